@@ -29,6 +29,19 @@ import (
 	"time"
 )
 
+func mapperClientOptions() []mapperclient.Option {
+	return []mapperclient.Option{
+		mapperclient.WithGRPCIngest(viper.GetString(sharedconfig.MapperGRPCAddressKey)),
+		mapperclient.WithBearerToken(viper.GetString(sharedconfig.MapperBearerTokenKey)),
+		mapperclient.WithServiceAccountToken(viper.GetString(sharedconfig.MapperTokenFileKey)),
+		mapperclient.WithClientCertificate(
+			viper.GetString(sharedconfig.MapperClientCertFileKey),
+			viper.GetString(sharedconfig.MapperClientKeyFileKey),
+			viper.GetString(sharedconfig.MapperCAFileKey),
+		),
+	}
+}
+
 func main() {
 	logrus.SetLevel(logrus.InfoLevel)
 	if viper.GetBool(sharedconfig.DebugKey) {
@@ -46,7 +59,7 @@ func main() {
 
 	ctrl.SetLogger(logrusr.New(logrus.StandardLogger()))
 
-	mapperClient := mapperclient.New(viper.GetString(sharedconfig.MapperApiUrlKey))
+	mapperClient := mapperclient.New(viper.GetString(sharedconfig.MapperApiUrlKey), mapperClientOptions()...)
 
 	mode := viper.GetString(config.KafkaLogReadModeKey)
 
@@ -20,19 +20,28 @@ import (
 	"github.com/otterize/network-mapper/src/mapper/pkg/dnscache"
 	"github.com/otterize/network-mapper/src/mapper/pkg/dnsintentspublisher"
 	"github.com/otterize/network-mapper/src/mapper/pkg/externaltrafficholder"
+	"github.com/otterize/network-mapper/src/mapper/pkg/federation"
 	"github.com/otterize/network-mapper/src/mapper/pkg/gcpintentsholder"
 	"github.com/otterize/network-mapper/src/mapper/pkg/incomingtrafficholder"
+	"github.com/otterize/network-mapper/src/mapper/pkg/ingestauth"
 	"github.com/otterize/network-mapper/src/mapper/pkg/metadatareporter"
 	"github.com/otterize/network-mapper/src/mapper/pkg/metrics_collection_traffic"
-	"github.com/otterize/network-mapper/src/mapper/pkg/mysqlstore"
 	"github.com/otterize/network-mapper/src/mapper/pkg/networkpolicyreport"
+	"github.com/otterize/network-mapper/src/mapper/pkg/networkpolicysimulator"
+	"github.com/otterize/network-mapper/src/mapper/pkg/notifier"
+	"github.com/otterize/network-mapper/src/mapper/pkg/policydrift"
 	"github.com/otterize/network-mapper/src/mapper/pkg/resourcevisibility"
+	"github.com/otterize/network-mapper/src/mapper/pkg/sqlstore"
+	"github.com/otterize/network-mapper/src/mapper/pkg/staleintents"
 	"github.com/otterize/network-mapper/src/mapper/pkg/webhook_traffic"
 	"github.com/otterize/network-mapper/src/shared/echologrus"
 	"golang.org/x/sync/errgroup"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
 	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"net"
 	"net/http"
 	"os"
 	"sigs.k8s.io/controller-runtime/pkg/metrics/server"
@@ -179,14 +188,70 @@ func main() {
 	trafficCollector := traffic.NewCollector()
 	serviceIdResolver := serviceidresolver.NewResolver(mgr.GetClient())
 
-	var dbClient *mysqlstore.MySQLIntentStore
+	var dbClient sqlstore.IntentStore
 	if viper.GetBool(config.DbEnabledKey) {
-		dbConfig := mysqlstore.ConfigFromViper()
-		dbClient, err = mysqlstore.NewMySQLIntentStore(dbConfig)
+		dbConfig := sqlstore.ConfigFromViper()
+		sqlStore, err := sqlstore.NewSQLIntentStore(dbConfig)
 		if err != nil {
 			logrus.WithError(err).Panic("Failed to initialize db client")
 		}
+		notifiers, err := notifier.NotifiersFromViper()
+		if err != nil {
+			logrus.WithError(err).Panic("Failed to initialize notifiers")
+		}
+		for _, n := range notifiers {
+			sqlStore.RegisterNotifier(n)
+		}
+		errgrp.Go(func() error {
+			defer errorreporter.AutoNotify()
+			sqlStore.RunNotificationOutbox(errGroupCtx)
+			return nil
+		})
+		dbClient = sqlStore
 		externalTrafficIntentsHolder.RegisterNotifyIntents(dbClient.LogExternalTrafficIntentsCallback)
+
+		if viper.GetBool(config.DbPersistIntentsEnabledKey) {
+			if err := dbClient.CleanupExpiredIntents(errGroupCtx); err != nil {
+				logrus.WithError(err).Warn("Failed to cleanup expired intents before loading them")
+			}
+			persistedIntents, err := dbClient.LoadIntents(errGroupCtx)
+			if err != nil {
+				logrus.WithError(err).Panic("Failed to load persisted intents")
+			}
+			intentsHolder.LoadIntents(persistedIntents)
+			logrus.WithField("count", len(persistedIntents)).Info("Loaded persisted intents")
+			intentsHolder.RegisterNotifyIntents(dbClient.LogIntentsCallback)
+		}
+
+		if viper.GetBool(config.DbIntentHistoryEnabledKey) {
+			intentsHolder.RegisterNotifyIntents(dbClient.LogIntentsHistoryCallback)
+			externalTrafficIntentsHolder.RegisterNotifyIntents(dbClient.LogExternalTrafficIntentsHistoryCallback)
+			incomingTrafficIntentsHolder.RegisterNotifyIntents(dbClient.LogIncomingTrafficIntentsHistoryCallback)
+			awsIntentsHolder.RegisterNotifyIntents(dbClient.LogAWSIntentsHistoryCallback)
+			gcpIntentsHolder.RegisterNotifyIntents(dbClient.LogGCPIntentsHistoryCallback)
+			azureIntentsHolder.RegisterNotifyIntents(dbClient.LogAzureOperationsHistoryCallback)
+		}
+	}
+
+	clusterFederation, err := federation.FromViper()
+	if err != nil {
+		logrus.WithError(err).Panic("Failed to initialize federation")
+	}
+
+	var staleIntentsReporter *staleintents.Reporter
+	clientIntentsInstalled, err := dnsintentspublisher.IsClientIntentsInstalled(errGroupCtx, mgr)
+	if err != nil {
+		logrus.WithError(err).Panic("Failed to check whether ClientIntents are installed")
+	}
+	var policyDriftDetector *policydrift.Detector
+	if clientIntentsInstalled {
+		staleIntentsReporter = staleintents.NewReporter(mgr.GetClient(), intentsHolder, externalTrafficIntentsHolder, dbClient)
+		if viper.GetBool(config.PolicyDriftEnabledKey) {
+			policyDriftDetector = policydrift.NewDetector(mgr.GetClient(), intentsHolder)
+			if err := policydrift.NewReconciler(mgr.GetClient(), policyDriftDetector).SetupWithManager(mgr); err != nil {
+				logrus.WithError(err).Panic("unable to create policy drift reconciler")
+			}
+		}
 	}
 
 	resolver := resolvers.NewResolver(
@@ -201,8 +266,17 @@ func main() {
 		incomingTrafficIntentsHolder,
 		trafficCollector,
 		dbClient,
+		clusterFederation,
+		staleIntentsReporter,
+		policyDriftDetector,
+		networkpolicysimulator.NewSimulator(mgr.GetClient(), kubeFinder, intentsHolder, dbClient),
 	)
-	resolver.Register(mapperServer)
+	ingestAuthPolicy, err := ingestauth.PolicyFromViper(append(resolvers.MutationNames(), federation.PushOperation), mgr.GetClient())
+	if err != nil {
+		logrus.WithError(err).Panic("Failed to initialize ingest auth")
+	}
+	resolver.Register(mapperServer, ingestAuthPolicy)
+	clusterFederation.RegisterPushHandler(mapperServer, ingestAuthPolicy)
 
 	metricsServer := echo.New()
 	mapperServer.Server.IdleTimeout = viper.GetDuration(config.HttpIdleTimeoutKey)
@@ -353,6 +427,20 @@ func main() {
 		return nil
 	})
 
+	if clusterFederation != nil {
+		errgrp.Go(func() error {
+			defer errorreporter.AutoNotify()
+			return clusterFederation.RunForever(errGroupCtx, resolver.LocalClusterSnapshot)
+		})
+	}
+
+	if staleIntentsReporter != nil {
+		errgrp.Go(func() error {
+			defer errorreporter.AutoNotify()
+			return staleIntentsReporter.RunForever(errGroupCtx)
+		})
+	}
+
 	telemetrysender.SendNetworkMapper(telemetriesgql.EventTypeStarted, 1)
 	telemetrysender.NetworkMapperRunActiveReporter(errGroupCtx)
 
@@ -368,6 +456,35 @@ func main() {
 
 		return mapperServer.Start(":9090")
 	})
+	if tlsConfig := ingestAuthPolicy.TLSConfig(); tlsConfig != nil {
+		errgrp.Go(func() error {
+			defer errorreporter.AutoNotify()
+			// Stopped along with the plain HTTP server by shutdownGracefullyOnCancel
+			mapperServer.TLSServer.Addr = fmt.Sprintf(":%d", viper.GetInt(config.IngestTLSPortKey))
+			mapperServer.TLSServer.TLSConfig = tlsConfig
+			mapperServer.TLSServer.IdleTimeout = mapperServer.Server.IdleTimeout
+			mapperServer.TLSServer.ReadTimeout = mapperServer.Server.ReadTimeout
+			mapperServer.TLSServer.WriteTimeout = mapperServer.Server.WriteTimeout
+			return mapperServer.StartServer(mapperServer.TLSServer)
+		})
+	}
+	if viper.GetBool(config.EnableGRPCIngestKey) {
+		grpcServer := grpc.NewServer(grpc.StreamInterceptor(ingestAuthPolicy.StreamServerInterceptor()))
+		resolver.RegisterGRPC(grpcServer)
+		errgrp.Go(func() error {
+			defer errorreporter.AutoNotify()
+			return serveGRPCUntilCancel(errGroupCtx, grpcServer, viper.GetInt(config.GRPCIngestPortKey))
+		})
+		// Like GraphQL, the API is served over TLS on a separate port, so reporters using other schemes can still reach it
+		if tlsConfig := ingestAuthPolicy.TLSConfig(); tlsConfig != nil {
+			grpcTLSServer := grpc.NewServer(grpc.StreamInterceptor(ingestAuthPolicy.StreamServerInterceptor()), grpc.Creds(credentials.NewTLS(tlsConfig)))
+			resolver.RegisterGRPC(grpcTLSServer)
+			errgrp.Go(func() error {
+				defer errorreporter.AutoNotify()
+				return serveGRPCUntilCancel(errGroupCtx, grpcTLSServer, viper.GetInt(config.GRPCIngestTLSPortKey))
+			})
+		}
+	}
 	errgrp.Go(func() error {
 		defer errorreporter.AutoNotify()
 		return resolver.RunForever(errGroupCtx)
@@ -383,6 +500,18 @@ func main() {
 	}
 }
 
+func serveGRPCUntilCancel(ctx context.Context, server *grpc.Server, port int) error {
+	listener, err := net.Listen("tcp", fmt.Sprintf(":%d", port))
+	if err != nil {
+		return errors.Wrap(err)
+	}
+	go func() {
+		<-ctx.Done()
+		server.GracefulStop()
+	}()
+	return errors.Wrap(server.Serve(listener))
+}
+
 func shutdownGracefullyOnCancel(errGroupCtx context.Context, server *echo.Echo) {
 	<-errGroupCtx.Done()
 	timeoutCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
@@ -0,0 +1,261 @@
+package clientintentsgenerator
+
+import (
+	"context"
+	"fmt"
+	"github.com/amit7itz/goset"
+	otterizev2alpha1 "github.com/otterize/intents-operator/src/operator/api/v2alpha1"
+	"github.com/otterize/intents-operator/src/shared/errors"
+	"github.com/otterize/network-mapper/src/mapper/pkg/externaltrafficholder"
+	"github.com/otterize/network-mapper/src/mapper/pkg/sqlstore"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/yaml"
+	"slices"
+	"strings"
+)
+
+var ErrNamespaceRequired = errors.NewSentinelError("namespace is required")
+
+// Filter selects the clients ClientIntents are generated for.
+type Filter struct {
+	Namespace string
+	// ClientName limits the result to a single client in Namespace. Empty for all clients in the namespace.
+	ClientName string
+	// ApprovedOnly only includes intents that were approved. Otherwise pending intents are included as well, including
+	// the ones seen since the last upload to the store. Denied intents are never included.
+	ApprovedOnly bool
+}
+
+type workload struct {
+	name      string
+	namespace string
+	kind      string
+}
+
+type workloadDomain struct {
+	workload workload
+	domain   string
+}
+
+// Generator builds ClientIntents that allow the internet domains discovered for clients, from the external traffic
+// intents held in memory and the ones persisted to the store.
+type Generator struct {
+	externalTrafficHolder *externaltrafficholder.ExternalTrafficIntentsHolder
+	store                 sqlstore.IntentStore
+	wildcardMinSubdomains int
+}
+
+// NewGenerator creates a Generator. store may be nil when the database is not enabled. Subdomains of the same parent
+// domain are collapsed into a wildcard once at least wildcardMinSubdomains of them are seen; 0 disables collapsing.
+func NewGenerator(externalTrafficHolder *externaltrafficholder.ExternalTrafficIntentsHolder, store sqlstore.IntentStore, wildcardMinSubdomains int) *Generator {
+	return &Generator{
+		externalTrafficHolder: externalTrafficHolder,
+		store:                 store,
+		wildcardMinSubdomains: wildcardMinSubdomains,
+	}
+}
+
+// Generate returns a ClientIntents per client matching the filter, with a single internet target listing the domains
+// the client accessed. The result is ordered by client name and kind.
+func (g *Generator) Generate(ctx context.Context, filter Filter) ([]otterizev2alpha1.ClientIntents, error) {
+	if filter.Namespace == "" {
+		return nil, errors.Wrap(ErrNamespaceRequired)
+	}
+
+	domains := make(map[workload]*goset.Set[string])
+	addDomain := func(client workload, domain string) {
+		if _, ok := domains[client]; !ok {
+			domains[client] = goset.NewSet[string]()
+		}
+		domains[client].Add(domain)
+	}
+
+	denied := goset.NewSet[workloadDomain]()
+	deniedDomains := make(map[workload][]string)
+	if g.store != nil {
+		storeFilter := sqlstore.ExternalIntentsFilter{Namespaces: []string{filter.Namespace}}
+		if filter.ClientName != "" {
+			storeFilter.ClientNames = []string{filter.ClientName}
+		}
+		page, err := g.store.GetExternalIntents(ctx, storeFilter, 0, "")
+		if err != nil {
+			return nil, errors.Wrap(err)
+		}
+		for _, record := range page.Intents {
+			client := workload{name: record.ClientName, namespace: record.ClientNamespace, kind: record.ClientKind}
+			switch record.Status {
+			case sqlstore.ExternalIntentStatusDenied:
+				denied.Add(workloadDomain{workload: client, domain: record.DNSName})
+				deniedDomains[client] = append(deniedDomains[client], record.DNSName)
+			case sqlstore.ExternalIntentStatusApproved:
+				addDomain(client, record.DNSName)
+			default:
+				if !filter.ApprovedOnly {
+					addDomain(client, record.DNSName)
+				}
+			}
+		}
+	}
+
+	if !filter.ApprovedOnly && g.externalTrafficHolder != nil {
+		for _, intent := range g.externalTrafficHolder.GetIntents() {
+			if intent.Client.Namespace != filter.Namespace || (filter.ClientName != "" && intent.Client.Name != filter.ClientName) {
+				continue
+			}
+			// The store only keeps intents to IPs outside the cluster, so the same goes for intents not stored yet.
+			if !sqlstore.HasExternalIP(intent.IPs) {
+				continue
+			}
+			client := workload{name: intent.Client.Name, namespace: intent.Client.Namespace}
+			if intent.Client.PodOwnerKind != nil {
+				client.kind = intent.Client.PodOwnerKind.Kind
+			}
+			if denied.Contains(workloadDomain{workload: client, domain: intent.DNSName}) {
+				continue
+			}
+			addDomain(client, intent.DNSName)
+		}
+	}
+
+	clients := make([]workload, 0, len(domains))
+	for client := range domains {
+		clients = append(clients, client)
+	}
+	slices.SortFunc(clients, func(a, b workload) int {
+		if c := strings.Compare(a.name, b.name); c != 0 {
+			return c
+		}
+		return strings.Compare(a.kind, b.kind)
+	})
+
+	clientIntents := make([]otterizev2alpha1.ClientIntents, 0, len(clients))
+	for _, client := range clients {
+		clientIntents = append(clientIntents, newClientIntents(client, CollapseWildcards(domains[client].Items(), deniedDomains[client], g.wildcardMinSubdomains)))
+	}
+	return clientIntents, nil
+}
+
+func newClientIntents(client workload, domains []string) otterizev2alpha1.ClientIntents {
+	name := fmt.Sprintf("%s-external-traffic", client.name)
+	if client.kind != "" {
+		name = fmt.Sprintf("%s-%s-external-traffic", client.name, strings.ToLower(client.kind))
+	}
+	return otterizev2alpha1.ClientIntents{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: otterizev2alpha1.GroupVersion.String(),
+			Kind:       "ClientIntents",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: client.namespace,
+		},
+		Spec: &otterizev2alpha1.IntentsSpec{
+			Workload: otterizev2alpha1.Workload{Name: client.name, Kind: client.kind},
+			Targets:  []otterizev2alpha1.Target{{Internet: &otterizev2alpha1.Internet{Domains: domains}}},
+		},
+	}
+}
+
+// CollapseWildcards replaces the subdomains of a parent domain with a '*.<parent>' wildcard when at least
+// minSubdomains of them are seen, and drops the domains the wildcard covers. Top-level domains are never wildcarded, so
+// 'a.com' and 'b.com' are not collapsed into '*.com', and neither are parents of a denied domain, whose subdomains are
+// kept as is so the wildcard does not allow the denied domain. The result is sorted and has no duplicates. A
+// minSubdomains of 0 disables collapsing.
+func CollapseWildcards(domains []string, denied []string, minSubdomains int) []string {
+	subdomainCounts := make(map[string]int)
+	if minSubdomains > 0 {
+		for _, domain := range goset.FromSlice(domains).Items() {
+			if parent, ok := wildcardableParent(domain); ok {
+				subdomainCounts[parent]++
+			}
+		}
+	}
+
+	wildcardParents := make([]string, 0)
+	for _, domain := range domains {
+		if parent, isWildcard := strings.CutPrefix(domain, "*."); isWildcard {
+			wildcardParents = append(wildcardParents, parent)
+		}
+	}
+	coversDenied := func(parent string) bool {
+		for _, domain := range denied {
+			if strings.HasSuffix(domain, "."+parent) {
+				return true
+			}
+		}
+		return false
+	}
+	for parent, count := range subdomainCounts {
+		if count >= minSubdomains && !coversDenied(parent) {
+			wildcardParents = append(wildcardParents, parent)
+		}
+	}
+
+	// Both wildcards and plain domains are dropped if a wildcard for one of their ancestors covers them.
+	isCovered := func(domain string) bool {
+		for _, parent := range wildcardParents {
+			if strings.HasSuffix(domain, "."+parent) && domain != "*."+parent {
+				return true
+			}
+		}
+		return false
+	}
+
+	result := goset.NewSet[string]()
+	for _, parent := range wildcardParents {
+		result.Add("*." + parent)
+	}
+	for _, domain := range domains {
+		result.Add(domain)
+	}
+	collapsed := make([]string, 0, result.Len())
+	for _, domain := range result.Items() {
+		if !isCovered(domain) {
+			collapsed = append(collapsed, domain)
+		}
+	}
+	slices.Sort(collapsed)
+	return collapsed
+}
+
+// wildcardableParent returns the domain without its first label, if that is not a top-level domain.
+func wildcardableParent(domain string) (string, bool) {
+	if strings.HasPrefix(domain, "*.") {
+		return "", false
+	}
+	_, parent, found := strings.Cut(domain, ".")
+	if !found || !strings.Contains(parent, ".") {
+		return "", false
+	}
+	return parent, true
+}
+
+// manifest is what is rendered for a ClientIntents - marshalling ClientIntents itself also renders an empty status and
+// a null creation timestamp.
+type manifest struct {
+	metav1.TypeMeta `json:",inline"`
+	Metadata        manifestMetadata              `json:"metadata"`
+	Spec            *otterizev2alpha1.IntentsSpec `json:"spec"`
+}
+
+type manifestMetadata struct {
+	Name      string `json:"name"`
+	Namespace string `json:"namespace"`
+}
+
+// MarshalYAML renders the ClientIntents as a multi-document YAML, ready to be applied with kubectl.
+func MarshalYAML(clientIntents []otterizev2alpha1.ClientIntents) ([]byte, error) {
+	documents := make([]string, 0, len(clientIntents))
+	for _, ci := range clientIntents {
+		document, err := yaml.Marshal(manifest{
+			TypeMeta: ci.TypeMeta,
+			Metadata: manifestMetadata{Name: ci.Name, Namespace: ci.Namespace},
+			Spec:     ci.Spec,
+		})
+		if err != nil {
+			return nil, errors.Wrap(err)
+		}
+		documents = append(documents, string(document))
+	}
+	return []byte(strings.Join(documents, "---\n")), nil
+}
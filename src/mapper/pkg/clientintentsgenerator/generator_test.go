@@ -0,0 +1,241 @@
+package clientintentsgenerator
+
+import (
+	"context"
+	"github.com/otterize/intents-operator/src/shared/errors"
+	"github.com/otterize/network-mapper/src/mapper/pkg/externaltrafficholder"
+	"github.com/otterize/network-mapper/src/mapper/pkg/graph/model"
+	"github.com/otterize/network-mapper/src/mapper/pkg/sqlstore"
+	"github.com/stretchr/testify/suite"
+	"testing"
+	"time"
+)
+
+type GeneratorSuite struct {
+	suite.Suite
+	store  *sqlstore.SQLIntentStore
+	holder *externaltrafficholder.ExternalTrafficIntentsHolder
+}
+
+func (s *GeneratorSuite) SetupTest() {
+	store, err := sqlstore.NewSQLIntentStore(sqlstore.Config{
+		DbDriver:             sqlstore.DriverSQLite,
+		DbSQLitePath:         ":memory:",
+		RetentionDays:        90,
+		IntentsRetentionDays: 90,
+	})
+	s.Require().NoError(err)
+	s.store = store
+	s.holder = externaltrafficholder.NewExternalTrafficIntentsHolder()
+}
+
+func (s *GeneratorSuite) TearDownTest() {
+	s.Require().NoError(s.store.Close())
+}
+
+func externalTrafficIntent(clientName string, namespace string, dnsName string, ip string) externaltrafficholder.ExternalTrafficIntent {
+	return externaltrafficholder.ExternalTrafficIntent{
+		Client: model.OtterizeServiceIdentity{
+			Name:         clientName,
+			Namespace:    namespace,
+			PodOwnerKind: &model.GroupVersionKind{Kind: "Deployment"},
+		},
+		LastSeen: time.Now(),
+		DNSName:  dnsName,
+		IPs:      map[externaltrafficholder.IP]struct{}{externaltrafficholder.IP(ip): {}},
+	}
+}
+
+func (s *GeneratorSuite) storeIntents(intents ...externaltrafficholder.ExternalTrafficIntent) {
+	timestamped := make([]externaltrafficholder.TimestampedExternalTrafficIntent, 0, len(intents))
+	for _, intent := range intents {
+		timestamped = append(timestamped, externaltrafficholder.TimestampedExternalTrafficIntent{Timestamp: intent.LastSeen, Intent: intent})
+	}
+	s.store.LogExternalTrafficIntentsCallback(context.Background(), timestamped)
+}
+
+func (s *GeneratorSuite) setStatus(clientName string, dnsName string, status string) {
+	_, err := s.store.SetExternalIntentStatus(context.Background(), sqlstore.ExternalIntentKey{
+		ClientName:      clientName,
+		ClientNamespace: "production",
+		ClientKind:      "Deployment",
+		DNSName:         dnsName,
+	}, status, "")
+	s.Require().NoError(err)
+}
+
+func (s *GeneratorSuite) domainsByClient(generator *Generator, filter Filter) map[string][]string {
+	clientIntents, err := generator.Generate(context.Background(), filter)
+	s.Require().NoError(err)
+	result := make(map[string][]string)
+	for _, ci := range clientIntents {
+		s.Require().Len(ci.Spec.Targets, 1)
+		result[ci.Spec.Workload.Name] = ci.Spec.Targets[0].Internet.Domains
+	}
+	return result
+}
+
+func (s *GeneratorSuite) TestStoredAndHeldIntentsAreMerged() {
+	s.storeIntents(
+		externalTrafficIntent("frontend", "production", "api.example.com", "8.8.8.8"),
+		externalTrafficIntent("frontend", "production", "tracker.example.com", "8.8.8.8"),
+		externalTrafficIntent("frontend", "production", "cdn.example.com", "8.8.8.8"),
+		externalTrafficIntent("backend", "staging", "db.example.com", "8.8.8.8"),
+	)
+	s.setStatus("frontend", "api.example.com", sqlstore.ExternalIntentStatusApproved)
+	s.setStatus("frontend", "tracker.example.com", sqlstore.ExternalIntentStatusDenied)
+
+	s.holder.AddIntent(externalTrafficIntent("frontend", "production", "tracker.example.com", "8.8.8.8"))
+	s.holder.AddIntent(externalTrafficIntent("frontend", "production", "payments.example.org", "1.1.1.1"))
+	s.holder.AddIntent(externalTrafficIntent("frontend", "production", "internal.example.org", "10.0.0.1"))
+	s.holder.AddIntent(externalTrafficIntent("worker", "production", "queue.example.net", "1.1.1.1"))
+
+	generator := NewGenerator(s.holder, s.store, 0)
+	s.Require().Equal(map[string][]string{
+		"frontend": {"api.example.com", "cdn.example.com", "payments.example.org"},
+		"worker":   {"queue.example.net"},
+	}, s.domainsByClient(generator, Filter{Namespace: "production"}))
+	s.Require().Equal(map[string][]string{
+		"frontend": {"api.example.com"},
+	}, s.domainsByClient(generator, Filter{Namespace: "production", ApprovedOnly: true}))
+	s.Require().Equal(map[string][]string{
+		"worker": {"queue.example.net"},
+	}, s.domainsByClient(generator, Filter{Namespace: "production", ClientName: "worker"}))
+
+	// Generating does not consume the held intents.
+	s.Require().Len(s.holder.GetNewIntentsSinceLastGet(), 4)
+}
+
+func (s *GeneratorSuite) TestGenerateWithoutStore() {
+	s.holder.AddIntent(externalTrafficIntent("frontend", "production", "api.example.com", "8.8.8.8"))
+
+	generator := NewGenerator(s.holder, nil, 0)
+	s.Require().Equal(map[string][]string{
+		"frontend": {"api.example.com"},
+	}, s.domainsByClient(generator, Filter{Namespace: "production"}))
+	s.Require().Empty(s.domainsByClient(generator, Filter{Namespace: "production", ApprovedOnly: true}))
+
+	_, err := generator.Generate(context.Background(), Filter{})
+	s.Require().True(errors.Is(err, ErrNamespaceRequired))
+}
+
+func (s *GeneratorSuite) TestMarshalYAML() {
+	s.holder.AddIntent(externalTrafficIntent("frontend", "production", "api.example.com", "8.8.8.8"))
+	s.holder.AddIntent(externalTrafficIntent("worker", "production", "queue.example.net", "1.1.1.1"))
+
+	clientIntents, err := NewGenerator(s.holder, nil, 0).Generate(context.Background(), Filter{Namespace: "production"})
+	s.Require().NoError(err)
+	manifests, err := MarshalYAML(clientIntents)
+	s.Require().NoError(err)
+	s.Require().Equal(`apiVersion: k8s.otterize.com/v2alpha1
+kind: ClientIntents
+metadata:
+  name: frontend-deployment-external-traffic
+  namespace: production
+spec:
+  targets:
+  - internet:
+      domains:
+      - api.example.com
+  workload:
+    kind: Deployment
+    name: frontend
+---
+apiVersion: k8s.otterize.com/v2alpha1
+kind: ClientIntents
+metadata:
+  name: worker-deployment-external-traffic
+  namespace: production
+spec:
+  targets:
+  - internet:
+      domains:
+      - queue.example.net
+  workload:
+    kind: Deployment
+    name: worker
+`, string(manifests))
+}
+
+func (s *GeneratorSuite) TestCollapseWildcards() {
+	testCases := []struct {
+		name          string
+		domains       []string
+		denied        []string
+		minSubdomains int
+		expected      []string
+	}{
+		{
+			name:          "below threshold",
+			domains:       []string{"b.example.com", "a.example.com", "a.example.com"},
+			minSubdomains: 3,
+			expected:      []string{"a.example.com", "b.example.com"},
+		},
+		{
+			name:          "collapsed at threshold, apex kept",
+			domains:       []string{"a.example.com", "b.example.com", "c.example.com", "example.com", "api.other.com"},
+			minSubdomains: 3,
+			expected:      []string{"*.example.com", "api.other.com", "example.com"},
+		},
+		{
+			name:          "deeper subdomains are covered",
+			domains:       []string{"a.example.com", "b.example.com", "x.a.example.com", "*.b.example.com"},
+			minSubdomains: 2,
+			expected:      []string{"*.example.com"},
+		},
+		{
+			name:          "top-level domains are never wildcarded",
+			domains:       []string{"a.com", "b.com", "c.com"},
+			minSubdomains: 2,
+			expected:      []string{"a.com", "b.com", "c.com"},
+		},
+		{
+			name:          "parents of denied domains are not wildcarded",
+			domains:       []string{"a.example.com", "b.example.com", "a.x.example.com", "b.x.example.com"},
+			denied:        []string{"evil.x.example.com"},
+			minSubdomains: 2,
+			expected:      []string{"a.example.com", "a.x.example.com", "b.example.com", "b.x.example.com"},
+		},
+		{
+			name:          "disabled",
+			domains:       []string{"a.example.com", "b.example.com"},
+			minSubdomains: 0,
+			expected:      []string{"a.example.com", "b.example.com"},
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.Require().Equal(tc.expected, CollapseWildcards(tc.domains, tc.denied, tc.minSubdomains))
+		})
+	}
+}
+
+func (s *GeneratorSuite) TestGenerateCollapsesWildcards() {
+	for _, dnsName := range []string{"a.cdn.example.com", "b.cdn.example.com", "c.cdn.example.com", "api.example.com"} {
+		s.holder.AddIntent(externalTrafficIntent("frontend", "production", dnsName, "8.8.8.8"))
+	}
+
+	s.Require().Equal(map[string][]string{
+		"frontend": {"*.cdn.example.com", "api.example.com"},
+	}, s.domainsByClient(NewGenerator(s.holder, s.store, 3), Filter{Namespace: "production"}))
+}
+
+func (s *GeneratorSuite) TestGenerateDoesNotWildcardDeniedDomains() {
+	subdomains := []string{"a.x.com", "b.x.com", "c.x.com", "d.x.com", "e.x.com"}
+	for _, dnsName := range append(subdomains, "evil.x.com") {
+		s.storeIntents(externalTrafficIntent("frontend", "production", dnsName, "8.8.8.8"))
+	}
+	for _, dnsName := range subdomains {
+		s.setStatus("frontend", dnsName, sqlstore.ExternalIntentStatusApproved)
+	}
+	s.setStatus("frontend", "evil.x.com", sqlstore.ExternalIntentStatusDenied)
+
+	s.Require().Equal(map[string][]string{
+		"frontend": subdomains,
+	}, s.domainsByClient(NewGenerator(s.holder, s.store, 3), Filter{Namespace: "production", ApprovedOnly: true}))
+}
+
+func TestGeneratorSuite(t *testing.T) {
+	suite.Run(t, new(GeneratorSuite))
+}
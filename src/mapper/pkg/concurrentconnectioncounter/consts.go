@@ -4,6 +4,7 @@ const (
 	SocketScanServiceIntentResolution string = "addSocketScanServiceIntent"
 	SocketScanPodIntentResolution     string = "addSocketScanPodIntent"
 	TCPTrafficIntentResolution        string = "handleInternalTrafficTCPResult"
+	UDPTrafficIntentResolution        string = "handleInternalTrafficUDPResult"
 	DNSTrafficIntentResolution        string = "handleDNSCaptureResultsAsKubernetesPods"
 	KafkaResultIntentResolution       string = "handleReportKafkaMapperResults"
 	IstioResultIntentResolution       string = "handleReportIstioConnectionResults"
@@ -23,7 +23,8 @@ func (c *CountableIntentIntent) ShouldCountUsingSrcPortMethod() bool {
 	return c.intent.ResolutionData != nil &&
 		(*(c.intent.ResolutionData) == SocketScanServiceIntentResolution ||
 			*c.intent.ResolutionData == SocketScanPodIntentResolution ||
-			*c.intent.ResolutionData == TCPTrafficIntentResolution)
+			*c.intent.ResolutionData == TCPTrafficIntentResolution ||
+			*c.intent.ResolutionData == UDPTrafficIntentResolution)
 }
 
 func (c *CountableIntentIntent) ShouldCountUsingDNSMethod() bool {
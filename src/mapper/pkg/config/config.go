@@ -55,6 +55,8 @@ const (
 
 	ControlPlaneIPv4CidrPrefixLength        = "control-plane-ipv4-cidr-prefix-length"
 	ControlPlaneIPv4CidrPrefixLengthDefault = 32
+	ControlPlaneIPv6CidrPrefixLength        = "control-plane-ipv6-cidr-prefix-length"
+	ControlPlaneIPv6CidrPrefixLengthDefault = 128
 
 	TCPDestResolveOnlyControlPlaneByIp        = "tcp-dest-resolve-only-control-plane-by-ip"
 	TCPDestResolveOnlyControlPlaneByIpDefault = true
@@ -72,6 +74,10 @@ const (
 	ClusterDefault                            = "cluster.local"
 	DbEnabledKey                              = "db-enabled"
 	DbEnabledDefault                          = true
+	DbDriverKey                               = "db-driver"
+	DbDriverDefault                           = "mysql"
+	DbSQLitePathKey                           = "db-sqlite-path"
+	DbSQLitePathDefault                       = "/var/lib/otterize/network-mapper.db"
 	DbHostKey                                 = "db-host"
 	DbHostDefault                             = "127.0.0.1"
 	DbUsernameKey                             = "db-username"
@@ -94,10 +100,68 @@ const (
 	GhaRepoDefault                            = ""
 	GhaEventTypeKey                           = "gha-event-type"
 	GhaEventTypeDefault                       = "recieveNewIntents"
+	GhaChunkMaxIntentsKey                     = "gha-chunk-max-intents"
+	GhaChunkMaxIntentsDefault                 = 100
+	GhaChunkMaxBytesKey                       = "gha-chunk-max-bytes"
+	GhaChunkMaxBytesDefault                   = 32 * 1024
+	GhaChunkGroupByNamespaceKey               = "gha-chunk-group-by-namespace"
+	GhaChunkGroupByNamespaceDefault           = false
 	ExternalIntentsRetentionDaysKey           = "external-intents-retention-days"
 	ExternalIntentsRetentionDaysDefault       = 90
+	ClientIntentsWildcardMinSubdomainsKey     = "client-intents-wildcard-min-subdomains"
+	ClientIntentsWildcardMinSubdomainsDefault = 5
+	DbPersistIntentsEnabledKey                = "db-persist-intents-enabled"
+	DbPersistIntentsEnabledDefault            = true
+	IntentsRetentionDaysKey                   = "intents-retention-days"
+	IntentsRetentionDaysDefault               = 90
+	DbIntentHistoryEnabledKey                 = "db-intent-history-enabled"
+	DbIntentHistoryEnabledDefault             = true
+	IntentHistoryRetentionDaysKey             = "intent-history-retention-days"
+	IntentHistoryRetentionDaysDefault         = 90
+	NotifiersConfigFileKey                    = "notifiers-config-file"
+	NotifiersConfigFileDefault                = ""
+	NotificationOutboxIntervalKey             = "notification-outbox-interval"
+	NotificationOutboxIntervalDefault         = 10 * time.Second
+	NotificationMaxAttemptsKey                = "notification-max-attempts"
+	NotificationMaxAttemptsDefault            = 12
+	NotificationInitialBackoffKey             = "notification-initial-backoff"
+	NotificationInitialBackoffDefault         = 30 * time.Second
+	NotificationMaxBackoffKey                 = "notification-max-backoff"
+	NotificationMaxBackoffDefault             = 1 * time.Hour
 	DNSResolutionFailureCacheTTLSecondsKey    = "dns-resolution-failure-cache-ttl"
 	DNSResolutionFailureCacheTTLSecondsDefault = 60
+	ReportQueueSizeKey                        = "report-queue-size"
+	ReportQueueSizeDefault                    = 200
+	ReportQueueWaitKey                        = "report-queue-wait"
+	ReportQueueWaitDefault                    = 0 * time.Second
+	ReportQueueRetryAfterKey                  = "report-queue-retry-after"
+	ReportQueueRetryAfterDefault              = 1 * time.Second
+	EnableGRPCIngestKey                       = "enable-grpc-ingest"
+	EnableGRPCIngestDefault                   = false
+	GRPCIngestPortKey                         = "grpc-ingest-port"
+	GRPCIngestPortDefault                     = 9091
+	GRPCIngestTLSPortKey                      = "grpc-ingest-tls-port"
+	GRPCIngestTLSPortDefault                  = 9444
+	GRPCIngestMaxReportItemsKey               = "grpc-ingest-max-report-items"
+	GRPCIngestMaxReportItemsDefault           = 100000
+	IngestAuthConfigFileKey                   = "ingest-auth-config-file"
+	IngestAuthConfigFileDefault               = ""
+	IngestTLSPortKey                          = "ingest-tls-port"
+	IngestTLSPortDefault                      = 9443
+	FederationConfigFileKey                   = "federation-config-file"
+	FederationConfigFileDefault               = ""
+	FederationSyncIntervalKey                 = "federation-sync-interval"
+	FederationSyncIntervalDefault             = 1 * time.Minute
+	FederationPushMaxBytesKey                 = "federation-push-max-bytes"
+	FederationPushMaxBytesDefault             = 64 * 1024 * 1024
+	StaleIntentsDaysKey                       = "stale-intents-days"
+	StaleIntentsDaysDefault                   = 30
+	StaleIntentsReportIntervalKey             = "stale-intents-report-interval"
+	StaleIntentsReportIntervalDefault         = 1 * time.Hour
+	PolicyDriftEnabledKey                     = "policy-drift-enabled"
+	PolicyDriftEnabledDefault                 = true
+	PolicyDriftIntervalKey                    = "policy-drift-interval"
+	PolicyDriftIntervalDefault                = 5 * time.Minute
 )
 
 var excludedNamespaces *goset.Set[string]
@@ -130,6 +194,7 @@ func init() {
 	viper.SetDefault(WebhookServicesCacheSizeKey, WebhookServicesCacheSizeDefault)
 	viper.SetDefault(TimeServerHasToLiveBeforeWeTrustItKey, TimeServerHasToLiveBeforeWeTrustItDefault)
 	viper.SetDefault(ControlPlaneIPv4CidrPrefixLength, ControlPlaneIPv4CidrPrefixLengthDefault)
+	viper.SetDefault(ControlPlaneIPv6CidrPrefixLength, ControlPlaneIPv6CidrPrefixLengthDefault)
 	viper.SetDefault(TCPDestResolveOnlyControlPlaneByIp, TCPDestResolveOnlyControlPlaneByIpDefault)
 	viper.SetDefault(HttpIdleTimeoutKey, HttpIdleTimeoutDefault)
 	viper.SetDefault(HttpReadTimeoutKey, HttpReadTimeoutDefault)
@@ -138,6 +203,8 @@ func init() {
 	viper.SetDefault(ClientIgnoreListByNamespaceKey, ClientIgnoreListByNamespaceDefault)
 	viper.SetDefault(ClusterKey, ClusterDefault)
 	viper.SetDefault(DbEnabledKey, DbEnabledDefault)
+	viper.SetDefault(DbDriverKey, DbDriverDefault)
+	viper.SetDefault(DbSQLitePathKey, DbSQLitePathDefault)
 	viper.SetDefault(DbHostKey, DbHostDefault)
 	viper.SetDefault(DbUsernameKey, DbUsernameDefault)
 	viper.SetDefault(DbPasswordKey, DbPasswordDefault)
@@ -149,8 +216,37 @@ func init() {
 	viper.SetDefault(GhaOwnerKey, GhaOwnerDefault)
 	viper.SetDefault(GhaRepoKey, GhaRepoDefault)
 	viper.SetDefault(GhaEventTypeKey, GhaEventTypeDefault)
+	viper.SetDefault(GhaChunkMaxIntentsKey, GhaChunkMaxIntentsDefault)
+	viper.SetDefault(GhaChunkMaxBytesKey, GhaChunkMaxBytesDefault)
+	viper.SetDefault(GhaChunkGroupByNamespaceKey, GhaChunkGroupByNamespaceDefault)
 	viper.SetDefault(ExternalIntentsRetentionDaysKey, ExternalIntentsRetentionDaysDefault)
+	viper.SetDefault(ClientIntentsWildcardMinSubdomainsKey, ClientIntentsWildcardMinSubdomainsDefault)
+	viper.SetDefault(DbPersistIntentsEnabledKey, DbPersistIntentsEnabledDefault)
+	viper.SetDefault(IntentsRetentionDaysKey, IntentsRetentionDaysDefault)
+	viper.SetDefault(DbIntentHistoryEnabledKey, DbIntentHistoryEnabledDefault)
+	viper.SetDefault(IntentHistoryRetentionDaysKey, IntentHistoryRetentionDaysDefault)
+	viper.SetDefault(NotifiersConfigFileKey, NotifiersConfigFileDefault)
+	viper.SetDefault(NotificationOutboxIntervalKey, NotificationOutboxIntervalDefault)
+	viper.SetDefault(NotificationMaxAttemptsKey, NotificationMaxAttemptsDefault)
+	viper.SetDefault(NotificationInitialBackoffKey, NotificationInitialBackoffDefault)
+	viper.SetDefault(NotificationMaxBackoffKey, NotificationMaxBackoffDefault)
 	viper.SetDefault(DNSResolutionFailureCacheTTLSecondsKey, DNSResolutionFailureCacheTTLSecondsDefault)
+	viper.SetDefault(ReportQueueSizeKey, ReportQueueSizeDefault)
+	viper.SetDefault(ReportQueueWaitKey, ReportQueueWaitDefault)
+	viper.SetDefault(ReportQueueRetryAfterKey, ReportQueueRetryAfterDefault)
+	viper.SetDefault(EnableGRPCIngestKey, EnableGRPCIngestDefault)
+	viper.SetDefault(GRPCIngestPortKey, GRPCIngestPortDefault)
+	viper.SetDefault(GRPCIngestTLSPortKey, GRPCIngestTLSPortDefault)
+	viper.SetDefault(GRPCIngestMaxReportItemsKey, GRPCIngestMaxReportItemsDefault)
+	viper.SetDefault(IngestAuthConfigFileKey, IngestAuthConfigFileDefault)
+	viper.SetDefault(IngestTLSPortKey, IngestTLSPortDefault)
+	viper.SetDefault(FederationConfigFileKey, FederationConfigFileDefault)
+	viper.SetDefault(FederationSyncIntervalKey, FederationSyncIntervalDefault)
+	viper.SetDefault(FederationPushMaxBytesKey, FederationPushMaxBytesDefault)
+	viper.SetDefault(StaleIntentsDaysKey, StaleIntentsDaysDefault)
+	viper.SetDefault(StaleIntentsReportIntervalKey, StaleIntentsReportIntervalDefault)
+	viper.SetDefault(PolicyDriftEnabledKey, PolicyDriftEnabledDefault)
+	viper.SetDefault(PolicyDriftIntervalKey, PolicyDriftIntervalDefault)
 
 	excludedNamespaces = goset.FromSlice(viper.GetStringSlice(ExcludedNamespacesKey))
 }
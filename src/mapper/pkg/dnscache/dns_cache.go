@@ -13,6 +13,8 @@ import (
 
 type DNSCache struct {
 	cache *ttl_cache.TTLCache[string, string]
+	// reverseCache maps resolved IPs back to the DNS names they were resolved for.
+	reverseCache *ttl_cache.TTLCache[string, string]
 }
 
 type Resolver interface {
@@ -25,14 +27,17 @@ func NewDNSCache() *DNSCache {
 		logrus.Panic("Capacity cannot be 0")
 	}
 	dnsRecordCache := ttl_cache.NewTTLCache[string, string](capacity)
+	reverseDNSRecordCache := ttl_cache.NewTTLCache[string, string](capacity)
 
 	return &DNSCache{
-		cache: dnsRecordCache,
+		cache:        dnsRecordCache,
+		reverseCache: reverseDNSRecordCache,
 	}
 }
 
 func (d *DNSCache) AddOrUpdateDNSData(dnsName string, ip string, ttl time.Duration) {
 	d.cache.Insert(dnsName, ip, ttl)
+	d.reverseCache.Insert(ip, dnsName, ttl)
 }
 
 func (d *DNSCache) GetResolvedIPs(dnsName string) []string {
@@ -40,6 +45,11 @@ func (d *DNSCache) GetResolvedIPs(dnsName string) []string {
 	return entry
 }
 
+// GetDNSNamesForIP returns the DNS names that were recently resolved to the IP.
+func (d *DNSCache) GetDNSNamesForIP(ip string) []string {
+	return d.reverseCache.Get(ip)
+}
+
 func (d *DNSCache) GetResolvedIPsForWildcard(dnsName string) []string {
 	dnsSuffix := strings.TrimPrefix(dnsName, "*") // Strip the wildcard, leave the '.example.com' suffix
 	result := d.cache.Filter(func(key string) bool {
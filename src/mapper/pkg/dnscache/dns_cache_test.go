@@ -45,6 +45,17 @@ func (s *DNSCacheTestSuite) TestDNSCache() {
 	s.Require().Equal(IP1, ips[0])
 }
 
+func (s *DNSCacheTestSuite) TestReverseLookup() {
+	cache := NewDNSCache()
+	cache.AddOrUpdateDNSData("good-news.com", IP1, 60*time.Second)
+	cache.AddOrUpdateDNSData("other-news.com", IP1, 60*time.Second)
+	cache.AddOrUpdateDNSData("bad-news.de", IP2, 60*time.Second)
+
+	names := cache.GetDNSNamesForIP(IP1)
+	s.Require().ElementsMatch([]string{"good-news.com", "other-news.com"}, names)
+	s.Require().Empty(cache.GetDNSNamesForIP("10.0.0.3"))
+}
+
 func (s *DNSCacheTestSuite) TestCapacityConfig() {
 	capacityLimit := 2
 	viper.Set(config.DNSCacheItemsMaxCapacityKey, capacityLimit)
@@ -8,17 +8,27 @@ import (
 	"github.com/otterize/network-mapper/src/mapper/pkg/graph/model"
 	"github.com/samber/lo"
 	"github.com/sirupsen/logrus"
+	"maps"
 	"sync"
 	"time"
 )
 
 type IP string
 
+// Port is a destination port along with its transport protocol.
+type Port struct {
+	Number   int
+	Protocol model.IntentProtocol
+}
+
 type ExternalTrafficIntent struct {
 	Client   model.OtterizeServiceIdentity `json:"client"`
 	LastSeen time.Time
 	DNSName  string
 	IPs      map[IP]struct{}
+	// Ports holds the destination ports seen per IP, when known. Not every IP in IPs has ports - the DNS sniffer only
+	// sees the resolution, and ports are only known once a connection to the IP is captured.
+	Ports map[IP]map[Port]struct{}
 }
 
 type TimestampedExternalTrafficIntent struct {
@@ -101,6 +111,23 @@ func (h *ExternalTrafficIntentsHolder) GetNewIntentsSinceLastGet() []Timestamped
 	return intents
 }
 
+// GetIntents returns a copy of the intents gathered since the last upload, without resetting them.
+func (h *ExternalTrafficIntentsHolder) GetIntents() []ExternalTrafficIntent {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+
+	intents := make([]ExternalTrafficIntent, 0, len(h.intents))
+	for _, ti := range h.intents {
+		intent := ti.Intent
+		intent.IPs = maps.Clone(intent.IPs)
+		intent.Ports = lo.MapValues(intent.Ports, func(ports map[Port]struct{}, _ IP) map[Port]struct{} {
+			return maps.Clone(ports)
+		})
+		intents = append(intents, intent)
+	}
+	return intents
+}
+
 func (h *ExternalTrafficIntentsHolder) AddIntent(intent ExternalTrafficIntent) {
 	if config.ExcludedNamespaces().Contains(intent.Client.Namespace) {
 		return
@@ -130,9 +157,23 @@ func (h *ExternalTrafficIntentsHolder) AddIntent(intent ExternalTrafficIntent) {
 
 	mergedIntent := h.intents[key]
 
+	if mergedIntent.Intent.IPs == nil {
+		mergedIntent.Intent.IPs = make(map[IP]struct{})
+	}
 	for ip := range intent.IPs {
 		mergedIntent.Intent.IPs[ip] = struct{}{}
 	}
+	if mergedIntent.Intent.Ports == nil {
+		mergedIntent.Intent.Ports = make(map[IP]map[Port]struct{})
+	}
+	for ip, ports := range intent.Ports {
+		if _, ok := mergedIntent.Intent.Ports[ip]; !ok {
+			mergedIntent.Intent.Ports[ip] = make(map[Port]struct{})
+		}
+		for port := range ports {
+			mergedIntent.Intent.Ports[ip][port] = struct{}{}
+		}
+	}
 	if intent.LastSeen.After(mergedIntent.Timestamp) {
 		mergedIntent.Timestamp = intent.LastSeen
 	}
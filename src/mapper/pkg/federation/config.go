@@ -0,0 +1,70 @@
+package federation
+
+import (
+	"github.com/otterize/intents-operator/src/shared/errors"
+	"github.com/otterize/network-mapper/src/mapperclient"
+	"os"
+	"sigs.k8s.io/yaml"
+)
+
+// Credentials are sent to other clusters' mappers, if they require ingest auth. BearerToken may reference environment
+// variables as ${VAR}, so it can be kept in a Kubernetes secret.
+type Credentials struct {
+	BearerToken    string `json:"bearerToken,omitempty"`
+	TokenFile      string `json:"tokenFile,omitempty"`
+	ClientCertFile string `json:"clientCertFile,omitempty"`
+	ClientKeyFile  string `json:"clientKeyFile,omitempty"`
+	CAFile         string `json:"caFile,omitempty"`
+}
+
+func (c Credentials) options() []mapperclient.Option {
+	return []mapperclient.Option{
+		mapperclient.WithBearerToken(os.ExpandEnv(c.BearerToken)),
+		mapperclient.WithServiceAccountToken(c.TokenFile),
+		mapperclient.WithClientCertificate(c.ClientCertFile, c.ClientKeyFile, c.CAFile),
+	}
+}
+
+// ClusterConfig is a cluster whose mapper is pulled by this mapper.
+type ClusterConfig struct {
+	// Name identities in the cluster are stamped with.
+	Name string `json:"name"`
+	// URL of the cluster mapper's GraphQL API, such as http://mapper.cluster-a.example.com:9090/query.
+	URL string `json:"url"`
+	Credentials
+}
+
+// PushConfig is the aggregator this mapper pushes its cluster's snapshot to.
+type PushConfig struct {
+	// URL of the aggregator's push endpoint, such as http://aggregator.example.com:9090/federation/snapshots.
+	URL string `json:"url"`
+	Credentials
+}
+
+// PusherConfig binds an identity authenticated by the ingest auth policy, such as serviceaccount:<namespace>/<name>,
+// to the clusters it may push snapshots of.
+type PusherConfig struct {
+	Identity string   `json:"identity"`
+	Clusters []string `json:"clusters"`
+}
+
+// FileConfig is the federation config file. A mapper aggregates clusters by pulling them, accepting their pushes, or
+// both, and a mapper whose cluster is aggregated by another may push its snapshot instead of waiting to be pulled.
+type FileConfig struct {
+	Clusters   []ClusterConfig `json:"clusters,omitempty"`
+	AcceptPush bool            `json:"acceptPush,omitempty"`
+	Pushers    []PusherConfig  `json:"pushers,omitempty"`
+	Push       *PushConfig     `json:"push,omitempty"`
+}
+
+func LoadConfigFile(path string) (FileConfig, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return FileConfig{}, errors.Wrap(err)
+	}
+	var fileConfig FileConfig
+	if err := yaml.UnmarshalStrict(content, &fileConfig); err != nil {
+		return FileConfig{}, errors.Errorf("failed parsing federation config file %s: %w", path, err)
+	}
+	return fileConfig, nil
+}
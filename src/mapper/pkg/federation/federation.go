@@ -0,0 +1,334 @@
+package federation
+
+import (
+	"bytes"
+	"cmp"
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/Khan/genqlient/graphql"
+	"github.com/bugsnag/bugsnag-go/v2"
+	"github.com/labstack/echo/v4"
+	"github.com/otterize/intents-operator/src/shared/errors"
+	"github.com/otterize/network-mapper/src/mapper/pkg/config"
+	"github.com/otterize/network-mapper/src/mapper/pkg/graph/model"
+	"github.com/otterize/network-mapper/src/mapper/pkg/ingestauth"
+	"github.com/otterize/network-mapper/src/mapperclient"
+	"github.com/samber/lo"
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/viper"
+	"io"
+	"net/http"
+	"slices"
+	"sync"
+	"time"
+)
+
+const (
+	// PushPath is where mappers accepting pushes receive snapshots.
+	PushPath = "/federation/snapshots"
+	// PushOperation is the name pushes are authorized as by the ingest auth policy, as if they were a mutation.
+	PushOperation = "reportClusterSnapshot"
+	// pushedSnapshotTTLIntervals is how many sync intervals a pushed snapshot is served for without being pushed again.
+	pushedSnapshotTTLIntervals = 3
+)
+
+type SnapshotFunc func(ctx context.Context) (ClusterSnapshot, error)
+
+type clusterState struct {
+	snapshot    *ClusterSnapshot
+	lastUpdated *time.Time
+	lastError   error
+	pushed      bool
+}
+
+type pulledCluster struct {
+	name   string
+	client graphql.Client
+}
+
+// Federation holds the snapshots of the federated clusters, pulling them periodically or receiving them from the
+// clusters' mappers. A nil *Federation has no clusters.
+type Federation struct {
+	localCluster string
+	pulled       []pulledCluster
+	acceptPush   bool
+	pushers      map[string][]string
+	pushedTTL    time.Duration
+	push         *PushConfig
+	pushClient   *http.Client
+	lock         sync.Mutex
+	clusters     map[string]*clusterState
+}
+
+// FromViper creates the federation described by the federation config file. Without a config file, the returned
+// federation is nil.
+func FromViper() (*Federation, error) {
+	configFile := viper.GetString(config.FederationConfigFileKey)
+	if configFile == "" {
+		return nil, nil
+	}
+	fileConfig, err := LoadConfigFile(configFile)
+	if err != nil {
+		return nil, errors.Wrap(err)
+	}
+	federation, err := NewFederation(fileConfig, viper.GetString(config.ClusterKey))
+	if err != nil {
+		return nil, errors.Errorf("invalid federation config file %s: %w", configFile, err)
+	}
+	return federation, nil
+}
+
+func NewFederation(fileConfig FileConfig, localCluster string) (*Federation, error) {
+	f := &Federation{
+		localCluster: localCluster,
+		acceptPush:   fileConfig.AcceptPush,
+		pushers:      make(map[string][]string),
+		pushedTTL:    pushedSnapshotTTLIntervals * viper.GetDuration(config.FederationSyncIntervalKey),
+		push:         fileConfig.Push,
+		clusters:     make(map[string]*clusterState),
+	}
+	for _, cluster := range fileConfig.Clusters {
+		if cluster.Name == "" || cluster.URL == "" {
+			return nil, errors.New("federated clusters require a name and a url")
+		}
+		if cluster.Name == localCluster {
+			return nil, errors.Errorf("federated cluster %q has the same name as this cluster", cluster.Name)
+		}
+		if _, found := f.clusters[cluster.Name]; found {
+			return nil, errors.Errorf("federated cluster %q is configured more than once", cluster.Name)
+		}
+		httpClient, err := mapperclient.NewHTTPClient(cluster.options()...)
+		if err != nil {
+			return nil, errors.Errorf("federated cluster %q: %w", cluster.Name, err)
+		}
+		f.pulled = append(f.pulled, pulledCluster{name: cluster.Name, client: graphql.NewClient(cluster.URL, httpClient)})
+		f.clusters[cluster.Name] = &clusterState{}
+	}
+	if err := f.bindPushers(fileConfig.Pushers); err != nil {
+		return nil, errors.Wrap(err)
+	}
+	if f.push != nil {
+		if f.push.URL == "" {
+			return nil, errors.New("push requires a url")
+		}
+		httpClient, err := mapperclient.NewHTTPClient(f.push.options()...)
+		if err != nil {
+			return nil, errors.Errorf("push: %w", err)
+		}
+		f.pushClient = httpClient
+	}
+	return f, nil
+}
+
+// bindPushers records the clusters each identity may push. Pushes must be bound to identities, since any caller
+// allowed to push could otherwise replace the snapshot of any cluster.
+func (f *Federation) bindPushers(pushers []PusherConfig) error {
+	if !f.acceptPush {
+		if len(pushers) != 0 {
+			return errors.New("pushers are only used with acceptPush")
+		}
+		return nil
+	}
+	if len(pushers) == 0 {
+		return errors.New("acceptPush requires pushers, binding the identities allowed to push to their clusters")
+	}
+	for _, pusher := range pushers {
+		if pusher.Identity == "" || len(pusher.Clusters) == 0 {
+			return errors.New("pushers require an identity and clusters")
+		}
+		for _, cluster := range pusher.Clusters {
+			if err := f.validatePushedCluster(cluster); err != nil {
+				return errors.Errorf("pusher %q: %w", pusher.Identity, err)
+			}
+		}
+		f.pushers[pusher.Identity] = append(f.pushers[pusher.Identity], pusher.Clusters...)
+	}
+	return nil
+}
+
+func (f *Federation) validatePushedCluster(cluster string) error {
+	if cluster == "" {
+		return errors.New("cluster name is empty")
+	}
+	if cluster == f.localCluster {
+		return errors.Errorf("cluster %q is this cluster", cluster)
+	}
+	if lo.ContainsBy(f.pulled, func(pulled pulledCluster) bool { return pulled.name == cluster }) {
+		return errors.Errorf("cluster %q is pulled, not pushed", cluster)
+	}
+	return nil
+}
+
+// expirePushedSnapshots stops serving pushed snapshots that were not pushed again within the TTL, so a cluster that
+// stopped pushing does not linger in the merged graph. Must be called with the lock held.
+func (f *Federation) expirePushedSnapshots() {
+	for _, state := range f.clusters {
+		if state.pushed && state.snapshot != nil && time.Since(*state.lastUpdated) > f.pushedTTL {
+			state.snapshot = nil
+			state.lastError = errors.Errorf("no snapshot was pushed in the last %s", f.pushedTTL)
+		}
+	}
+}
+
+// Snapshots returns the last snapshot of every federated cluster, not including this one.
+func (f *Federation) Snapshots() []ClusterSnapshot {
+	if f == nil {
+		return nil
+	}
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	f.expirePushedSnapshots()
+
+	snapshots := make([]ClusterSnapshot, 0, len(f.clusters))
+	for _, state := range f.clusters {
+		if state.snapshot != nil {
+			snapshots = append(snapshots, *state.snapshot)
+		}
+	}
+	slices.SortFunc(snapshots, func(a, b ClusterSnapshot) int {
+		return cmp.Compare(a.Cluster, b.Cluster)
+	})
+	return snapshots
+}
+
+// Clusters returns the state of every federated cluster, not including this one.
+func (f *Federation) Clusters() []model.FederatedCluster {
+	if f == nil {
+		return nil
+	}
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	f.expirePushedSnapshots()
+
+	clusters := make([]model.FederatedCluster, 0, len(f.clusters))
+	for name, state := range f.clusters {
+		cluster := model.FederatedCluster{Name: name, LastUpdated: state.lastUpdated}
+		if state.lastError != nil {
+			cluster.LastError = lo.ToPtr(state.lastError.Error())
+		}
+		clusters = append(clusters, cluster)
+	}
+	slices.SortFunc(clusters, func(a, b model.FederatedCluster) int {
+		return cmp.Compare(a.Name, b.Name)
+	})
+	return clusters
+}
+
+func (f *Federation) setSnapshot(snapshot ClusterSnapshot, pushed bool) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	state, found := f.clusters[snapshot.Cluster]
+	if !found {
+		state = &clusterState{pushed: pushed}
+		f.clusters[snapshot.Cluster] = state
+	}
+	state.snapshot = &snapshot
+	state.lastUpdated = lo.ToPtr(time.Now())
+	state.lastError = nil
+}
+
+func (f *Federation) setError(cluster string, err error) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	f.clusters[cluster].lastError = err
+}
+
+// RunForever pulls the federated clusters, and pushes this cluster's snapshot if configured to, every sync interval.
+func (f *Federation) RunForever(ctx context.Context, localSnapshot SnapshotFunc) error {
+	interval := viper.GetDuration(config.FederationSyncIntervalKey)
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		syncCtx, cancel := context.WithTimeout(ctx, interval)
+		f.sync(syncCtx, localSnapshot)
+		cancel()
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+func (f *Federation) sync(ctx context.Context, localSnapshot SnapshotFunc) {
+	var wg sync.WaitGroup
+	for _, cluster := range f.pulled {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			defer bugsnag.AutoNotify(ctx)
+			snapshot, err := pull(ctx, cluster)
+			if err != nil {
+				logrus.WithError(err).WithField("cluster", cluster.name).Error("Failed to pull federated cluster")
+				f.setError(cluster.name, err)
+				return
+			}
+			f.setSnapshot(snapshot, false)
+		}()
+	}
+	if f.push != nil {
+		if err := f.pushSnapshot(ctx, localSnapshot); err != nil {
+			logrus.WithError(err).Error("Failed to push snapshot to federation aggregator")
+		}
+	}
+	wg.Wait()
+}
+
+func (f *Federation) pushSnapshot(ctx context.Context, localSnapshot SnapshotFunc) error {
+	snapshot, err := localSnapshot(ctx)
+	if err != nil {
+		return errors.Wrap(err)
+	}
+	body, err := json.Marshal(snapshot)
+	if err != nil {
+		return errors.Wrap(err)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, f.push.URL, bytes.NewReader(body))
+	if err != nil {
+		return errors.Wrap(err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := f.pushClient.Do(req)
+	if err != nil {
+		return errors.Wrap(err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode >= 300 {
+		message, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return errors.Errorf("aggregator returned %s: %s", resp.Status, message)
+	}
+	return nil
+}
+
+// RegisterPushHandler serves PushPath if this mapper accepts pushes. Pushes are only accepted from callers authPolicy
+// allows to call PushOperation, and only for the clusters their identity is bound to by the pushers config.
+func (f *Federation) RegisterPushHandler(e *echo.Echo, authPolicy *ingestauth.Policy) {
+	if f == nil || !f.acceptPush {
+		return
+	}
+	e.POST(PushPath, func(c echo.Context) error {
+		req := c.Request()
+		identity, err := authPolicy.Authenticate(req.Context(), PushOperation, ingestauth.CredentialsFromHTTPRequest(req))
+		if err != nil {
+			return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+		}
+		body := http.MaxBytesReader(c.Response(), req.Body, viper.GetInt64(config.FederationPushMaxBytesKey))
+		var snapshot ClusterSnapshot
+		if err := json.NewDecoder(body).Decode(&snapshot); err != nil {
+			var maxBytesErr *http.MaxBytesError
+			if errors.As(err, &maxBytesErr) {
+				return echo.NewHTTPError(http.StatusRequestEntityTooLarge, fmt.Sprintf("snapshot is larger than %d bytes", maxBytesErr.Limit))
+			}
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("invalid snapshot: %s", err))
+		}
+		if !lo.Contains(f.pushers[identity], snapshot.Cluster) {
+			logrus.WithFields(logrus.Fields{"identity": identity, "cluster": snapshot.Cluster}).Warn("Rejected snapshot pushed for a cluster the caller is not bound to")
+			return echo.NewHTTPError(http.StatusForbidden, fmt.Sprintf("%q may not push cluster %q", identity, snapshot.Cluster))
+		}
+		logrus.WithField("cluster", snapshot.Cluster).Debug("Received federated cluster snapshot")
+		f.setSnapshot(snapshot, true)
+		return c.NoContent(http.StatusNoContent)
+	})
+}
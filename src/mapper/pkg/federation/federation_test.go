@@ -0,0 +1,183 @@
+package federation
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/labstack/echo/v4"
+	"github.com/otterize/network-mapper/src/mapper/pkg/config"
+	"github.com/otterize/network-mapper/src/mapper/pkg/graph/model"
+	"github.com/otterize/network-mapper/src/mapper/pkg/ingestauth"
+	"github.com/samber/lo"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/suite"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+type FederationTestSuite struct {
+	suite.Suite
+}
+
+type graphqlRequest struct {
+	OperationName string         `json:"operationName"`
+	Variables     map[string]any `json:"variables"`
+}
+
+func (s *FederationTestSuite) TestPull() {
+	var authorization string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		authorization = r.Header.Get("Authorization")
+		var req graphqlRequest
+		s.Require().NoError(json.NewDecoder(r.Body).Decode(&req))
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case req.OperationName == "PullIntents":
+			_, _ = w.Write([]byte(`{"data": {
+				"intents": [{"client": {"name": "checkout", "namespace": "shop"}, "server": {"name": "cart", "namespace": "shop"}}],
+				"workloadAddresses": [{"identity": {"name": "cart", "namespace": "shop"}, "ips": ["10.1.0.5"]}]
+			}}`))
+		case req.Variables["after"] == nil:
+			_, _ = w.Write([]byte(`{"data": {"externalIntentsPage": {"intents": [{"client": {"name": "checkout", "namespace": "shop", "kind": "Deployment"}, "dnsName": "api.stripe.com", "lastSeen": "", "addresses": [], "status": "PENDING"}], "nextCursor": "1"}}}`))
+		default:
+			_, _ = w.Write([]byte(`{"data": {"externalIntentsPage": {"intents": [{"client": {"name": "cart", "namespace": "shop", "kind": "Deployment"}, "dnsName": "api.example.com", "lastSeen": "", "addresses": [], "status": "PENDING"}], "nextCursor": null}}}`))
+		}
+	}))
+	defer server.Close()
+
+	federation, err := NewFederation(FileConfig{Clusters: []ClusterConfig{
+		{Name: "west", URL: server.URL, Credentials: Credentials{BearerToken: "token"}},
+	}}, "east")
+	s.Require().NoError(err)
+	s.Require().Empty(federation.Snapshots())
+
+	federation.sync(context.Background(), nil)
+	s.Require().Equal("Bearer token", authorization)
+	snapshots := federation.Snapshots()
+	s.Require().Len(snapshots, 1)
+	s.Require().Equal("west", snapshots[0].Cluster)
+	s.Require().Len(snapshots[0].Intents, 1)
+	s.Require().Equal("cart", snapshots[0].Intents[0].Server.Name)
+	s.Require().Equal([]string{"10.1.0.5"}, snapshots[0].WorkloadAddresses[0].Ips)
+	s.Require().Len(snapshots[0].ExternalIntents, 2)
+
+	clusters := federation.Clusters()
+	s.Require().Len(clusters, 1)
+	s.Require().NotNil(clusters[0].LastUpdated)
+	s.Require().Nil(clusters[0].LastError)
+
+	// A failed pull keeps serving the last snapshot
+	server.Close()
+	federation.sync(context.Background(), nil)
+	s.Require().Len(federation.Snapshots(), 1)
+	s.Require().NotNil(federation.Clusters()[0].LastError)
+}
+
+func (s *FederationTestSuite) newAggregator() (*Federation, *httptest.Server) {
+	policy, err := ingestauth.NewPolicy(ingestauth.FileConfig{
+		DefaultSchemes: []string{ingestauth.SchemeBearer},
+		Bearer:         &ingestauth.BearerConfig{Tokens: []string{"token", "other-token"}},
+	}, []string{PushOperation}, nil)
+	s.Require().NoError(err)
+
+	aggregator, err := NewFederation(FileConfig{
+		AcceptPush: true,
+		Pushers:    []PusherConfig{{Identity: "bearer-token-0", Clusters: []string{"west", "south"}}},
+		Clusters:   []ClusterConfig{{Name: "north", URL: "http://north"}},
+	}, "east")
+	s.Require().NoError(err)
+	e := echo.New()
+	aggregator.RegisterPushHandler(e, policy)
+	server := httptest.NewServer(e)
+	s.T().Cleanup(server.Close)
+	return aggregator, server
+}
+
+func (s *FederationTestSuite) TestPush() {
+	aggregator, server := s.newAggregator()
+
+	snapshot := ClusterSnapshot{Cluster: "west", Intents: []model.Intent{{Client: identity("shop", "checkout"), Server: identity("shop", "cart")}}}
+	push := func(snapshot ClusterSnapshot, token string) error {
+		pusher, err := NewFederation(FileConfig{Push: &PushConfig{URL: server.URL + PushPath, Credentials: Credentials{BearerToken: token}}}, snapshot.Cluster)
+		s.Require().NoError(err)
+		return pusher.pushSnapshot(context.Background(), func(context.Context) (ClusterSnapshot, error) { return snapshot, nil })
+	}
+
+	s.Require().Error(push(snapshot, "wrong"))
+	s.Require().Empty(aggregator.Snapshots())
+
+	s.Require().NoError(push(snapshot, "token"))
+	snapshots := aggregator.Snapshots()
+	s.Require().Len(snapshots, 1)
+	s.Require().Equal(snapshot, snapshots[0])
+
+	// Pushes may only be made for the clusters the caller's identity is bound to
+	s.Require().Error(push(ClusterSnapshot{Cluster: "east"}, "token"))
+	s.Require().Error(push(ClusterSnapshot{Cluster: "north"}, "token"))
+	s.Require().Error(push(ClusterSnapshot{Cluster: "south"}, "other-token"))
+	s.Require().Len(aggregator.Snapshots(), 1)
+}
+
+func (s *FederationTestSuite) TestPushBodySizeIsLimited() {
+	viper.Set(config.FederationPushMaxBytesKey, 1024)
+	s.T().Cleanup(func() { viper.Set(config.FederationPushMaxBytesKey, config.FederationPushMaxBytesDefault) })
+	_, server := s.newAggregator()
+
+	body := fmt.Sprintf(`{"cluster": "west", "intents": [], "padding": %q}`, strings.Repeat("x", 2048))
+	req, err := http.NewRequest(http.MethodPost, server.URL+PushPath, strings.NewReader(body))
+	s.Require().NoError(err)
+	req.Header.Set("Authorization", "Bearer token")
+	resp, err := http.DefaultClient.Do(req)
+	s.Require().NoError(err)
+	defer resp.Body.Close()
+	s.Require().Equal(http.StatusRequestEntityTooLarge, resp.StatusCode)
+}
+
+func (s *FederationTestSuite) TestPushedSnapshotsExpire() {
+	aggregator, err := NewFederation(FileConfig{AcceptPush: true, Pushers: []PusherConfig{{Identity: "bearer-token-0", Clusters: []string{"west"}}}}, "east")
+	s.Require().NoError(err)
+	aggregator.setSnapshot(ClusterSnapshot{Cluster: "west"}, true)
+	s.Require().Len(aggregator.Snapshots(), 1)
+
+	aggregator.clusters["west"].lastUpdated = lo.ToPtr(time.Now().Add(-aggregator.pushedTTL - time.Second))
+	s.Require().Empty(aggregator.Snapshots())
+	clusters := aggregator.Clusters()
+	s.Require().Len(clusters, 1)
+	s.Require().NotNil(clusters[0].LastError)
+
+	// Pushing again serves the cluster again
+	aggregator.setSnapshot(ClusterSnapshot{Cluster: "west"}, true)
+	s.Require().Len(aggregator.Snapshots(), 1)
+	s.Require().Nil(aggregator.Clusters()[0].LastError)
+}
+
+func (s *FederationTestSuite) TestInvalidConfig() {
+	_, err := NewFederation(FileConfig{Clusters: []ClusterConfig{{Name: "west"}}}, "east")
+	s.Require().Error(err)
+	_, err = NewFederation(FileConfig{Clusters: []ClusterConfig{{Name: "east", URL: "http://east"}}}, "east")
+	s.Require().Error(err)
+	_, err = NewFederation(FileConfig{Clusters: []ClusterConfig{{Name: "west", URL: "http://a"}, {Name: "west", URL: "http://b"}}}, "east")
+	s.Require().Error(err)
+	_, err = NewFederation(FileConfig{Push: &PushConfig{}}, "east")
+	s.Require().Error(err)
+	_, err = NewFederation(FileConfig{AcceptPush: true}, "east")
+	s.Require().Error(err)
+	_, err = NewFederation(FileConfig{AcceptPush: true, Pushers: []PusherConfig{{Identity: "bearer-token-0", Clusters: []string{"east"}}}}, "east")
+	s.Require().Error(err)
+	_, err = NewFederation(FileConfig{Pushers: []PusherConfig{{Identity: "bearer-token-0", Clusters: []string{"west"}}}}, "east")
+	s.Require().Error(err)
+}
+
+func (s *FederationTestSuite) TestNilFederation() {
+	var federation *Federation
+	s.Require().Empty(federation.Snapshots())
+	s.Require().Empty(federation.Clusters())
+	federation.RegisterPushHandler(echo.New(), nil)
+}
+
+func TestFederationTestSuite(t *testing.T) {
+	suite.Run(t, new(FederationTestSuite))
+}
@@ -0,0 +1,209 @@
+package federation
+
+import (
+	"cmp"
+	"fmt"
+	"github.com/otterize/network-mapper/src/mapper/pkg/graph/model"
+	"github.com/samber/lo"
+	"k8s.io/apimachinery/pkg/types"
+	"slices"
+	"strings"
+)
+
+// clusterSetDomainSuffix is the domain of multi-cluster services, <service>.<namespace>.svc.clusterset.local.
+const clusterSetDomainSuffix = ".svc.clusterset.local"
+
+// ClusterSnapshot is what a cluster's mapper knows, as pulled from it or pushed by it.
+type ClusterSnapshot struct {
+	Cluster           string                    `json:"cluster"`
+	Intents           []model.Intent            `json:"intents"`
+	ExternalIntents   []model.ExternalIntent    `json:"externalIntents"`
+	WorkloadAddresses []model.WorkloadAddresses `json:"workloadAddresses"`
+}
+
+// MergeIntents returns the intents of all the snapshots, stamped with their cluster, along with the intents resolved
+// from external traffic between the clusters.
+func MergeIntents(snapshots []ClusterSnapshot) []model.Intent {
+	merged := make([]model.Intent, 0)
+	for _, snapshot := range snapshots {
+		for _, intent := range snapshot.Intents {
+			intent.Client = stampIdentity(intent.Client, snapshot.Cluster)
+			intent.Server = stampIdentity(intent.Server, snapshot.Cluster)
+			merged = append(merged, intent)
+		}
+	}
+	merged = append(merged, resolveCrossClusterIntents(snapshots)...)
+
+	slices.SortFunc(merged, func(a, b model.Intent) int {
+		return cmp.Or(compareIdentities(a.Client, b.Client), compareIdentities(a.Server, b.Server))
+	})
+	return merged
+}
+
+// MergeExternalIntents returns the external intents of all the snapshots, stamped with their cluster.
+func MergeExternalIntents(snapshots []ClusterSnapshot) []model.ExternalIntent {
+	merged := make([]model.ExternalIntent, 0)
+	for _, snapshot := range snapshots {
+		for _, intent := range snapshot.ExternalIntents {
+			if intent.Client != nil {
+				client := *intent.Client
+				client.Cluster = lo.ToPtr(snapshot.Cluster)
+				intent.Client = &client
+			}
+			merged = append(merged, intent)
+		}
+	}
+	return merged
+}
+
+func stampIdentity(identity *model.OtterizeServiceIdentity, cluster string) *model.OtterizeServiceIdentity {
+	if identity == nil {
+		return nil
+	}
+	stamped := *identity
+	stamped.Cluster = lo.ToPtr(cluster)
+	return &stamped
+}
+
+func compareIdentities(a, b *model.OtterizeServiceIdentity) int {
+	return cmp.Or(
+		cmp.Compare(lo.FromPtr(a.Cluster), lo.FromPtr(b.Cluster)),
+		cmp.Compare(a.Namespace, b.Namespace),
+		cmp.Compare(a.Name, b.Name),
+	)
+}
+
+type remoteWorkload struct {
+	cluster  string
+	identity *model.OtterizeServiceIdentity
+}
+
+type crossClusterIntentKey struct {
+	clientCluster string
+	client        types.NamespacedName
+	serverCluster string
+	server        types.NamespacedName
+}
+
+// resolveCrossClusterIntents resolves the external intents of each cluster whose destination is a workload of another
+// cluster: either by the name of a multi-cluster service, or by an IP that belongs to a workload or service of exactly
+// one other cluster - such as a pod IP on a flat network, or an east-west gateway's load balancer IP. IPs belonging to
+// several clusters are ambiguous, and are left unresolved.
+func resolveCrossClusterIntents(snapshots []ClusterSnapshot) []model.Intent {
+	workloadsByIP := make(map[string][]remoteWorkload)
+	workloadsByService := make(map[types.NamespacedName][]remoteWorkload)
+	for _, snapshot := range snapshots {
+		for _, addresses := range snapshot.WorkloadAddresses {
+			if addresses.Identity == nil {
+				continue
+			}
+			workload := remoteWorkload{cluster: snapshot.Cluster, identity: stampIdentity(addresses.Identity, snapshot.Cluster)}
+			for _, ip := range addresses.Ips {
+				workloadsByIP[ip] = append(workloadsByIP[ip], workload)
+			}
+			if addresses.Identity.KubernetesService != nil {
+				service := types.NamespacedName{Namespace: addresses.Identity.Namespace, Name: *addresses.Identity.KubernetesService}
+				workloadsByService[service] = append(workloadsByService[service], workload)
+			}
+		}
+	}
+
+	intents := make(map[crossClusterIntentKey]*model.Intent)
+	keys := make([]crossClusterIntentKey, 0)
+	addIntent := func(client *model.OtterizeServiceIdentity, server remoteWorkload, dnsName string, ports []model.IntentPort) {
+		key := crossClusterIntentKey{
+			clientCluster: lo.FromPtr(client.Cluster),
+			client:        client.AsNamespacedName(),
+			serverCluster: server.cluster,
+			server:        server.identity.AsNamespacedName(),
+		}
+		intent, found := intents[key]
+		if !found {
+			intent = &model.Intent{
+				Client:         client,
+				Server:         server.identity,
+				ResolutionData: lo.ToPtr(fmt.Sprintf("federation: external traffic to %s", dnsName)),
+			}
+			intents[key] = intent
+			keys = append(keys, key)
+		}
+		for _, port := range ports {
+			if !lo.Contains(intent.Ports, port) {
+				intent.Ports = append(intent.Ports, port)
+			}
+		}
+	}
+
+	for _, snapshot := range snapshots {
+		isRemote := func(workload remoteWorkload) bool { return workload.cluster != snapshot.Cluster }
+		for _, externalIntent := range snapshot.ExternalIntents {
+			if externalIntent.Client == nil {
+				continue
+			}
+			client := externalClientIdentity(*externalIntent.Client, snapshot.Cluster)
+
+			if service, isClusterSetName := parseClusterSetName(externalIntent.DNSName); isClusterSetName {
+				ports := lo.Uniq(lo.FilterMap(externalIntent.Addresses, func(address model.ExternalIntentAddress, _ int) (model.IntentPort, bool) {
+					return addressPort(address)
+				}))
+				for _, workload := range lo.Filter(workloadsByService[service], func(workload remoteWorkload, _ int) bool { return isRemote(workload) }) {
+					addIntent(client, workload, externalIntent.DNSName, ports)
+				}
+				continue
+			}
+
+			for _, address := range externalIntent.Addresses {
+				workloads := lo.Filter(workloadsByIP[address.IP], func(workload remoteWorkload, _ int) bool { return isRemote(workload) })
+				clusters := lo.Uniq(lo.Map(workloads, func(workload remoteWorkload, _ int) string { return workload.cluster }))
+				if len(clusters) != 1 {
+					continue
+				}
+				var ports []model.IntentPort
+				if port, hasPort := addressPort(address); hasPort {
+					ports = []model.IntentPort{port}
+				}
+				for _, workload := range workloads {
+					addIntent(client, workload, externalIntent.DNSName, ports)
+				}
+			}
+		}
+	}
+
+	return lo.Map(keys, func(key crossClusterIntentKey, _ int) model.Intent {
+		return *intents[key]
+	})
+}
+
+// addressPort returns the port of an external intent address, if a connection to it was seen. Addresses pulled from
+// mappers that predate recording the protocol were seen on TCP, the only protocol they recorded.
+func addressPort(address model.ExternalIntentAddress) (model.IntentPort, bool) {
+	if address.Port == 0 {
+		return model.IntentPort{}, false
+	}
+	return model.IntentPort{Port: address.Port, Protocol: lo.FromPtrOr(address.Protocol, model.IntentProtocolTCP)}, true
+}
+
+func externalClientIdentity(client model.ExternalClient, cluster string) *model.OtterizeServiceIdentity {
+	identity := &model.OtterizeServiceIdentity{
+		Name:      client.Name,
+		Namespace: client.Namespace,
+		Cluster:   lo.ToPtr(cluster),
+	}
+	if client.Kind != "" {
+		identity.PodOwnerKind = &model.GroupVersionKind{Kind: client.Kind}
+	}
+	return identity
+}
+
+// parseClusterSetName returns the service a <service>.<namespace>.svc.clusterset.local name refers to.
+func parseClusterSetName(dnsName string) (types.NamespacedName, bool) {
+	serviceAndNamespace, found := strings.CutSuffix(strings.TrimSuffix(dnsName, "."), clusterSetDomainSuffix)
+	if !found {
+		return types.NamespacedName{}, false
+	}
+	name, namespace, found := strings.Cut(serviceAndNamespace, ".")
+	if !found || name == "" || namespace == "" || strings.Contains(namespace, ".") {
+		return types.NamespacedName{}, false
+	}
+	return types.NamespacedName{Namespace: namespace, Name: name}, true
+}
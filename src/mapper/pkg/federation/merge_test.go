@@ -0,0 +1,123 @@
+package federation
+
+import (
+	"github.com/otterize/network-mapper/src/mapper/pkg/graph/model"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/suite"
+	"testing"
+)
+
+type MergeTestSuite struct {
+	suite.Suite
+}
+
+func identity(namespace, name string) *model.OtterizeServiceIdentity {
+	return &model.OtterizeServiceIdentity{Name: name, Namespace: namespace}
+}
+
+func externalIntent(namespace, name, dnsName string, addresses ...model.ExternalIntentAddress) model.ExternalIntent {
+	return model.ExternalIntent{
+		Client:    &model.ExternalClient{Name: name, Namespace: namespace, Kind: "Deployment"},
+		DNSName:   dnsName,
+		Addresses: addresses,
+	}
+}
+
+func (s *MergeTestSuite) TestIdentitiesAreStampedWithCluster() {
+	snapshots := []ClusterSnapshot{
+		{Cluster: "east", Intents: []model.Intent{{Client: identity("shop", "checkout"), Server: identity("shop", "cart")}}},
+		{Cluster: "west", Intents: []model.Intent{{Client: identity("shop", "checkout"), Server: identity("shop", "cart")}}},
+	}
+
+	intents := MergeIntents(snapshots)
+	s.Require().Len(intents, 2)
+	s.Require().Equal("east", lo.FromPtr(intents[0].Client.Cluster))
+	s.Require().Equal("east", lo.FromPtr(intents[0].Server.Cluster))
+	s.Require().Equal("west", lo.FromPtr(intents[1].Client.Cluster))
+	s.Require().Equal("west", lo.FromPtr(intents[1].Server.Cluster))
+	// The snapshots themselves are left untouched
+	s.Require().Nil(snapshots[0].Intents[0].Client.Cluster)
+
+	externalIntents := MergeExternalIntents([]ClusterSnapshot{
+		{Cluster: "east", ExternalIntents: []model.ExternalIntent{externalIntent("shop", "checkout", "api.stripe.com")}},
+	})
+	s.Require().Len(externalIntents, 1)
+	s.Require().Equal("east", lo.FromPtr(externalIntents[0].Client.Cluster))
+}
+
+func (s *MergeTestSuite) TestCrossClusterIntentByIP() {
+	snapshots := []ClusterSnapshot{
+		{
+			Cluster: "east",
+			ExternalIntents: []model.ExternalIntent{externalIntent("shop", "checkout", "payments.west.example.com",
+				model.ExternalIntentAddress{IP: "10.1.0.5", Port: 8443},
+			)},
+		},
+		{
+			Cluster:           "west",
+			WorkloadAddresses: []model.WorkloadAddresses{{Identity: identity("billing", "payments"), Ips: []string{"10.1.0.5", "10.1.0.6"}}},
+		},
+	}
+
+	intents := MergeIntents(snapshots)
+	s.Require().Len(intents, 1)
+	s.Require().Equal("east", lo.FromPtr(intents[0].Client.Cluster))
+	s.Require().Equal("checkout", intents[0].Client.Name)
+	s.Require().Equal("Deployment", intents[0].Client.PodOwnerKind.Kind)
+	s.Require().Equal("west", lo.FromPtr(intents[0].Server.Cluster))
+	s.Require().Equal("payments", intents[0].Server.Name)
+	s.Require().Equal([]model.IntentPort{{Port: 8443, Protocol: model.IntentProtocolTCP}}, intents[0].Ports)
+}
+
+func (s *MergeTestSuite) TestCrossClusterIntentByClusterSetName() {
+	payments := identity("billing", "payments")
+	payments.KubernetesService = lo.ToPtr("payments")
+	snapshots := []ClusterSnapshot{
+		{
+			Cluster: "east",
+			ExternalIntents: []model.ExternalIntent{externalIntent("shop", "checkout", "payments.billing.svc.clusterset.local",
+				model.ExternalIntentAddress{IP: "240.0.0.1", Port: 443, Protocol: lo.ToPtr(model.IntentProtocolTCP)},
+				model.ExternalIntentAddress{IP: "240.0.0.1", Port: 53, Protocol: lo.ToPtr(model.IntentProtocolUDP)},
+			)},
+		},
+		{Cluster: "west", WorkloadAddresses: []model.WorkloadAddresses{{Identity: payments, Ips: []string{"34.1.2.3"}}}},
+	}
+
+	intents := MergeIntents(snapshots)
+	s.Require().Len(intents, 1)
+	s.Require().Equal("west", lo.FromPtr(intents[0].Server.Cluster))
+	s.Require().Equal("payments", intents[0].Server.Name)
+	s.Require().Equal([]model.IntentPort{{Port: 443, Protocol: model.IntentProtocolTCP}, {Port: 53, Protocol: model.IntentProtocolUDP}}, intents[0].Ports)
+}
+
+func (s *MergeTestSuite) TestAmbiguousAndLocalIPsAreNotResolved() {
+	snapshots := []ClusterSnapshot{
+		{
+			Cluster: "east",
+			ExternalIntents: []model.ExternalIntent{externalIntent("shop", "checkout", "overlapping.example.com",
+				model.ExternalIntentAddress{IP: "10.0.0.1", Port: 443},
+				model.ExternalIntentAddress{IP: "10.0.0.2", Port: 443},
+			)},
+			WorkloadAddresses: []model.WorkloadAddresses{{Identity: identity("shop", "cart"), Ips: []string{"10.0.0.2"}}},
+		},
+		{Cluster: "west", WorkloadAddresses: []model.WorkloadAddresses{{Identity: identity("billing", "payments"), Ips: []string{"10.0.0.1"}}}},
+		{Cluster: "north", WorkloadAddresses: []model.WorkloadAddresses{{Identity: identity("billing", "payments"), Ips: []string{"10.0.0.1"}}}},
+	}
+
+	s.Require().Empty(MergeIntents(snapshots))
+}
+
+func (s *MergeTestSuite) TestParseClusterSetName() {
+	service, ok := parseClusterSetName("payments.billing.svc.clusterset.local.")
+	s.Require().True(ok)
+	s.Require().Equal("billing/payments", service.String())
+
+	_, ok = parseClusterSetName("payments.billing.svc.cluster.local")
+	s.Require().False(ok)
+	_, ok = parseClusterSetName("billing.svc.clusterset.local")
+	s.Require().False(ok)
+}
+
+func TestMergeTestSuite(t *testing.T) {
+	suite.Run(t, new(MergeTestSuite))
+}
@@ -0,0 +1,98 @@
+package federation
+
+import (
+	"context"
+	"github.com/Khan/genqlient/graphql"
+	"github.com/otterize/intents-operator/src/shared/errors"
+	"github.com/otterize/network-mapper/src/mapper/pkg/graph/model"
+)
+
+const pullExternalIntentsPageSize = 1000
+
+const identityFields = `
+	name
+	namespace
+	nameResolvedUsingAnnotation
+	podOwnerKind { group version kind }
+	kubernetesService
+`
+
+const pullIntentsQuery = `query PullIntents {
+	intents {
+		client {` + identityFields + `}
+		server {` + identityFields + `}
+		type
+		protocol
+		ports { port protocol }
+		resolutionData
+		kafkaTopics { name operations }
+		httpResources { path methods }
+		awsActions
+	}
+	workloadAddresses {
+		identity {` + identityFields + `}
+		ips
+	}
+}`
+
+const pullExternalIntentsQuery = `query PullExternalIntents($first: Int, $after: String) {
+	externalIntentsPage(first: $first, after: $after) {
+		intents {
+			client { name namespace kind }
+			dnsName
+			lastSeen
+			addresses { ip port protocol firstSeen lastSeen }
+			status
+			statusReason
+			statusUpdatedAt
+		}
+		nextCursor
+	}
+}`
+
+type pullIntentsResponse struct {
+	Intents           []model.Intent            `json:"intents"`
+	WorkloadAddresses []model.WorkloadAddresses `json:"workloadAddresses"`
+}
+
+type pullExternalIntentsResponse struct {
+	ExternalIntentsPage model.ExternalIntentsPage `json:"externalIntentsPage"`
+}
+
+func pull(ctx context.Context, cluster pulledCluster) (ClusterSnapshot, error) {
+	var intents pullIntentsResponse
+	err := cluster.client.MakeRequest(ctx,
+		&graphql.Request{OpName: "PullIntents", Query: pullIntentsQuery},
+		&graphql.Response{Data: &intents},
+	)
+	if err != nil {
+		return ClusterSnapshot{}, errors.Wrap(err)
+	}
+
+	snapshot := ClusterSnapshot{
+		Cluster:           cluster.name,
+		Intents:           intents.Intents,
+		ExternalIntents:   make([]model.ExternalIntent, 0),
+		WorkloadAddresses: intents.WorkloadAddresses,
+	}
+	var after *string
+	for {
+		var page pullExternalIntentsResponse
+		err := cluster.client.MakeRequest(ctx,
+			&graphql.Request{
+				OpName:    "PullExternalIntents",
+				Query:     pullExternalIntentsQuery,
+				Variables: map[string]any{"first": pullExternalIntentsPageSize, "after": after},
+			},
+			&graphql.Response{Data: &page},
+		)
+		if err != nil {
+			return ClusterSnapshot{}, errors.Wrap(err)
+		}
+		snapshot.ExternalIntents = append(snapshot.ExternalIntents, page.ExternalIntentsPage.Intents...)
+		if page.ExternalIntentsPage.NextCursor == nil {
+			return snapshot, nil
+		}
+		after = page.ExternalIntentsPage.NextCursor
+	}
+}
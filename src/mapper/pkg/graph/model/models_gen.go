@@ -33,6 +33,37 @@ type CaptureTCPResults struct {
 	Results []RecordedDestinationsForSrc `json:"results"`
 }
 
+type CaptureUDPResults struct {
+	Results []RecordedDestinationsForSrc `json:"results"`
+}
+
+type ClientIntentsManifest struct {
+	ClientName string  `json:"clientName"`
+	Namespace  string  `json:"namespace"`
+	Kind       *string `json:"kind,omitempty"`
+	// The internet domains the client accessed. Subdomains seen under the same parent domain are collapsed into a wildcard.
+	Domains []string `json:"domains"`
+	// The ClientIntents (k8s.otterize.com/v2alpha1) manifest, ready to be applied.
+	Yaml string `json:"yaml"`
+}
+
+type DeniedIntent struct {
+	Client *OtterizeServiceIdentity `json:"client"`
+	// The server, or null for traffic to a destination outside the cluster.
+	Server *OtterizeServiceIdentity `json:"server,omitempty"`
+	// The DNS name of a destination outside the cluster, whose traffic is evaluated against the client's egress policies.
+	DNSName *string `json:"dnsName,omitempty"`
+	// The IPs of a destination outside the cluster that would be denied.
+	Ips []string `json:"ips,omitempty"`
+	// The observed ports that would be denied. Empty when the intent's ports were not observed, and no port would be
+	// allowed.
+	Ports []IntentPort `json:"ports"`
+	// The directions denying the traffic: INGRESS for the server's policies, EGRESS for the client's.
+	DeniedBy []NetworkPolicyDirection `json:"deniedBy"`
+	// The policies selecting the pods on the denying side, as <namespace>/<name>, none of which allow the traffic.
+	Policies []string `json:"policies"`
+}
+
 type Destination struct {
 	Destination     string    `json:"destination"`
 	DestinationIP   *string   `json:"destinationIP,omitempty"`
@@ -46,12 +77,62 @@ type ExternalClient struct {
 	Name      string `json:"name"`
 	Namespace string `json:"namespace"`
 	Kind      string `json:"kind"`
+	// The cluster the client is in. Only set by the federation queries.
+	Cluster *string `json:"cluster,omitempty"`
 }
 
 type ExternalIntent struct {
-	Client   *ExternalClient `json:"client"`
-	DNSName  string          `json:"dnsName"`
-	LastSeen string          `json:"lastSeen"`
+	Client    *ExternalClient         `json:"client"`
+	DNSName   string                  `json:"dnsName"`
+	LastSeen  string                  `json:"lastSeen"`
+	Addresses []ExternalIntentAddress `json:"addresses"`
+	// The intent's approval state. New intents are pending until they are approved or denied.
+	Status          ExternalIntentStatus `json:"status"`
+	StatusReason    *string              `json:"statusReason,omitempty"`
+	StatusUpdatedAt *string              `json:"statusUpdatedAt,omitempty"`
+}
+
+type ExternalIntentAddress struct {
+	IP string `json:"ip"`
+	// The destination port, or 0 when only the DNS resolution was seen.
+	Port int64 `json:"port"`
+	// The transport protocol of the port, or null when only the DNS resolution was seen.
+	Protocol  *IntentProtocol `json:"protocol,omitempty"`
+	FirstSeen string          `json:"firstSeen"`
+	LastSeen  string          `json:"lastSeen"`
+}
+
+type ExternalIntentKey struct {
+	ClientName      string `json:"clientName"`
+	ClientNamespace string `json:"clientNamespace"`
+	ClientKind      string `json:"clientKind"`
+	DNSName         string `json:"dnsName"`
+}
+
+type ExternalIntentsFilter struct {
+	Namespaces  []string               `json:"namespaces,omitempty"`
+	ClientNames []string               `json:"clientNames,omitempty"`
+	ClientKinds []string               `json:"clientKinds,omitempty"`
+	Statuses    []ExternalIntentStatus `json:"statuses,omitempty"`
+	// Matches the DNS name exactly, or all of its subdomains if it is a wildcard such as '*.example.com'.
+	DNSName *string `json:"dnsName,omitempty"`
+	// Bounds on the last seen date, inclusive. Only the date part is compared.
+	LastSeenFrom *time.Time `json:"lastSeenFrom,omitempty"`
+	LastSeenTo   *time.Time `json:"lastSeenTo,omitempty"`
+}
+
+type ExternalIntentsPage struct {
+	Intents []ExternalIntent `json:"intents"`
+	// Pass as 'after' to fetch the next page. Null if this is the last page.
+	NextCursor *string `json:"nextCursor,omitempty"`
+}
+
+type FederatedCluster struct {
+	Name string `json:"name"`
+	// When the cluster's intents were last pulled or pushed. Null if they never were.
+	LastUpdated *time.Time `json:"lastUpdated,omitempty"`
+	// The error of the last pull, if it failed. The intents of the last successful pull are still served.
+	LastError *string `json:"lastError,omitempty"`
 }
 
 type GCPOperation struct {
@@ -86,13 +167,53 @@ type IdentityResolutionData struct {
 }
 
 type Intent struct {
-	Client         *OtterizeServiceIdentity `json:"client"`
-	Server         *OtterizeServiceIdentity `json:"server"`
-	Type           *IntentType              `json:"type,omitempty"`
-	ResolutionData *string                  `json:"resolutionData,omitempty"`
-	KafkaTopics    []KafkaConfig            `json:"kafkaTopics,omitempty"`
-	HTTPResources  []HTTPResource           `json:"httpResources,omitempty"`
-	AwsActions     []string                 `json:"awsActions,omitempty"`
+	Client *OtterizeServiceIdentity `json:"client"`
+	Server *OtterizeServiceIdentity `json:"server"`
+	Type   *IntentType              `json:"type,omitempty"`
+	// The transport protocol the traffic was seen on. Null for TCP and for intents not discovered from network traffic.
+	Protocol *IntentProtocol `json:"protocol,omitempty"`
+	// The destination ports the client was seen connecting to, for intents discovered from network traffic. For intents
+	// to a Kubernetes service, these are the service's ports rather than the pods' target ports.
+	Ports          []IntentPort   `json:"ports,omitempty"`
+	ResolutionData *string        `json:"resolutionData,omitempty"`
+	KafkaTopics    []KafkaConfig  `json:"kafkaTopics,omitempty"`
+	HTTPResources  []HTTPResource `json:"httpResources,omitempty"`
+	AwsActions     []string       `json:"awsActions,omitempty"`
+}
+
+type IntentActivityDay struct {
+	// The day, as YYYY-MM-DD in UTC.
+	Date string `json:"date"`
+	// The number of upload intervals the intent was seen in during the day.
+	Count int64 `json:"count"`
+}
+
+type IntentHistory struct {
+	Kind IntentHistoryKind `json:"kind"`
+	// The client's name, or the source IP of INCOMING traffic.
+	ClientName string `json:"clientName"`
+	// Empty for INCOMING traffic.
+	ClientNamespace string `json:"clientNamespace"`
+	// The server's name, the DNS name of EXTERNAL traffic, the ARN of AWS intents, the resource of GCP intents or the scope
+	// of AZURE intents.
+	ServerName string `json:"serverName"`
+	// Empty for servers outside the cluster.
+	ServerNamespace string    `json:"serverNamespace"`
+	FirstSeen       time.Time `json:"firstSeen"`
+	LastSeen        time.Time `json:"lastSeen"`
+	// The days the intent was seen on within the requested range, oldest first. Days it was not seen on are left out.
+	Activity []IntentActivityDay `json:"activity"`
+}
+
+type IntentHistoryServer struct {
+	Name string `json:"name"`
+	// Matches servers in any namespace if omitted, and servers outside the cluster if empty.
+	Namespace *string `json:"namespace,omitempty"`
+}
+
+type IntentPort struct {
+	Port     int64          `json:"port"`
+	Protocol IntentProtocol `json:"protocol"`
 }
 
 type IstioConnection struct {
@@ -128,6 +249,9 @@ type KafkaMapperResults struct {
 	Results []KafkaMapperResult `json:"results"`
 }
 
+// The report mutations queue the reported results for handling. If the queue for the results is full, they fail with an
+// error whose extensions.code is RETRY_AFTER, and whose extensions also hold retryAfterMs, queueDepth and queueCapacity.
+// The results were not accepted, and should be reported again after retryAfterMs.
 type Mutation struct {
 }
 
@@ -146,6 +270,8 @@ type OtterizeServiceIdentity struct {
 	PodOwnerKind *GroupVersionKind `json:"podOwnerKind,omitempty"`
 	// If the service identity was resolved from a Kubernetes service, its name.
 	KubernetesService *string `json:"kubernetesService,omitempty"`
+	// The cluster the identity is in. Only set by the federation queries.
+	Cluster *string `json:"cluster,omitempty"`
 }
 
 type PodLabel struct {
@@ -153,6 +279,21 @@ type PodLabel struct {
 	Value string `json:"value"`
 }
 
+type PolicyDrift struct {
+	Type PolicyDriftType `json:"type"`
+	// The client's namespace.
+	Namespace string `json:"namespace"`
+	Client    string `json:"client"`
+	// The ClientIntents declaring the target, for UNOBSERVED drift.
+	ClientIntentsName *string `json:"clientIntentsName,omitempty"`
+	// The server as <name>.<namespace>. For UNOBSERVED drift to a Kubernetes service, the name is the service's.
+	Server string `json:"server"`
+	// The Kubernetes service the traffic was sent to, for UNDECLARED drift through a service.
+	ServerKubernetesService *string `json:"serverKubernetesService,omitempty"`
+	// When the traffic was last seen, for UNDECLARED drift.
+	LastSeen *time.Time `json:"lastSeen,omitempty"`
+}
+
 type Query struct {
 }
 
@@ -176,6 +317,23 @@ type SocketScanResults struct {
 	Results []RecordedDestinationsForSrc `json:"results"`
 }
 
+type StaleIntent struct {
+	// The ClientIntents resource declaring the target.
+	ClientIntentsName string `json:"clientIntentsName"`
+	Namespace         string `json:"namespace"`
+	// The client workload the ClientIntents applies to.
+	Client     string                `json:"client"`
+	ClientKind string                `json:"clientKind"`
+	TargetType StaleIntentTargetType `json:"targetType"`
+	// The target as <name>.<namespace> for services and Kafka servers, or the declared domain for internet targets.
+	Target     string  `json:"target"`
+	KafkaTopic *string `json:"kafkaTopic,omitempty"`
+	// When the client was last seen accessing the target. Null if it was not seen within the retention of the mapper.
+	LastSeen *time.Time `json:"lastSeen,omitempty"`
+	// When the ClientIntents resource was created. Targets are not stale before it is older than the threshold.
+	DeclaredAt time.Time `json:"declaredAt"`
+}
+
 type TCPDestResolveBugfixData struct {
 	IsSrcControlPlane bool `json:"isSrcControlPlane"`
 	ResolvedUsingIP   bool `json:"resolvedUsingIp"`
@@ -192,6 +350,64 @@ type TrafficLevelResults struct {
 	Results []TrafficLevelResult `json:"results"`
 }
 
+type UnevaluatedIntent struct {
+	Client *OtterizeServiceIdentity `json:"client"`
+	// The server, or null for traffic to a destination outside the cluster.
+	Server *OtterizeServiceIdentity `json:"server,omitempty"`
+	// The DNS name of a destination outside the cluster.
+	DNSName *string                   `json:"dnsName,omitempty"`
+	Reasons []UnevaluatedIntentReason `json:"reasons"`
+}
+
+type WorkloadAddresses struct {
+	Identity *OtterizeServiceIdentity `json:"identity"`
+	// The pod IPs of a workload, or the load balancer and external IPs of a Kubernetes service.
+	Ips []string `json:"ips"`
+}
+
+type ExternalIntentStatus string
+
+const (
+	ExternalIntentStatusPending  ExternalIntentStatus = "PENDING"
+	ExternalIntentStatusApproved ExternalIntentStatus = "APPROVED"
+	ExternalIntentStatusDenied   ExternalIntentStatus = "DENIED"
+)
+
+var AllExternalIntentStatus = []ExternalIntentStatus{
+	ExternalIntentStatusPending,
+	ExternalIntentStatusApproved,
+	ExternalIntentStatusDenied,
+}
+
+func (e ExternalIntentStatus) IsValid() bool {
+	switch e {
+	case ExternalIntentStatusPending, ExternalIntentStatusApproved, ExternalIntentStatusDenied:
+		return true
+	}
+	return false
+}
+
+func (e ExternalIntentStatus) String() string {
+	return string(e)
+}
+
+func (e *ExternalIntentStatus) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = ExternalIntentStatus(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid ExternalIntentStatus", str)
+	}
+	return nil
+}
+
+func (e ExternalIntentStatus) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
 type HTTPMethod string
 
 const (
@@ -247,6 +463,96 @@ func (e HTTPMethod) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
 
+type IntentHistoryKind string
+
+const (
+	IntentHistoryKindInternal IntentHistoryKind = "INTERNAL"
+	IntentHistoryKindExternal IntentHistoryKind = "EXTERNAL"
+	IntentHistoryKindIncoming IntentHistoryKind = "INCOMING"
+	IntentHistoryKindAws      IntentHistoryKind = "AWS"
+	IntentHistoryKindGcp      IntentHistoryKind = "GCP"
+	IntentHistoryKindAzure    IntentHistoryKind = "AZURE"
+)
+
+var AllIntentHistoryKind = []IntentHistoryKind{
+	IntentHistoryKindInternal,
+	IntentHistoryKindExternal,
+	IntentHistoryKindIncoming,
+	IntentHistoryKindAws,
+	IntentHistoryKindGcp,
+	IntentHistoryKindAzure,
+}
+
+func (e IntentHistoryKind) IsValid() bool {
+	switch e {
+	case IntentHistoryKindInternal, IntentHistoryKindExternal, IntentHistoryKindIncoming, IntentHistoryKindAws, IntentHistoryKindGcp, IntentHistoryKindAzure:
+		return true
+	}
+	return false
+}
+
+func (e IntentHistoryKind) String() string {
+	return string(e)
+}
+
+func (e *IntentHistoryKind) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = IntentHistoryKind(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid IntentHistoryKind", str)
+	}
+	return nil
+}
+
+func (e IntentHistoryKind) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type IntentProtocol string
+
+const (
+	IntentProtocolTCP IntentProtocol = "TCP"
+	IntentProtocolUDP IntentProtocol = "UDP"
+)
+
+var AllIntentProtocol = []IntentProtocol{
+	IntentProtocolTCP,
+	IntentProtocolUDP,
+}
+
+func (e IntentProtocol) IsValid() bool {
+	switch e {
+	case IntentProtocolTCP, IntentProtocolUDP:
+		return true
+	}
+	return false
+}
+
+func (e IntentProtocol) String() string {
+	return string(e)
+}
+
+func (e *IntentProtocol) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = IntentProtocol(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid IntentProtocol", str)
+	}
+	return nil
+}
+
+func (e IntentProtocol) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
 type IntentType string
 
 const (
@@ -352,3 +658,180 @@ func (e *KafkaOperation) UnmarshalGQL(v interface{}) error {
 func (e KafkaOperation) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
+
+type NetworkPolicyDirection string
+
+const (
+	NetworkPolicyDirectionIngress NetworkPolicyDirection = "INGRESS"
+	NetworkPolicyDirectionEgress  NetworkPolicyDirection = "EGRESS"
+)
+
+var AllNetworkPolicyDirection = []NetworkPolicyDirection{
+	NetworkPolicyDirectionIngress,
+	NetworkPolicyDirectionEgress,
+}
+
+func (e NetworkPolicyDirection) IsValid() bool {
+	switch e {
+	case NetworkPolicyDirectionIngress, NetworkPolicyDirectionEgress:
+		return true
+	}
+	return false
+}
+
+func (e NetworkPolicyDirection) String() string {
+	return string(e)
+}
+
+func (e *NetworkPolicyDirection) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = NetworkPolicyDirection(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid NetworkPolicyDirection", str)
+	}
+	return nil
+}
+
+func (e NetworkPolicyDirection) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type PolicyDriftType string
+
+const (
+	// Traffic the mapper observed that no ClientIntents of the client declares. It is blocked by Otterize network
+	// policies protecting the server, or will be once they are enforced.
+	PolicyDriftTypeUndeclared PolicyDriftType = "UNDECLARED"
+	// A target declared in a ClientIntents that the mapper has not observed traffic to.
+	PolicyDriftTypeUnobserved PolicyDriftType = "UNOBSERVED"
+)
+
+var AllPolicyDriftType = []PolicyDriftType{
+	PolicyDriftTypeUndeclared,
+	PolicyDriftTypeUnobserved,
+}
+
+func (e PolicyDriftType) IsValid() bool {
+	switch e {
+	case PolicyDriftTypeUndeclared, PolicyDriftTypeUnobserved:
+		return true
+	}
+	return false
+}
+
+func (e PolicyDriftType) String() string {
+	return string(e)
+}
+
+func (e *PolicyDriftType) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = PolicyDriftType(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid PolicyDriftType", str)
+	}
+	return nil
+}
+
+func (e PolicyDriftType) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type StaleIntentTargetType string
+
+const (
+	// A Kubernetes workload or service, declared as a kubernetes or service target.
+	StaleIntentTargetTypeService StaleIntentTargetType = "SERVICE"
+	// An internet domain, declared in an internet target.
+	StaleIntentTargetTypeInternet StaleIntentTargetType = "INTERNET"
+	// A Kafka topic, or the Kafka server if the target lists no topics.
+	StaleIntentTargetTypeKafka StaleIntentTargetType = "KAFKA"
+)
+
+var AllStaleIntentTargetType = []StaleIntentTargetType{
+	StaleIntentTargetTypeService,
+	StaleIntentTargetTypeInternet,
+	StaleIntentTargetTypeKafka,
+}
+
+func (e StaleIntentTargetType) IsValid() bool {
+	switch e {
+	case StaleIntentTargetTypeService, StaleIntentTargetTypeInternet, StaleIntentTargetTypeKafka:
+		return true
+	}
+	return false
+}
+
+func (e StaleIntentTargetType) String() string {
+	return string(e)
+}
+
+func (e *StaleIntentTargetType) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = StaleIntentTargetType(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid StaleIntentTargetType", str)
+	}
+	return nil
+}
+
+func (e StaleIntentTargetType) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type UnevaluatedIntentReason string
+
+const (
+	// The client has no running pods outside the host network.
+	UnevaluatedIntentReasonNoClientPods UnevaluatedIntentReason = "NO_CLIENT_PODS"
+	// The server has no running pods outside the host network.
+	UnevaluatedIntentReasonNoServerPods UnevaluatedIntentReason = "NO_SERVER_PODS"
+	// No IP was stored for the destination outside the cluster.
+	UnevaluatedIntentReasonNoDestinationIPS UnevaluatedIntentReason = "NO_DESTINATION_IPS"
+)
+
+var AllUnevaluatedIntentReason = []UnevaluatedIntentReason{
+	UnevaluatedIntentReasonNoClientPods,
+	UnevaluatedIntentReasonNoServerPods,
+	UnevaluatedIntentReasonNoDestinationIPS,
+}
+
+func (e UnevaluatedIntentReason) IsValid() bool {
+	switch e {
+	case UnevaluatedIntentReasonNoClientPods, UnevaluatedIntentReasonNoServerPods, UnevaluatedIntentReasonNoDestinationIPS:
+		return true
+	}
+	return false
+}
+
+func (e UnevaluatedIntentReason) String() string {
+	return string(e)
+}
+
+func (e *UnevaluatedIntentReason) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = UnevaluatedIntentReason(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid UnevaluatedIntentReason", str)
+	}
+	return nil
+}
+
+func (e UnevaluatedIntentReason) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
@@ -38,6 +38,10 @@ func (c CaptureTCPResults) Length() int {
 	return len(c.Results)
 }
 
+func (c CaptureUDPResults) Length() int {
+	return len(c.Results)
+}
+
 func (c TrafficLevelResults) Length() int {
 	return len(c.Results)
 }
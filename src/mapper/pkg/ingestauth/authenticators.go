@@ -0,0 +1,177 @@
+package ingestauth
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/subtle"
+	"crypto/x509"
+	"fmt"
+	"github.com/hashicorp/golang-lru/v2/expirable"
+	"github.com/otterize/intents-operator/src/shared/errors"
+	"github.com/samber/lo"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	"os"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"strings"
+)
+
+const tokenReviewCacheSize = 1000
+
+var (
+	ErrNoCredentials       = errors.NewSentinelError("no credentials")
+	ErrInvalidCredentials  = errors.NewSentinelError("invalid credentials")
+	ErrNotAllowedPrincipal = errors.NewSentinelError("caller is not allowed")
+)
+
+// Authenticator verifies the credentials of a caller, returning the caller's identity.
+type Authenticator interface {
+	Authenticate(ctx context.Context, creds Credentials) (string, error)
+}
+
+type bearerAuthenticator struct {
+	tokenHashes [][sha256.Size]byte
+}
+
+func newBearerAuthenticator(bearerConfig BearerConfig) (*bearerAuthenticator, error) {
+	a := &bearerAuthenticator{}
+	for i, token := range bearerConfig.Tokens {
+		token = os.ExpandEnv(token)
+		if token == "" {
+			return nil, errors.Errorf("bearer token %d is empty", i)
+		}
+		a.tokenHashes = append(a.tokenHashes, sha256.Sum256([]byte(token)))
+	}
+	if len(a.tokenHashes) == 0 {
+		return nil, errors.New("bearer scheme requires at least one token")
+	}
+	return a, nil
+}
+
+func (a *bearerAuthenticator) Authenticate(_ context.Context, creds Credentials) (string, error) {
+	if creds.BearerToken == "" {
+		return "", ErrNoCredentials
+	}
+	// Compare hashes in constant time, so neither the tokens nor their lengths leak through timing
+	hash := sha256.Sum256([]byte(creds.BearerToken))
+	for i, tokenHash := range a.tokenHashes {
+		if subtle.ConstantTimeCompare(hash[:], tokenHash[:]) == 1 {
+			return fmt.Sprintf("bearer-token-%d", i), nil
+		}
+	}
+	return "", ErrInvalidCredentials
+}
+
+//+kubebuilder:rbac:groups="authentication.k8s.io",resources=tokenreviews,verbs=create
+
+type tokenReviewAuthenticator struct {
+	k8sClient       client.Client
+	audiences       []string
+	serviceAccounts map[string]bool
+	// reviews caches the service account of reviewed tokens, by token hash, so a reporter's calls don't each cost a
+	// TokenReview. Rejected tokens are not cached.
+	reviews *expirable.LRU[[sha256.Size]byte, string]
+}
+
+func newTokenReviewAuthenticator(k8sClient client.Client, tokenReviewConfig TokenReviewConfig) (*tokenReviewAuthenticator, error) {
+	if k8sClient == nil {
+		return nil, errors.New("tokenreview scheme requires a Kubernetes client")
+	}
+	for _, serviceAccount := range tokenReviewConfig.ServiceAccounts {
+		if namespace, name, found := strings.Cut(serviceAccount, "/"); !found || namespace == "" || name == "" {
+			return nil, errors.Errorf("service account %q is not in the form namespace/name", serviceAccount)
+		}
+	}
+	cacheTTL := DefaultTokenReviewCacheTTL
+	if tokenReviewConfig.CacheTTL != nil {
+		cacheTTL = tokenReviewConfig.CacheTTL.Duration
+	}
+	return &tokenReviewAuthenticator{
+		k8sClient:       k8sClient,
+		audiences:       tokenReviewConfig.Audiences,
+		serviceAccounts: lo.SliceToMap(tokenReviewConfig.ServiceAccounts, func(sa string) (string, bool) { return sa, true }),
+		reviews:         expirable.NewLRU[[sha256.Size]byte, string](tokenReviewCacheSize, nil, cacheTTL),
+	}, nil
+}
+
+func (a *tokenReviewAuthenticator) Authenticate(ctx context.Context, creds Credentials) (string, error) {
+	if creds.BearerToken == "" {
+		return "", ErrNoCredentials
+	}
+	hash := sha256.Sum256([]byte(creds.BearerToken))
+	serviceAccount, found := a.reviews.Get(hash)
+	if !found {
+		var err error
+		serviceAccount, err = a.review(ctx, creds.BearerToken)
+		if err != nil {
+			return "", errors.Wrap(err)
+		}
+		a.reviews.Add(hash, serviceAccount)
+	}
+
+	if len(a.serviceAccounts) != 0 && !a.serviceAccounts[serviceAccount] {
+		return "", errors.Errorf("service account %s: %w", serviceAccount, ErrNotAllowedPrincipal)
+	}
+	return "serviceaccount:" + serviceAccount, nil
+}
+
+// review returns the namespace/name of the service account the token belongs to.
+func (a *tokenReviewAuthenticator) review(ctx context.Context, token string) (string, error) {
+	review := &authenticationv1.TokenReview{
+		Spec: authenticationv1.TokenReviewSpec{Token: token, Audiences: a.audiences},
+	}
+	if err := a.k8sClient.Create(ctx, review); err != nil {
+		return "", errors.Errorf("token review failed: %w", err)
+	}
+	if !review.Status.Authenticated {
+		return "", errors.Errorf("%w: %s", ErrInvalidCredentials, review.Status.Error)
+	}
+	// Service account usernames are system:serviceaccount:<namespace>:<name>
+	namespacedName, isServiceAccount := strings.CutPrefix(review.Status.User.Username, "system:serviceaccount:")
+	if !isServiceAccount {
+		return "", errors.Errorf("%q is not a service account: %w", review.Status.User.Username, ErrNotAllowedPrincipal)
+	}
+	return strings.Replace(namespacedName, ":", "/", 1), nil
+}
+
+// mtlsAuthenticator checks the client certificate of calls made on the mapper's TLS listener. The certificate chain
+// itself is verified against the client CA by the TLS handshake.
+type mtlsAuthenticator struct {
+	allowedClients map[string]bool
+}
+
+func newMTLSAuthenticator(mtlsConfig MTLSConfig) (*mtlsAuthenticator, error) {
+	if mtlsConfig.CertFile == "" || mtlsConfig.KeyFile == "" || mtlsConfig.ClientCAFile == "" {
+		return nil, errors.New("mtls scheme requires certFile, keyFile and clientCAFile")
+	}
+	return &mtlsAuthenticator{
+		allowedClients: lo.SliceToMap(mtlsConfig.AllowedClients, func(name string) (string, bool) { return name, true }),
+	}, nil
+}
+
+func (a *mtlsAuthenticator) Authenticate(_ context.Context, creds Credentials) (string, error) {
+	if creds.TLS == nil || len(creds.TLS.VerifiedChains) == 0 || len(creds.TLS.VerifiedChains[0]) == 0 {
+		return "", ErrNoCredentials
+	}
+	cert := creds.TLS.VerifiedChains[0][0]
+	if len(a.allowedClients) == 0 {
+		return "cert:" + cert.Subject.CommonName, nil
+	}
+	for _, name := range certificateNames(cert) {
+		if a.allowedClients[name] {
+			return "cert:" + name, nil
+		}
+	}
+	return "", errors.Errorf("client certificate %q: %w", cert.Subject.CommonName, ErrNotAllowedPrincipal)
+}
+
+func certificateNames(cert *x509.Certificate) []string {
+	names := make([]string, 0, 1+len(cert.DNSNames)+len(cert.URIs))
+	if cert.Subject.CommonName != "" {
+		names = append(names, cert.Subject.CommonName)
+	}
+	names = append(names, cert.DNSNames...)
+	for _, uri := range cert.URIs {
+		names = append(names, uri.String())
+	}
+	return names
+}
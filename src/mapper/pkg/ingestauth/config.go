@@ -0,0 +1,92 @@
+package ingestauth
+
+import (
+	"github.com/otterize/intents-operator/src/shared/errors"
+	"github.com/otterize/network-mapper/src/mapper/pkg/config"
+	"github.com/spf13/viper"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"os"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+	"time"
+)
+
+const (
+	// SchemeNone lets callers through without credentials. It is useful to keep some mutations open when the default
+	// schemes require credentials.
+	SchemeNone        = "none"
+	SchemeBearer      = "bearer"
+	SchemeTokenReview = "tokenreview"
+	SchemeMTLS        = "mtls"
+)
+
+const DefaultTokenReviewCacheTTL = 1 * time.Minute
+
+// BearerConfig configures the bearer scheme, which accepts static tokens sent as "Authorization: Bearer <token>".
+type BearerConfig struct {
+	// Tokens may reference environment variables as ${VAR}, so they can be kept in Kubernetes secrets.
+	Tokens []string `json:"tokens"`
+}
+
+// TokenReviewConfig configures the tokenreview scheme, which accepts Kubernetes service account tokens sent as
+// "Authorization: Bearer <token>" and validates them with the API server's TokenReview API.
+type TokenReviewConfig struct {
+	// Audiences the tokens must be issued for. Defaults to the API server's audiences.
+	Audiences []string `json:"audiences,omitempty"`
+	// ServiceAccounts allowed to call, as namespace/name. Any service account is allowed if empty.
+	ServiceAccounts []string `json:"serviceAccounts,omitempty"`
+	// CacheTTL is how long a reviewed token is trusted before it is reviewed again. Defaults to DefaultTokenReviewCacheTTL.
+	CacheTTL *metav1.Duration `json:"cacheTTL,omitempty"`
+}
+
+// MTLSConfig configures the mtls scheme, which accepts client certificates signed by ClientCAFile. As TLS is
+// terminated by the mapper, the mapper also serves its GraphQL API with CertFile and KeyFile on the ingest TLS port.
+type MTLSConfig struct {
+	CertFile     string `json:"certFile"`
+	KeyFile      string `json:"keyFile"`
+	ClientCAFile string `json:"clientCAFile"`
+	// AllowedClients are matched against the client certificate's common name, DNS names and URIs (e.g. SPIFFE IDs).
+	// Any certificate signed by ClientCAFile is allowed if empty.
+	AllowedClients []string `json:"allowedClients,omitempty"`
+}
+
+// FileConfig is the ingest auth config file. Each mutation accepts the schemes listed for it under Mutations, or
+// DefaultSchemes if it is not listed; a call is allowed if it authenticates with any of them. No schemes means no
+// authentication.
+type FileConfig struct {
+	DefaultSchemes []string            `json:"defaultSchemes,omitempty"`
+	Mutations      map[string][]string `json:"mutations,omitempty"`
+	Bearer         *BearerConfig       `json:"bearer,omitempty"`
+	TokenReview    *TokenReviewConfig  `json:"tokenReview,omitempty"`
+	MTLS           *MTLSConfig         `json:"mtls,omitempty"`
+}
+
+func LoadConfigFile(path string) (FileConfig, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return FileConfig{}, errors.Wrap(err)
+	}
+	var fileConfig FileConfig
+	if err := yaml.UnmarshalStrict(content, &fileConfig); err != nil {
+		return FileConfig{}, errors.Errorf("failed parsing ingest auth config file %s: %w", path, err)
+	}
+	return fileConfig, nil
+}
+
+// PolicyFromViper creates the policy described by the ingest auth config file. Without a config file, the returned
+// policy is nil, allowing every call.
+func PolicyFromViper(mutations []string, k8sClient client.Client) (*Policy, error) {
+	configFile := viper.GetString(config.IngestAuthConfigFileKey)
+	if configFile == "" {
+		return nil, nil
+	}
+	fileConfig, err := LoadConfigFile(configFile)
+	if err != nil {
+		return nil, errors.Wrap(err)
+	}
+	policy, err := NewPolicy(fileConfig, mutations, k8sClient)
+	if err != nil {
+		return nil, errors.Errorf("invalid ingest auth config file %s: %w", configFile, err)
+	}
+	return policy, nil
+}
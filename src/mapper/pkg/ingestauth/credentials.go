@@ -0,0 +1,62 @@
+package ingestauth
+
+import (
+	"context"
+	"crypto/tls"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"net/http"
+	"strings"
+)
+
+// Credentials are what a caller presented to the mapper, over GraphQL or gRPC.
+type Credentials struct {
+	// BearerToken is the token sent as "Authorization: Bearer <token>", if any.
+	BearerToken string
+	// TLS is the state of the connection the call was made on, if it was made over TLS.
+	TLS *tls.ConnectionState
+}
+
+type credentialsContextKey struct{}
+
+func ContextWithCredentials(ctx context.Context, creds Credentials) context.Context {
+	return context.WithValue(ctx, credentialsContextKey{}, creds)
+}
+
+// CredentialsFromContext returns the credentials stored by ContextWithCredentials. Calls that did not come in through
+// one of the mapper's servers have no credentials.
+func CredentialsFromContext(ctx context.Context) Credentials {
+	creds, _ := ctx.Value(credentialsContextKey{}).(Credentials)
+	return creds
+}
+
+func CredentialsFromHTTPRequest(r *http.Request) Credentials {
+	return Credentials{
+		BearerToken: bearerToken(r.Header.Get("Authorization")),
+		TLS:         r.TLS,
+	}
+}
+
+func CredentialsFromGRPCContext(ctx context.Context) Credentials {
+	creds := Credentials{}
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if values := md.Get("authorization"); len(values) > 0 {
+			creds.BearerToken = bearerToken(values[0])
+		}
+	}
+	if p, ok := peer.FromContext(ctx); ok {
+		if tlsInfo, ok := p.AuthInfo.(credentials.TLSInfo); ok {
+			creds.TLS = &tlsInfo.State
+		}
+	}
+	return creds
+}
+
+func bearerToken(authorization string) string {
+	scheme, token, found := strings.Cut(authorization, " ")
+	if !found || !strings.EqualFold(scheme, "Bearer") {
+		return ""
+	}
+	return strings.TrimSpace(token)
+}
@@ -0,0 +1,186 @@
+package ingestauth
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"github.com/otterize/intents-operator/src/shared/errors"
+	"github.com/samber/lo"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"os"
+	"path"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+var ErrUnauthenticated = errors.NewSentinelError("unauthenticated")
+
+// Policy decides which mutations callers may call, based on the schemes configured for each mutation. A nil *Policy
+// allows every call.
+type Policy struct {
+	defaultSchemes  []string
+	mutationSchemes map[string][]string
+	authenticators  map[string]Authenticator
+	tlsConfig       *tls.Config
+}
+
+// NewPolicy creates the policy described by fileConfig. mutations are the names of the mutations the mapper serves,
+// so that a typo in the config file fails startup rather than leaving a mutation open.
+func NewPolicy(fileConfig FileConfig, mutations []string, k8sClient client.Client) (*Policy, error) {
+	p := &Policy{
+		defaultSchemes:  fileConfig.DefaultSchemes,
+		mutationSchemes: fileConfig.Mutations,
+		authenticators:  make(map[string]Authenticator),
+	}
+
+	if fileConfig.Bearer != nil {
+		authenticator, err := newBearerAuthenticator(*fileConfig.Bearer)
+		if err != nil {
+			return nil, errors.Wrap(err)
+		}
+		p.authenticators[SchemeBearer] = authenticator
+	}
+	if fileConfig.TokenReview != nil {
+		authenticator, err := newTokenReviewAuthenticator(k8sClient, *fileConfig.TokenReview)
+		if err != nil {
+			return nil, errors.Wrap(err)
+		}
+		p.authenticators[SchemeTokenReview] = authenticator
+	}
+	if fileConfig.MTLS != nil {
+		authenticator, err := newMTLSAuthenticator(*fileConfig.MTLS)
+		if err != nil {
+			return nil, errors.Wrap(err)
+		}
+		p.authenticators[SchemeMTLS] = authenticator
+		p.tlsConfig, err = serverTLSConfig(*fileConfig.MTLS)
+		if err != nil {
+			return nil, errors.Wrap(err)
+		}
+	}
+
+	if err := p.validateSchemes("defaultSchemes", p.defaultSchemes); err != nil {
+		return nil, errors.Wrap(err)
+	}
+	for mutation, schemes := range p.mutationSchemes {
+		if !lo.Contains(mutations, mutation) {
+			return nil, errors.Errorf("unknown mutation %q", mutation)
+		}
+		if err := p.validateSchemes(mutation, schemes); err != nil {
+			return nil, errors.Wrap(err)
+		}
+	}
+	return p, nil
+}
+
+func (p *Policy) validateSchemes(name string, schemes []string) error {
+	for _, scheme := range schemes {
+		if scheme == SchemeNone {
+			continue
+		}
+		if _, configured := p.authenticators[scheme]; !configured {
+			return errors.Errorf("%s: scheme %q is unknown or has no configuration", name, scheme)
+		}
+	}
+	return nil
+}
+
+func serverTLSConfig(mtlsConfig MTLSConfig) (*tls.Config, error) {
+	// Make sure the certificate can be loaded now, rather than failing every handshake
+	if _, err := tls.LoadX509KeyPair(mtlsConfig.CertFile, mtlsConfig.KeyFile); err != nil {
+		return nil, errors.Errorf("failed loading mapper certificate: %w", err)
+	}
+	caPEM, err := os.ReadFile(mtlsConfig.ClientCAFile)
+	if err != nil {
+		return nil, errors.Wrap(err)
+	}
+	clientCAs := x509.NewCertPool()
+	if !clientCAs.AppendCertsFromPEM(caPEM) {
+		return nil, errors.Errorf("no certificates found in client CA file %s", mtlsConfig.ClientCAFile)
+	}
+	return &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		// The certificate is reloaded on each handshake, so rotated certificates are picked up without a restart
+		GetCertificate: func(*tls.ClientHelloInfo) (*tls.Certificate, error) {
+			cert, err := tls.LoadX509KeyPair(mtlsConfig.CertFile, mtlsConfig.KeyFile)
+			if err != nil {
+				return nil, errors.Wrap(err)
+			}
+			return &cert, nil
+		},
+		ClientCAs: clientCAs,
+		// Callers without a certificate may still authenticate with the other schemes
+		ClientAuth: tls.VerifyClientCertIfGiven,
+	}, nil
+}
+
+// TLSConfig returns the config the mapper serves TLS with when the mtls scheme is configured, and nil otherwise.
+func (p *Policy) TLSConfig() *tls.Config {
+	if p == nil {
+		return nil
+	}
+	return p.tlsConfig
+}
+
+func (p *Policy) schemesFor(mutation string) []string {
+	if schemes, found := p.mutationSchemes[mutation]; found {
+		return schemes
+	}
+	return p.defaultSchemes
+}
+
+// Authorize returns ErrUnauthenticated unless creds authenticate with one of the schemes configured for mutation.
+func (p *Policy) Authorize(ctx context.Context, mutation string, creds Credentials) error {
+	_, err := p.Authenticate(ctx, mutation, creds)
+	return err
+}
+
+// Authenticate is like Authorize, but also returns the caller's identity: bearer-token-<index> for the bearer scheme,
+// serviceaccount:<namespace>/<name> for the tokenreview scheme and cert:<name> for the mtls scheme. The identity is
+// empty when the mutation does not require authentication.
+func (p *Policy) Authenticate(ctx context.Context, mutation string, creds Credentials) (string, error) {
+	if p == nil {
+		return "", nil
+	}
+	schemes := p.schemesFor(mutation)
+	if len(schemes) == 0 || lo.Contains(schemes, SchemeNone) {
+		return "", nil
+	}
+
+	failures := make([]string, 0, len(schemes))
+	for _, scheme := range schemes {
+		identity, err := p.authenticators[scheme].Authenticate(ctx, creds)
+		if err == nil {
+			logrus.WithFields(logrus.Fields{"mutation": mutation, "scheme": scheme, "identity": identity}).Debug("Authenticated ingest call")
+			return identity, nil
+		}
+		failures = append(failures, fmt.Sprintf("%s: %s", scheme, err.Error()))
+	}
+	logrus.WithFields(logrus.Fields{"mutation": mutation, "reasons": failures}).Warn("Rejected unauthenticated ingest call")
+	return "", errors.Errorf("%w: %s", ErrUnauthenticated, strings.Join(failures, "; "))
+}
+
+// StreamServerInterceptor authorizes calls to the gRPC ingest API. Each RPC is authorized as the mutation of the same
+// name, e.g. ReportCaptureResults as reportCaptureResults.
+func (p *Policy) StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx := ss.Context()
+		if err := p.Authorize(ctx, mutationForMethod(info.FullMethod), CredentialsFromGRPCContext(ctx)); err != nil {
+			return status.Error(codes.Unauthenticated, err.Error())
+		}
+		return handler(srv, ss)
+	}
+}
+
+// mutationForMethod returns the name of the mutation matching a full gRPC method name, /package.Service/Method.
+func mutationForMethod(fullMethod string) string {
+	method := path.Base(fullMethod)
+	first, size := utf8.DecodeRuneInString(method)
+	return string(unicode.ToLower(first)) + method[size:]
+}
@@ -0,0 +1,175 @@
+package ingestauth
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"github.com/otterize/intents-operator/src/shared/errors"
+	"github.com/stretchr/testify/suite"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+	"net/url"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+	"testing"
+)
+
+var testMutations = []string{"reportCaptureResults", "resetCapture"}
+
+type PolicyTestSuite struct {
+	suite.Suite
+	tokenReviews int
+	k8sClient    client.Client
+}
+
+func (s *PolicyTestSuite) SetupTest() {
+	s.tokenReviews = 0
+	// Tokens are valid if they are the username of a service account
+	s.k8sClient = fake.NewClientBuilder().WithScheme(scheme.Scheme).WithInterceptorFuncs(interceptor.Funcs{
+		Create: func(ctx context.Context, c client.WithWatch, obj client.Object, opts ...client.CreateOption) error {
+			review := obj.(*authenticationv1.TokenReview)
+			s.tokenReviews++
+			review.Status.Authenticated = review.Spec.Token != "invalid"
+			review.Status.User.Username = review.Spec.Token
+			return nil
+		},
+	}).Build()
+}
+
+func (s *PolicyTestSuite) newPolicy(fileConfig FileConfig) *Policy {
+	policy, err := NewPolicy(fileConfig, testMutations, s.k8sClient)
+	s.Require().NoError(err)
+	return policy
+}
+
+func (s *PolicyTestSuite) TestNilPolicyAllowsEverything() {
+	var policy *Policy
+	s.Require().NoError(policy.Authorize(context.Background(), "resetCapture", Credentials{}))
+}
+
+func (s *PolicyTestSuite) TestNoSchemesAllowsEverything() {
+	policy := s.newPolicy(FileConfig{Bearer: &BearerConfig{Tokens: []string{"token"}}})
+	s.Require().NoError(policy.Authorize(context.Background(), "resetCapture", Credentials{}))
+}
+
+func (s *PolicyTestSuite) TestPerMutationSchemes() {
+	policy := s.newPolicy(FileConfig{
+		DefaultSchemes: []string{SchemeNone},
+		Mutations:      map[string][]string{"resetCapture": {SchemeBearer}},
+		Bearer:         &BearerConfig{Tokens: []string{"token"}},
+	})
+	s.Require().NoError(policy.Authorize(context.Background(), "reportCaptureResults", Credentials{}))
+
+	err := policy.Authorize(context.Background(), "resetCapture", Credentials{})
+	s.Require().True(errors.Is(err, ErrUnauthenticated))
+	err = policy.Authorize(context.Background(), "resetCapture", Credentials{BearerToken: "wrong"})
+	s.Require().True(errors.Is(err, ErrUnauthenticated))
+	s.Require().NoError(policy.Authorize(context.Background(), "resetCapture", Credentials{BearerToken: "token"}))
+}
+
+func (s *PolicyTestSuite) TestBearerTokensExpandEnv() {
+	s.T().Setenv("INGEST_TOKEN", "from-env")
+	policy := s.newPolicy(FileConfig{
+		DefaultSchemes: []string{SchemeBearer},
+		Bearer:         &BearerConfig{Tokens: []string{"${INGEST_TOKEN}"}},
+	})
+	s.Require().NoError(policy.Authorize(context.Background(), "resetCapture", Credentials{BearerToken: "from-env"}))
+}
+
+func (s *PolicyTestSuite) TestAnyOfSeveralSchemes() {
+	policy := s.newPolicy(FileConfig{
+		DefaultSchemes: []string{SchemeBearer, SchemeTokenReview},
+		Bearer:         &BearerConfig{Tokens: []string{"token"}},
+		TokenReview:    &TokenReviewConfig{},
+	})
+	s.Require().NoError(policy.Authorize(context.Background(), "resetCapture", Credentials{BearerToken: "token"}))
+	s.Require().NoError(policy.Authorize(context.Background(), "resetCapture", Credentials{BearerToken: "system:serviceaccount:ns:sniffer"}))
+}
+
+func (s *PolicyTestSuite) TestAuthenticateReturnsIdentity() {
+	policy := s.newPolicy(FileConfig{
+		DefaultSchemes: []string{SchemeBearer, SchemeTokenReview},
+		Mutations:      map[string][]string{"reportCaptureResults": {SchemeNone}},
+		Bearer:         &BearerConfig{Tokens: []string{"first", "second"}},
+		TokenReview:    &TokenReviewConfig{},
+	})
+	identity, err := policy.Authenticate(context.Background(), "resetCapture", Credentials{BearerToken: "second"})
+	s.Require().NoError(err)
+	s.Require().Equal("bearer-token-1", identity)
+	identity, err = policy.Authenticate(context.Background(), "resetCapture", Credentials{BearerToken: "system:serviceaccount:ns:sniffer"})
+	s.Require().NoError(err)
+	s.Require().Equal("serviceaccount:ns/sniffer", identity)
+	identity, err = policy.Authenticate(context.Background(), "reportCaptureResults", Credentials{BearerToken: "second"})
+	s.Require().NoError(err)
+	s.Require().Empty(identity)
+}
+
+func (s *PolicyTestSuite) TestTokenReviewServiceAccountAllowlist() {
+	policy := s.newPolicy(FileConfig{
+		DefaultSchemes: []string{SchemeTokenReview},
+		TokenReview:    &TokenReviewConfig{ServiceAccounts: []string{"otterize-system/sniffer"}},
+	})
+	s.Require().NoError(policy.Authorize(context.Background(), "resetCapture", Credentials{BearerToken: "system:serviceaccount:otterize-system:sniffer"}))
+	s.Require().Error(policy.Authorize(context.Background(), "resetCapture", Credentials{BearerToken: "system:serviceaccount:default:other"}))
+	s.Require().Error(policy.Authorize(context.Background(), "resetCapture", Credentials{BearerToken: "jane@example.com"}))
+	s.Require().Error(policy.Authorize(context.Background(), "resetCapture", Credentials{BearerToken: "invalid"}))
+}
+
+func (s *PolicyTestSuite) TestTokenReviewsAreCached() {
+	policy := s.newPolicy(FileConfig{
+		DefaultSchemes: []string{SchemeTokenReview},
+		TokenReview:    &TokenReviewConfig{},
+	})
+	creds := Credentials{BearerToken: "system:serviceaccount:otterize-system:sniffer"}
+	s.Require().NoError(policy.Authorize(context.Background(), "resetCapture", creds))
+	s.Require().NoError(policy.Authorize(context.Background(), "reportCaptureResults", creds))
+	s.Require().Equal(1, s.tokenReviews)
+}
+
+func (s *PolicyTestSuite) TestMTLSAllowedClients() {
+	authenticator, err := newMTLSAuthenticator(MTLSConfig{
+		CertFile:       "tls.crt",
+		KeyFile:        "tls.key",
+		ClientCAFile:   "ca.crt",
+		AllowedClients: []string{"spiffe://cluster.local/ns/otterize-system/sa/sniffer"},
+	})
+	s.Require().NoError(err)
+
+	allowed := &x509.Certificate{
+		Subject: pkix.Name{CommonName: "sniffer"},
+		URIs:    []*url.URL{{Scheme: "spiffe", Host: "cluster.local", Path: "/ns/otterize-system/sa/sniffer"}},
+	}
+	identity, err := authenticator.Authenticate(context.Background(), Credentials{TLS: &tls.ConnectionState{VerifiedChains: [][]*x509.Certificate{{allowed}}}})
+	s.Require().NoError(err)
+	s.Require().Equal("cert:spiffe://cluster.local/ns/otterize-system/sa/sniffer", identity)
+
+	other := &x509.Certificate{Subject: pkix.Name{CommonName: "other"}}
+	_, err = authenticator.Authenticate(context.Background(), Credentials{TLS: &tls.ConnectionState{VerifiedChains: [][]*x509.Certificate{{other}}}})
+	s.Require().True(errors.Is(err, ErrNotAllowedPrincipal))
+
+	// Certificates that were presented but not verified are ignored
+	_, err = authenticator.Authenticate(context.Background(), Credentials{TLS: &tls.ConnectionState{PeerCertificates: []*x509.Certificate{allowed}}})
+	s.Require().True(errors.Is(err, ErrNoCredentials))
+}
+
+func (s *PolicyTestSuite) TestInvalidConfig() {
+	_, err := NewPolicy(FileConfig{Mutations: map[string][]string{"resetCaptur": {SchemeNone}}}, testMutations, s.k8sClient)
+	s.Require().Error(err)
+	_, err = NewPolicy(FileConfig{DefaultSchemes: []string{SchemeBearer}}, testMutations, s.k8sClient)
+	s.Require().Error(err)
+	_, err = NewPolicy(FileConfig{DefaultSchemes: []string{"basic"}}, testMutations, s.k8sClient)
+	s.Require().Error(err)
+	_, err = NewPolicy(FileConfig{TokenReview: &TokenReviewConfig{ServiceAccounts: []string{"sniffer"}}}, testMutations, s.k8sClient)
+	s.Require().Error(err)
+}
+
+func (s *PolicyTestSuite) TestMutationForMethod() {
+	s.Require().Equal("reportCaptureResults", mutationForMethod("/otterize.networkmapper.ingest.v1.Ingest/ReportCaptureResults"))
+	s.Require().Equal("reportAWSOperation", mutationForMethod("/otterize.networkmapper.ingest.v1.Ingest/ReportAWSOperation"))
+}
+
+func TestPolicyTestSuite(t *testing.T) {
+	suite.Run(t, new(PolicyTestSuite))
+}
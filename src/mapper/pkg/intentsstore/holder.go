@@ -23,6 +23,7 @@ type IntentsStoreKey struct {
 	Source      types.NamespacedName
 	Destination types.NamespacedName
 	Type        model.IntentType
+	Protocol    model.IntentProtocol
 }
 
 type TimestampedIntent struct {
@@ -135,12 +136,28 @@ func mergeHTTPResources(existingResources, newResources []model.HTTPResource) []
 	})
 }
 
-func (i *IntentsHolder) addIntentToStore(store IntentsStore, newTimestamp time.Time, intent model.Intent) {
-	key := IntentsStoreKey{
+func mergeIntentPorts(existingPorts, newPorts []model.IntentPort) []model.IntentPort {
+	ports := lo.Uniq(append(slices.Clone(existingPorts), newPorts...))
+	slices.SortFunc(ports, func(a, b model.IntentPort) int {
+		if a.Protocol != b.Protocol {
+			return strings.Compare(string(a.Protocol), string(b.Protocol))
+		}
+		return int(a.Port - b.Port)
+	})
+	return ports
+}
+
+func intentsStoreKeyForIntent(intent model.Intent) IntentsStoreKey {
+	return IntentsStoreKey{
 		Source:      intent.Client.AsNamespacedName(),
 		Destination: intent.Server.AsNamespacedName(),
 		Type:        lo.FromPtr(intent.Type),
+		Protocol:    lo.FromPtr(intent.Protocol),
 	}
+}
+
+func (i *IntentsHolder) addIntentToStore(store IntentsStore, newTimestamp time.Time, intent model.Intent) {
+	key := intentsStoreKeyForIntent(intent)
 
 	existingIntent, ok := store[key]
 	if !ok {
@@ -151,12 +168,24 @@ func (i *IntentsHolder) addIntentToStore(store IntentsStore, newTimestamp time.T
 		return
 	}
 
-	// merge into existing intent
+	store[key] = mergeIntent(existingIntent, newTimestamp, intent)
+}
+
+// MergeTimestampedIntents merges newIntent into existingIntent the same way the holder merges intents reported for
+// the same IntentsStoreKey: the latest timestamp and labels win, Kafka topics, HTTP resources and ports are unified.
+func MergeTimestampedIntents(existingIntent TimestampedIntent, newIntent TimestampedIntent) TimestampedIntent {
+	return mergeIntent(existingIntent, newIntent.Timestamp, newIntent.Intent)
+}
+
+func mergeIntent(existingIntent TimestampedIntent, newTimestamp time.Time, intent model.Intent) TimestampedIntent {
 	if newTimestamp.After(existingIntent.Timestamp) {
 		existingIntent.Timestamp = newTimestamp
 	}
 	existingIntent.Intent.KafkaTopics = mergeKafkaTopics(existingIntent.Intent.KafkaTopics, intent.KafkaTopics)
 	existingIntent.Intent.HTTPResources = mergeHTTPResources(existingIntent.Intent.HTTPResources, intent.HTTPResources)
+	if len(existingIntent.Intent.Ports) != 0 || len(intent.Ports) != 0 {
+		existingIntent.Intent.Ports = mergeIntentPorts(existingIntent.Intent.Ports, intent.Ports)
+	}
 
 	// Replace labels with latest
 	existingIntent.Intent.Client.Labels = intent.Client.Labels
@@ -172,15 +201,11 @@ func (i *IntentsHolder) addIntentToStore(store IntentsStore, newTimestamp time.T
 		}
 	}
 
-	store[key] = existingIntent
+	return existingIntent
 }
 
 func (i *IntentsHolder) addUniqueCount(intent model.Intent, sourcePorts []int64) {
-	key := IntentsStoreKey{
-		Source:      intent.Client.AsNamespacedName(),
-		Destination: intent.Server.AsNamespacedName(),
-		Type:        lo.FromPtr(intent.Type),
-	}
+	key := intentsStoreKeyForIntent(intent)
 
 	i.connectionsCountDiffer.Increment(key, concurrentconnectioncounter.CounterInput[*concurrentconnectioncounter.CountableIntentIntent]{
 		Intent:      concurrentconnectioncounter.NewCountableIntentIntent(intent),
@@ -240,6 +265,20 @@ func (i *IntentsHolder) AddIntent(newTimestamp time.Time, intent model.Intent, s
 	intentLogger.Debug("Added client to intent store")
 }
 
+// LoadIntents restores previously persisted intents into the accumulating store, so they are served by GetIntents.
+// Loaded intents are not considered new, and will not be passed to the registered callbacks.
+func (i *IntentsHolder) LoadIntents(intents []TimestampedIntent) {
+	i.lock.Lock()
+	defer i.lock.Unlock()
+
+	for _, intent := range intents {
+		if config.ExcludedNamespaces().Contains(intent.Intent.Client.Namespace) || config.ExcludedNamespaces().Contains(intent.Intent.Server.Namespace) {
+			continue
+		}
+		i.addIntentToStore(i.accumulatingStore, intent.Timestamp, intent.Intent)
+	}
+}
+
 func (i *IntentsHolder) GetIntents(
 	namespaces []string,
 	includeLabels []string,
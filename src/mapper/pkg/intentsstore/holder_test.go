@@ -0,0 +1,112 @@
+package intentsstore
+
+import (
+	"github.com/otterize/network-mapper/src/mapper/pkg/graph/model"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/suite"
+	"testing"
+	"time"
+)
+
+type IntentsHolderSuite struct {
+	suite.Suite
+	holder *IntentsHolder
+}
+
+func (s *IntentsHolderSuite) SetupTest() {
+	s.holder = NewIntentsHolder()
+}
+
+func kafkaIntent(topic string, operation model.KafkaOperation) model.Intent {
+	return model.Intent{
+		Client: &model.OtterizeServiceIdentity{Name: "client", Namespace: "ns"},
+		Server: &model.OtterizeServiceIdentity{Name: "kafka", Namespace: "ns"},
+		Type:   lo.ToPtr(model.IntentTypeKafka),
+		KafkaTopics: []model.KafkaConfig{
+			{Name: topic, Operations: []model.KafkaOperation{operation}},
+		},
+	}
+}
+
+func (s *IntentsHolderSuite) TestLoadIntentsMergesWithReportedIntents() {
+	loadedTimestamp := time.Date(2024, 4, 1, 0, 0, 0, 0, time.UTC)
+	s.holder.LoadIntents([]TimestampedIntent{
+		{Timestamp: loadedTimestamp, Intent: kafkaIntent("orders", model.KafkaOperationConsume)},
+	})
+
+	reportedTimestamp := loadedTimestamp.Add(time.Hour)
+	s.holder.AddIntent(reportedTimestamp, kafkaIntent("payments", model.KafkaOperationProduce), nil)
+
+	intents, err := s.holder.GetIntents(nil, nil, nil, false, nil)
+	s.Require().NoError(err)
+	s.Require().Len(intents, 1)
+	s.Require().Equal(reportedTimestamp, intents[0].Timestamp)
+	s.Require().ElementsMatch([]string{"orders", "payments"}, lo.Map(intents[0].Intent.KafkaTopics, func(topic model.KafkaConfig, _ int) string {
+		return topic.Name
+	}))
+}
+
+func (s *IntentsHolderSuite) TestLoadedIntentsAreNotReportedAsNew() {
+	s.holder.LoadIntents([]TimestampedIntent{
+		{Timestamp: time.Now(), Intent: kafkaIntent("orders", model.KafkaOperationConsume)},
+	})
+
+	s.Require().Empty(s.holder.GetNewIntentsSinceLastGet())
+}
+
+func (s *IntentsHolderSuite) TestMergeTimestampedIntents() {
+	older := TimestampedIntent{Timestamp: time.Date(2024, 4, 1, 0, 0, 0, 0, time.UTC), Intent: kafkaIntent("orders", model.KafkaOperationConsume)}
+	newer := TimestampedIntent{Timestamp: older.Timestamp.Add(time.Minute), Intent: kafkaIntent("orders", model.KafkaOperationProduce)}
+
+	merged := MergeTimestampedIntents(older, newer)
+	s.Require().Equal(newer.Timestamp, merged.Timestamp)
+	s.Require().Len(merged.Intent.KafkaTopics, 1)
+	s.Require().ElementsMatch([]model.KafkaOperation{model.KafkaOperationConsume, model.KafkaOperationProduce}, merged.Intent.KafkaTopics[0].Operations)
+}
+
+func (s *IntentsHolderSuite) TestIntentsAreHeldPerProtocol() {
+	tcpIntent := model.Intent{
+		Client: &model.OtterizeServiceIdentity{Name: "client", Namespace: "ns"},
+		Server: &model.OtterizeServiceIdentity{Name: "server", Namespace: "ns"},
+	}
+	udpIntent := tcpIntent
+	udpIntent.Protocol = lo.ToPtr(model.IntentProtocolUDP)
+	s.holder.AddIntent(time.Now(), tcpIntent, nil)
+	s.holder.AddIntent(time.Now(), udpIntent, nil)
+
+	intents, err := s.holder.GetIntents(nil, nil, nil, false, nil)
+	s.Require().NoError(err)
+	s.Require().ElementsMatch([]*model.IntentProtocol{nil, lo.ToPtr(model.IntentProtocolUDP)}, lo.Map(intents, func(intent TimestampedIntent, _ int) *model.IntentProtocol {
+		return intent.Intent.Protocol
+	}))
+}
+
+func (s *IntentsHolderSuite) TestPortsAreAggregated() {
+	intentWithPort := func(port int64, protocol model.IntentProtocol) model.Intent {
+		return model.Intent{
+			Client: &model.OtterizeServiceIdentity{Name: "client", Namespace: "ns"},
+			Server: &model.OtterizeServiceIdentity{Name: "server", Namespace: "ns"},
+			Ports:  []model.IntentPort{{Port: port, Protocol: protocol}},
+		}
+	}
+	s.holder.AddIntent(time.Now(), intentWithPort(8080, model.IntentProtocolTCP), nil)
+	s.holder.AddIntent(time.Now(), intentWithPort(443, model.IntentProtocolTCP), nil)
+	s.holder.AddIntent(time.Now(), intentWithPort(8080, model.IntentProtocolTCP), nil)
+	// Intents with no known port, such as ones discovered from DNS, don't remove the ports seen
+	s.holder.AddIntent(time.Now(), model.Intent{
+		Client: &model.OtterizeServiceIdentity{Name: "client", Namespace: "ns"},
+		Server: &model.OtterizeServiceIdentity{Name: "server", Namespace: "ns"},
+	}, nil)
+
+	intents, err := s.holder.GetIntents(nil, nil, nil, false, nil)
+	s.Require().NoError(err)
+	s.Require().Len(intents, 1)
+	s.Require().Equal([]model.IntentPort{
+		{Port: 443, Protocol: model.IntentProtocolTCP},
+		{Port: 8080, Protocol: model.IntentProtocolTCP},
+	}, intents[0].Intent.Ports)
+}
+
+func TestIntentsHolderSuite(t *testing.T) {
+	suite.Run(t, new(IntentsHolderSuite))
+}
@@ -76,7 +76,7 @@ func (k *KubeFinder) initIndexes(ctx context.Context) error {
 			return res
 		}
 		for _, ip := range pod.Status.PodIPs {
-			res = append(res, ip.IP)
+			res = append(res, canonicalIP(ip.IP))
 		}
 		return res
 	})
@@ -93,8 +93,8 @@ func (k *KubeFinder) initIndexes(ctx context.Context) error {
 		}
 
 		for _, ip := range pod.Status.PodIPs {
-			k.seenIPsTTLCache.Add(ip.IP, struct{}{})
-			res = append(res, ip.IP)
+			k.seenIPsTTLCache.Add(canonicalIP(ip.IP), struct{}{})
+			res = append(res, canonicalIP(ip.IP))
 		}
 		return res
 	})
@@ -105,7 +105,9 @@ func (k *KubeFinder) initIndexes(ctx context.Context) error {
 	err = k.mgr.GetCache().IndexField(ctx, &corev1.Service{}, serviceIPIndexField, func(object client.Object) []string {
 		res := make([]string, 0)
 		svc := object.(*corev1.Service)
-		res = append(res, svc.Spec.ClusterIPs...)
+		for _, ip := range svc.Spec.ClusterIPs {
+			res = append(res, canonicalIP(ip))
+		}
 		return res
 	})
 	if err != nil {
@@ -123,7 +125,7 @@ func (k *KubeFinder) initIndexes(ctx context.Context) error {
 		}
 
 		for _, ingress := range svc.Status.LoadBalancer.Ingress {
-			ips.Insert(ingress.IP)
+			ips.Insert(canonicalIP(ingress.IP))
 		}
 		return ips.UnsortedList()
 	})
@@ -161,7 +163,7 @@ func (k *KubeFinder) initIndexes(ctx context.Context) error {
 
 		for _, address := range node.Status.Addresses {
 			if address.Type == corev1.NodeInternalIP || address.Type == corev1.NodeExternalIP {
-				ips.Insert(address.Address)
+				ips.Insert(canonicalIP(address.Address))
 			}
 		}
 		return ips.UnsortedList()
@@ -184,7 +186,7 @@ func (k *KubeFinder) ResolvePodByName(ctx context.Context, name string, namespac
 
 func (k *KubeFinder) ResolveIPToService(ctx context.Context, ip string) (*corev1.Service, bool, error) {
 	var services corev1.ServiceList
-	err := k.client.List(ctx, &services, client.MatchingFields{serviceIPIndexField: ip})
+	err := k.client.List(ctx, &services, client.MatchingFields{serviceIPIndexField: canonicalIP(ip)})
 	if err != nil {
 		return nil, false, errors.Wrap(err)
 	}
@@ -247,7 +249,7 @@ func (k *KubeFinder) ResolveServiceToPods(ctx context.Context, svc *corev1.Servi
 
 func (k *KubeFinder) IsIpHostNetworkIp(ctx context.Context, ip string) (bool, error) {
 	var podsWithHostNetwork corev1.PodList
-	err := k.client.List(ctx, &podsWithHostNetwork, client.MatchingFields{podIPIncludingHostNetworkIndexField: ip})
+	err := k.client.List(ctx, &podsWithHostNetwork, client.MatchingFields{podIPIncludingHostNetworkIndexField: canonicalIP(ip)})
 	if err != nil {
 		return false, errors.Wrap(err)
 	}
@@ -257,7 +259,7 @@ func (k *KubeFinder) IsIpHostNetworkIp(ctx context.Context, ip string) (bool, er
 
 func (k *KubeFinder) ResolveIPToPod(ctx context.Context, ip string) (*corev1.Pod, error) {
 	var pods corev1.PodList
-	err := k.client.List(ctx, &pods, client.MatchingFields{podIPIndexField: ip})
+	err := k.client.List(ctx, &pods, client.MatchingFields{podIPIndexField: canonicalIP(ip)})
 	if err != nil {
 		return nil, errors.Wrap(err)
 	}
@@ -279,7 +281,9 @@ func (k *KubeFinder) ResolveIPToControlPlane(ctx context.Context, ip string) (*c
 		return nil, false, errors.Wrap(err)
 	}
 
-	if ip == svc.Spec.ClusterIP {
+	// ClusterIPs holds both the IPv4 and IPv6 cluster IPs on dual-stack clusters
+	clusterIPs := append([]string{svc.Spec.ClusterIP}, svc.Spec.ClusterIPs...)
+	if lo.ContainsBy(clusterIPs, func(clusterIP string) bool { return canonicalIP(clusterIP) == canonicalIP(ip) }) {
 		return &svc, true, nil
 	}
 
@@ -305,27 +309,38 @@ func (k *KubeFinder) isIPMatchingControlPlaneEndpoints(ctx context.Context, ip s
 	}
 
 	parsedIP := net.ParseIP(ip)
-	controlPlaneCIDRPrefixLength := viper.GetInt(config.ControlPlaneIPv4CidrPrefixLength)
+	controlPlaneIPv4CIDRPrefixLength := viper.GetInt(config.ControlPlaneIPv4CidrPrefixLength)
+	controlPlaneIPv6CIDRPrefixLength := viper.GetInt(config.ControlPlaneIPv6CidrPrefixLength)
 
 	for _, endpointSlice := range endpointSlices.Items {
 		for _, endpoint := range endpointSlice.Endpoints {
 			for _, endpointIP := range endpoint.Addresses {
 				// check for exact match
-				if endpointIP == ip {
+				if canonicalIP(endpointIP) == canonicalIP(ip) {
 					return true, nil
 				}
 
-				// check if IP matches the control plane CIDR
+				// check if IP matches the control plane CIDR, of the same IP family
 				parsedEndpointIP := net.ParseIP(endpointIP)
-				if parsedIP.To4() != nil && parsedEndpointIP.To4() != nil {
-					_, endpointNetwork, err := net.ParseCIDR(fmt.Sprintf("%s/%d", parsedEndpointIP.To4().String(), controlPlaneCIDRPrefixLength))
-					if err != nil {
-						return false, errors.Wrap(err)
-					}
-
-					if endpointNetwork.Contains(parsedIP) {
-						return true, nil
-					}
+				if parsedIP == nil || parsedEndpointIP == nil {
+					continue
+				}
+				var endpointCIDR string
+				switch {
+				case parsedIP.To4() != nil && parsedEndpointIP.To4() != nil:
+					endpointCIDR = fmt.Sprintf("%s/%d", parsedEndpointIP.To4().String(), controlPlaneIPv4CIDRPrefixLength)
+				case parsedIP.To4() == nil && parsedEndpointIP.To4() == nil:
+					endpointCIDR = fmt.Sprintf("%s/%d", parsedEndpointIP.String(), controlPlaneIPv6CIDRPrefixLength)
+				default:
+					continue
+				}
+				_, endpointNetwork, err := net.ParseCIDR(endpointCIDR)
+				if err != nil {
+					return false, errors.Wrap(err)
+				}
+
+				if endpointNetwork.Contains(parsedIP) {
+					return true, nil
 				}
 			}
 		}
@@ -352,7 +367,7 @@ func (k *KubeFinder) ResolveIPToExternalAccessService(ctx context.Context, ip st
 
 func (k *KubeFinder) resolveLoadBalancerServiceByExternalIP(ctx context.Context, ip string, port int) (*corev1.Service, bool, error) {
 	var services corev1.ServiceList
-	err := k.client.List(ctx, &services, client.MatchingFields{externalIPIndexField: ip})
+	err := k.client.List(ctx, &services, client.MatchingFields{externalIPIndexField: canonicalIP(ip)})
 	if err != nil {
 		return nil, false, errors.Wrap(err)
 	}
@@ -373,7 +388,7 @@ func (k *KubeFinder) resolveLoadBalancerServiceByExternalIP(ctx context.Context,
 
 func (k *KubeFinder) resolveServiceByNodeIPAndPort(ctx context.Context, ip string, port int) (*corev1.Service, bool, error) {
 	var nodes corev1.NodeList
-	err := k.client.List(ctx, &nodes, client.MatchingFields{nodeIPIndexField: ip})
+	err := k.client.List(ctx, &nodes, client.MatchingFields{nodeIPIndexField: canonicalIP(ip)})
 	if err != nil {
 		return nil, false, errors.Wrap(err)
 	}
@@ -455,8 +470,10 @@ func (k *KubeFinder) ResolveServiceAddressToPods(ctx context.Context, fqdn strin
 
 		return pods, serviceNamespacedName, nil
 	case "pod":
-		// for address format of pods: 172-17-0-3.default.pod.cluster.local
-		ip := strings.ReplaceAll(fqdnWithoutClusterDomainParts[0], "-", ".")
+		ip, err := podAddressToIP(fqdnWithoutClusterDomainParts[0])
+		if err != nil {
+			return make([]corev1.Pod, 0), types.NamespacedName{}, errors.Wrap(err)
+		}
 		pod, err := k.ResolveIPToPod(ctx, ip)
 		if err != nil {
 			return make([]corev1.Pod, 0), types.NamespacedName{}, errors.Wrap(err)
@@ -545,6 +562,97 @@ func (k *KubeFinder) ResolveOtterizeIdentityForService(ctx context.Context, svc
 	return dstSvcIdentity, true, nil
 }
 
+// WorkloadPods is a workload along with its pods.
+type WorkloadPods struct {
+	Identity model.OtterizeServiceIdentity
+	Pods     []corev1.Pod
+}
+
+// ListWorkloadPods returns the running pods that are not on the host network, grouped by the workload they belong to.
+func (k *KubeFinder) ListWorkloadPods(ctx context.Context) ([]WorkloadPods, error) {
+	var pods corev1.PodList
+	if err := k.client.List(ctx, &pods); err != nil {
+		return nil, errors.Wrap(err)
+	}
+
+	workloadIndexes := make(map[types.NamespacedName]int)
+	workloads := make([]WorkloadPods, 0)
+	for _, pod := range pods.Items {
+		if pod.Spec.HostNetwork || pod.Status.Phase != corev1.PodRunning || pod.DeletionTimestamp != nil {
+			continue
+		}
+		serviceIdentity, err := k.serviceIdResolver.ResolvePodToServiceIdentity(ctx, &pod)
+		if err != nil {
+			return nil, errors.Wrap(err)
+		}
+		identity := model.OtterizeServiceIdentity{
+			Name:                        serviceIdentity.Name,
+			Namespace:                   pod.Namespace,
+			NameResolvedUsingAnnotation: serviceIdentity.ResolvedUsingOverrideAnnotation,
+		}
+		if serviceIdentity.OwnerObject != nil {
+			identity.PodOwnerKind = model.GroupVersionKindFromKubeGVK(serviceIdentity.OwnerObject.GetObjectKind().GroupVersionKind())
+		}
+		key := identity.AsNamespacedName()
+		if _, found := workloadIndexes[key]; !found {
+			workloadIndexes[key] = len(workloads)
+			workloads = append(workloads, WorkloadPods{Identity: identity})
+		}
+		workloads[workloadIndexes[key]].Pods = append(workloads[workloadIndexes[key]].Pods, pod)
+	}
+	return workloads, nil
+}
+
+// ListWorkloadAddresses returns the IPs other clusters may reach this cluster's workloads at: the IPs of running pods
+// that are not on the host network, and the external and load balancer IPs of services. Cluster IPs are left out, as
+// they are only reachable from within the cluster.
+func (k *KubeFinder) ListWorkloadAddresses(ctx context.Context) ([]model.WorkloadAddresses, error) {
+	workloads, err := k.ListWorkloadPods(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err)
+	}
+
+	workloadAddresses := make([]model.WorkloadAddresses, 0)
+	for _, workload := range workloads {
+		ips := make([]string, 0)
+		for _, pod := range workload.Pods {
+			ips = append(ips, lo.Map(pod.Status.PodIPs, func(podIP corev1.PodIP, _ int) string { return podIP.IP })...)
+		}
+		if len(ips) == 0 {
+			continue
+		}
+		workloadAddresses = append(workloadAddresses, model.WorkloadAddresses{Identity: &workload.Identity, Ips: ips})
+	}
+
+	var services corev1.ServiceList
+	if err := k.client.List(ctx, &services); err != nil {
+		return nil, errors.Wrap(err)
+	}
+	for _, svc := range services.Items {
+		ips := append([]string{}, svc.Spec.ExternalIPs...)
+		for _, ingress := range svc.Status.LoadBalancer.Ingress {
+			if ingress.IP != "" {
+				ips = append(ips, ingress.IP)
+			}
+		}
+		if len(ips) == 0 {
+			continue
+		}
+		identity, found, err := k.ResolveOtterizeIdentityForService(ctx, &svc, time.Now())
+		if err != nil {
+			return nil, errors.Wrap(err)
+		}
+		if !found {
+			continue
+		}
+		identity.Labels = nil
+		identity.ResolutionData = nil
+		workloadAddresses = append(workloadAddresses, model.WorkloadAddresses{Identity: &identity, Ips: ips})
+	}
+
+	return workloadAddresses, nil
+}
+
 func (k *KubeFinder) IsSrcIpClusterInternal(ctx context.Context, ip string) (bool, error) {
 	// Known issue: this function is currently missing support for services/endpoints, node.PodCIDR
 
@@ -582,7 +690,7 @@ func (k *KubeFinder) IsSrcIpClusterInternal(ctx context.Context, ip string) (boo
 
 func (k *KubeFinder) IsPodIp(ctx context.Context, ip string) (bool, error) {
 	var pods corev1.PodList
-	err := k.client.List(ctx, &pods, client.MatchingFields{podIPIncludingHostNetworkIndexField: ip})
+	err := k.client.List(ctx, &pods, client.MatchingFields{podIPIncludingHostNetworkIndexField: canonicalIP(ip)})
 	if err != nil {
 		return false, errors.Wrap(err)
 	}
@@ -590,14 +698,36 @@ func (k *KubeFinder) IsPodIp(ctx context.Context, ip string) (bool, error) {
 }
 
 func (k *KubeFinder) WasPodIP(ip string) bool {
-	return k.seenIPsTTLCache.Contains(ip)
+	return k.seenIPsTTLCache.Contains(canonicalIP(ip))
 }
 
 func (k *KubeFinder) IsNodeIP(ctx context.Context, ip string) (bool, error) {
 	var nodes corev1.NodeList
-	err := k.client.List(ctx, &nodes, client.MatchingFields{nodeIPIndexField: ip})
+	err := k.client.List(ctx, &nodes, client.MatchingFields{nodeIPIndexField: canonicalIP(ip)})
 	if err != nil {
 		return false, errors.Wrap(err)
 	}
 	return len(nodes.Items) > 0, nil
 }
+
+// canonicalIP returns the IP in its canonical textual form, so IPv6 addresses written differently (zero-padded, upper
+// case, or IPv4-mapped) are indexed and looked up the same way. Strings that are not IPs are returned as is.
+func canonicalIP(ip string) string {
+	parsed := net.ParseIP(ip)
+	if parsed == nil {
+		return ip
+	}
+	return parsed.String()
+}
+
+// podAddressToIP converts the first label of a pod DNS address to the pod's IP. IPv4 addresses have their dots
+// replaced with dashes (172-17-0-3.default.pod.cluster.local), and IPv6 addresses their colons (fd00--3.default.pod...).
+func podAddressToIP(label string) (string, error) {
+	if ip := net.ParseIP(strings.ReplaceAll(label, "-", ".")); ip != nil && ip.To4() != nil {
+		return ip.String(), nil
+	}
+	if ip := net.ParseIP(strings.ReplaceAll(label, "-", ":")); ip != nil {
+		return ip.String(), nil
+	}
+	return "", errors.Errorf("pod address label %s is not an IP", label)
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/otterize/network-mapper/src/shared/testbase"
 	"github.com/samber/lo"
 	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 	corev1 "k8s.io/api/core/v1"
 	discoveryv1 "k8s.io/api/discovery/v1"
@@ -145,6 +146,49 @@ func (s *KubeFinderTestSuite) TestIsSrcIpClusterInternal() {
 	s.Require().False(isInternal)
 }
 
+func (s *KubeFinderTestSuite) TestResolveIPv6IpToPod() {
+	s.AddPod("test-pod", "fd00:10:244::5", nil, nil)
+	s.Require().True(s.Mgr.GetCache().WaitForCacheSync(context.Background()))
+
+	// The same address written differently resolves to the same pod
+	for _, ip := range []string{"fd00:10:244::5", "FD00:0010:0244:0000:0000:0000:0000:0005"} {
+		pod, err := s.kubeFinder.ResolveIPToPod(context.Background(), ip)
+		s.Require().NoError(err)
+		s.Require().Equal("test-pod", pod.Name)
+
+		isInternal, err := s.kubeFinder.IsSrcIpClusterInternal(context.Background(), ip)
+		s.Require().NoError(err)
+		s.Require().True(isInternal)
+	}
+
+	pods, _, err := s.kubeFinder.ResolveServiceAddressToPods(context.Background(), fmt.Sprintf("fd00-10-244--5.%s.pod.cluster.local", s.TestNamespace))
+	s.Require().NoError(err)
+	s.Require().Len(pods, 1)
+	s.Require().Equal("test-pod", pods[0].Name)
+}
+
+func TestPodAddressToIP(t *testing.T) {
+	for label, expected := range map[string]string{
+		"172-17-0-3":     "172.17.0.3",
+		"fd00-10-244--5": "fd00:10:244::5",
+		"--1":            "::1",
+	} {
+		ip, err := podAddressToIP(label)
+		require.NoError(t, err)
+		require.Equal(t, expected, ip)
+	}
+
+	_, err := podAddressToIP("not-an-ip")
+	require.Error(t, err)
+}
+
+func TestCanonicalIP(t *testing.T) {
+	require.Equal(t, "fd00::1", canonicalIP("FD00:0000::0001"))
+	require.Equal(t, "10.0.0.1", canonicalIP("::ffff:10.0.0.1"))
+	require.Equal(t, "10.0.0.1", canonicalIP("10.0.0.1"))
+	require.Equal(t, "not-an-ip", canonicalIP("not-an-ip"))
+}
+
 func TestKubeFinderTestSuite(t *testing.T) {
 	suite.Run(t, new(KubeFinderTestSuite))
 }
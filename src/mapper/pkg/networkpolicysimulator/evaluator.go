@@ -0,0 +1,222 @@
+package networkpolicysimulator
+
+import (
+	"fmt"
+	"github.com/otterize/intents-operator/src/shared/errors"
+	"github.com/samber/lo"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"net/netip"
+	"strings"
+)
+
+// podPort is a port traffic is sent to on the destination pod. When name is set, the port is a named container port
+// of the pod, as Kubernetes services may target them.
+type podPort struct {
+	number   int32
+	name     string
+	protocol corev1.Protocol
+}
+
+// resolve returns the port number on pod, and false if pod has no container port with the port's name. Named ports
+// never resolve for a nil pod, which stands for a destination outside the cluster.
+func (p podPort) resolve(pod *corev1.Pod) (int32, bool) {
+	if p.name == "" {
+		return p.number, true
+	}
+	if pod == nil {
+		return 0, false
+	}
+	for _, container := range pod.Spec.Containers {
+		for _, containerPort := range container.Ports {
+			if containerPort.Name == p.name && lo.CoalesceOrEmpty(containerPort.Protocol, corev1.ProtocolTCP) == p.protocol {
+				return containerPort.ContainerPort, true
+			}
+		}
+	}
+	return 0, false
+}
+
+// verdict is the outcome of evaluating the policies of one direction.
+type verdict struct {
+	allowed bool
+	// policies are the policies selecting the pod, as <namespace>/<name>. Set when the traffic is denied.
+	policies []string
+}
+
+// policy is a NetworkPolicy with its selectors parsed.
+type policy struct {
+	networkPolicy networkingv1.NetworkPolicy
+	podSelector   labels.Selector
+}
+
+// evaluator evaluates NetworkPolicies the way Kubernetes network plugins enforce them: traffic is allowed in a
+// direction if no policy selects the pod for that direction, or if a rule of one of the selecting policies allows it.
+type evaluator struct {
+	policies        []policy
+	namespaceLabels map[string]map[string]string
+}
+
+func newEvaluator(networkPolicies []networkingv1.NetworkPolicy, namespaces []corev1.Namespace) (*evaluator, error) {
+	e := &evaluator{namespaceLabels: make(map[string]map[string]string)}
+	for _, namespace := range namespaces {
+		e.namespaceLabels[namespace.Name] = namespace.Labels
+	}
+	for _, networkPolicy := range networkPolicies {
+		podSelector, err := metav1.LabelSelectorAsSelector(&networkPolicy.Spec.PodSelector)
+		if err != nil {
+			return nil, errors.Errorf("invalid pod selector in network policy %s/%s: %w", networkPolicy.Namespace, networkPolicy.Name, err)
+		}
+		e.policies = append(e.policies, policy{networkPolicy: networkPolicy, podSelector: podSelector})
+	}
+	return e, nil
+}
+
+// defaultDenyPolicy is a policy selecting all the pods of namespace, without rules, in the given direction.
+func defaultDenyPolicy(namespace string, policyType networkingv1.PolicyType) networkingv1.NetworkPolicy {
+	return networkingv1.NetworkPolicy{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      fmt.Sprintf("default-deny-%s (simulated)", strings.ToLower(string(policyType))),
+			Namespace: namespace,
+		},
+		Spec: networkingv1.NetworkPolicySpec{PolicyTypes: []networkingv1.PolicyType{policyType}},
+	}
+}
+
+func appliesTo(networkPolicy networkingv1.NetworkPolicy, policyType networkingv1.PolicyType) bool {
+	if len(networkPolicy.Spec.PolicyTypes) == 0 {
+		// Policies without types always apply to ingress, and to egress only if they have egress rules.
+		return policyType == networkingv1.PolicyTypeIngress || len(networkPolicy.Spec.Egress) > 0
+	}
+	return lo.Contains(networkPolicy.Spec.PolicyTypes, policyType)
+}
+
+// ingress evaluates the policies of the destination pod for traffic from source. A nil port stands for a port that was
+// not observed, which is allowed if any port would be.
+func (e *evaluator) ingress(source *corev1.Pod, destination *corev1.Pod, port *podPort) verdict {
+	return e.evaluate(destination, networkingv1.PolicyTypeIngress, func(networkPolicy networkingv1.NetworkPolicy) bool {
+		return lo.ContainsBy(networkPolicy.Spec.Ingress, func(rule networkingv1.NetworkPolicyIngressRule) bool {
+			return e.peersMatch(rule.From, networkPolicy.Namespace, source) && portsMatch(rule.Ports, destination, port)
+		})
+	})
+}
+
+// egress evaluates the policies of the source pod for traffic to destination.
+func (e *evaluator) egress(source *corev1.Pod, destination *corev1.Pod, port *podPort) verdict {
+	return e.evaluate(source, networkingv1.PolicyTypeEgress, func(networkPolicy networkingv1.NetworkPolicy) bool {
+		return lo.ContainsBy(networkPolicy.Spec.Egress, func(rule networkingv1.NetworkPolicyEgressRule) bool {
+			return e.peersMatch(rule.To, networkPolicy.Namespace, destination) && portsMatch(rule.Ports, destination, port)
+		})
+	})
+}
+
+// egressToIP evaluates the policies of the source pod for traffic to an IP outside the cluster, which only rules
+// without peers or with a matching ipBlock allow.
+func (e *evaluator) egressToIP(source *corev1.Pod, ip string, port *podPort) verdict {
+	return e.evaluate(source, networkingv1.PolicyTypeEgress, func(networkPolicy networkingv1.NetworkPolicy) bool {
+		return lo.ContainsBy(networkPolicy.Spec.Egress, func(rule networkingv1.NetworkPolicyEgressRule) bool {
+			peersMatch := len(rule.To) == 0 || lo.ContainsBy(rule.To, func(peer networkingv1.NetworkPolicyPeer) bool {
+				return peer.IPBlock != nil && ipBlockContains(peer.IPBlock, ip)
+			})
+			return peersMatch && portsMatch(rule.Ports, nil, port)
+		})
+	})
+}
+
+func (e *evaluator) evaluate(pod *corev1.Pod, policyType networkingv1.PolicyType, allows func(networkingv1.NetworkPolicy) bool) verdict {
+	selecting := lo.Filter(e.policies, func(policy policy, _ int) bool {
+		return policy.networkPolicy.Namespace == pod.Namespace && appliesTo(policy.networkPolicy, policyType) &&
+			policy.podSelector.Matches(labels.Set(pod.Labels))
+	})
+	if len(selecting) == 0 || lo.ContainsBy(selecting, func(policy policy) bool { return allows(policy.networkPolicy) }) {
+		return verdict{allowed: true}
+	}
+	return verdict{policies: lo.Map(selecting, func(policy policy, _ int) string {
+		return fmt.Sprintf("%s/%s", policy.networkPolicy.Namespace, policy.networkPolicy.Name)
+	})}
+}
+
+// peersMatch reports whether pod is one of the peers of a rule in a policy of policyNamespace. A rule without peers
+// matches all pods.
+func (e *evaluator) peersMatch(peers []networkingv1.NetworkPolicyPeer, policyNamespace string, pod *corev1.Pod) bool {
+	if len(peers) == 0 {
+		return true
+	}
+	return lo.ContainsBy(peers, func(peer networkingv1.NetworkPolicyPeer) bool {
+		return e.peerMatches(peer, policyNamespace, pod)
+	})
+}
+
+func (e *evaluator) peerMatches(peer networkingv1.NetworkPolicyPeer, policyNamespace string, pod *corev1.Pod) bool {
+	if peer.IPBlock != nil {
+		return lo.ContainsBy(pod.Status.PodIPs, func(podIP corev1.PodIP) bool {
+			return ipBlockContains(peer.IPBlock, podIP.IP)
+		})
+	}
+
+	if peer.NamespaceSelector == nil {
+		if pod.Namespace != policyNamespace {
+			return false
+		}
+	} else if !selectorMatches(peer.NamespaceSelector, e.namespaceLabels[pod.Namespace]) {
+		return false
+	}
+	return peer.PodSelector == nil || selectorMatches(peer.PodSelector, pod.Labels)
+}
+
+// selectorMatches reports whether a selector of a policy rule matches podLabels. Invalid selectors match nothing, as
+// the API server rejects them.
+func selectorMatches(selector *metav1.LabelSelector, podLabels map[string]string) bool {
+	parsed, err := metav1.LabelSelectorAsSelector(selector)
+	if err != nil {
+		return false
+	}
+	return parsed.Matches(labels.Set(podLabels))
+}
+
+func ipBlockContains(ipBlock *networkingv1.IPBlock, ip string) bool {
+	addr, err := netip.ParseAddr(ip)
+	if err != nil {
+		return false
+	}
+	prefixContains := func(cidr string) bool {
+		prefix, err := netip.ParsePrefix(cidr)
+		return err == nil && prefix.Contains(addr)
+	}
+	return prefixContains(ipBlock.CIDR) && !lo.ContainsBy(ipBlock.Except, prefixContains)
+}
+
+// portsMatch reports whether port on the destination pod is one of the ports of a rule. A rule without ports matches
+// all ports, and a nil port matches any rule. destination is nil for a destination outside the cluster.
+func portsMatch(ports []networkingv1.NetworkPolicyPort, destination *corev1.Pod, port *podPort) bool {
+	if len(ports) == 0 || port == nil {
+		return true
+	}
+	number, resolved := port.resolve(destination)
+	return lo.ContainsBy(ports, func(rulePort networkingv1.NetworkPolicyPort) bool {
+		if lo.FromPtrOr(rulePort.Protocol, corev1.ProtocolTCP) != port.protocol {
+			return false
+		}
+		if rulePort.Port == nil {
+			return true
+		}
+		if rulePort.Port.Type == intstr.String {
+			if rulePort.Port.StrVal == port.name {
+				return true
+			}
+			// Named rule ports refer to the destination pod's container ports.
+			namedPort, ok := podPort{name: rulePort.Port.StrVal, protocol: port.protocol}.resolve(destination)
+			return ok && resolved && namedPort == number
+		}
+		if !resolved {
+			return false
+		}
+		if rulePort.EndPort != nil {
+			return number >= rulePort.Port.IntVal && number <= *rulePort.EndPort
+		}
+		return number == rulePort.Port.IntVal
+	})
+}
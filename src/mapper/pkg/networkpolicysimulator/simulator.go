@@ -0,0 +1,379 @@
+package networkpolicysimulator
+
+import (
+	"cmp"
+	"context"
+	"github.com/otterize/intents-operator/src/shared/errors"
+	"github.com/otterize/network-mapper/src/mapper/pkg/graph/model"
+	"github.com/otterize/network-mapper/src/mapper/pkg/intentsstore"
+	"github.com/otterize/network-mapper/src/mapper/pkg/kubefinder"
+	"github.com/otterize/network-mapper/src/mapper/pkg/sqlstore"
+	"github.com/samber/lo"
+	"github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"slices"
+)
+
+// externalIntentsPageSize is the page size external intents are read from the store in.
+const externalIntentsPageSize = 1000
+
+type WorkloadPodsLister interface {
+	ListWorkloadPods(ctx context.Context) ([]kubefinder.WorkloadPods, error)
+}
+
+// ExternalIntentsLister lists the stored external intents, along with the IPs and ports they were seen at.
+type ExternalIntentsLister interface {
+	GetExternalIntents(ctx context.Context, filter sqlstore.ExternalIntentsFilter, limit int, cursor string) (sqlstore.ExternalIntentsPage, error)
+}
+
+// Filter selects the intents to evaluate, and the policies to simulate on top of the cluster's.
+type Filter struct {
+	// Namespaces matches intents with either side in one of them. Empty matches all intents.
+	Namespaces                   []string
+	DefaultDenyIngressNamespaces []string
+	DefaultDenyEgressNamespaces  []string
+}
+
+// Simulator evaluates the cluster's NetworkPolicies against the in-cluster traffic held by the intents holder, and
+// against the external traffic stored by externalIntents. externalIntents may be nil, leaving external traffic out.
+type Simulator struct {
+	k8sClient       client.Client
+	workloads       WorkloadPodsLister
+	intentsHolder   *intentsstore.IntentsHolder
+	externalIntents ExternalIntentsLister
+}
+
+func NewSimulator(k8sClient client.Client, workloads WorkloadPodsLister, intentsHolder *intentsstore.IntentsHolder, externalIntents ExternalIntentsLister) *Simulator {
+	return &Simulator{
+		k8sClient:       k8sClient,
+		workloads:       workloads,
+		intentsHolder:   intentsHolder,
+		externalIntents: externalIntents,
+	}
+}
+
+// Simulation is the outcome of evaluating the policies against the observed intents.
+type Simulation struct {
+	DeniedIntents []model.DeniedIntent
+	// UnevaluatedIntents are the intents that could not be evaluated, ordered like DeniedIntents.
+	UnevaluatedIntents []model.UnevaluatedIntent
+}
+
+// observedPort is a port an intent was seen on, along with the port it reaches on the server's pods.
+type observedPort struct {
+	intentPort model.IntentPort
+	podPort    podPort
+}
+
+// edge is the traffic from a client to a server, merged across the intents held for them.
+type edge struct {
+	client model.OtterizeServiceIdentity
+	server model.OtterizeServiceIdentity
+	ports  []observedPort
+}
+
+// Simulate evaluates the NetworkPolicies against the intents matching filter. Denied intents are ordered by client,
+// then by server for in-cluster traffic followed by DNS name for external traffic.
+func (s *Simulator) Simulate(ctx context.Context, filter Filter) (Simulation, error) {
+	var networkPolicies networkingv1.NetworkPolicyList
+	if err := s.k8sClient.List(ctx, &networkPolicies); err != nil {
+		return Simulation{}, errors.Wrap(err)
+	}
+	var namespaces corev1.NamespaceList
+	if err := s.k8sClient.List(ctx, &namespaces); err != nil {
+		return Simulation{}, errors.Wrap(err)
+	}
+	var services corev1.ServiceList
+	if err := s.k8sClient.List(ctx, &services); err != nil {
+		return Simulation{}, errors.Wrap(err)
+	}
+
+	policies := networkPolicies.Items
+	for _, namespace := range filter.DefaultDenyIngressNamespaces {
+		policies = append(policies, defaultDenyPolicy(namespace, networkingv1.PolicyTypeIngress))
+	}
+	for _, namespace := range filter.DefaultDenyEgressNamespaces {
+		policies = append(policies, defaultDenyPolicy(namespace, networkingv1.PolicyTypeEgress))
+	}
+	evaluator, err := newEvaluator(policies, namespaces.Items)
+	if err != nil {
+		return Simulation{}, errors.Wrap(err)
+	}
+
+	workloads, err := s.workloads.ListWorkloadPods(ctx)
+	if err != nil {
+		return Simulation{}, errors.Wrap(err)
+	}
+	podsByWorkload := make(map[types.NamespacedName][]corev1.Pod)
+	for _, workload := range workloads {
+		podsByWorkload[workload.Identity.AsNamespacedName()] = workload.Pods
+	}
+
+	intents, err := s.intentsHolder.GetIntents(nil, nil, nil, false, nil)
+	if err != nil {
+		return Simulation{}, errors.Wrap(err)
+	}
+	externalIntents, err := s.listExternalIntents(ctx, filter.Namespaces)
+	if err != nil {
+		return Simulation{}, errors.Wrap(err)
+	}
+
+	simulation := Simulation{DeniedIntents: make([]model.DeniedIntent, 0), UnevaluatedIntents: make([]model.UnevaluatedIntent, 0)}
+	for _, edge := range newEdges(intents, services.Items, filter.Namespaces) {
+		clientPods := podsByWorkload[edge.client.AsNamespacedName()]
+		serverPods := podsByWorkload[edge.server.AsNamespacedName()]
+		reasons := make([]model.UnevaluatedIntentReason, 0)
+		if len(clientPods) == 0 {
+			reasons = append(reasons, model.UnevaluatedIntentReasonNoClientPods)
+		}
+		if len(serverPods) == 0 {
+			reasons = append(reasons, model.UnevaluatedIntentReasonNoServerPods)
+		}
+		if len(reasons) != 0 {
+			simulation.addUnevaluated(model.UnevaluatedIntent{Client: &edge.client, Server: &edge.server, Reasons: reasons})
+			continue
+		}
+		if deniedIntent, denied := evaluateEdge(evaluator, edge, clientPods, serverPods); denied {
+			simulation.DeniedIntents = append(simulation.DeniedIntents, deniedIntent)
+		}
+	}
+
+	for _, externalIntent := range externalIntents {
+		client := model.OtterizeServiceIdentity{Name: externalIntent.ClientName, Namespace: externalIntent.ClientNamespace}
+		if externalIntent.ClientKind != "" {
+			client.PodOwnerKind = &model.GroupVersionKind{Kind: externalIntent.ClientKind}
+		}
+		clientPods := podsByWorkload[client.AsNamespacedName()]
+		reasons := make([]model.UnevaluatedIntentReason, 0)
+		if len(clientPods) == 0 {
+			reasons = append(reasons, model.UnevaluatedIntentReasonNoClientPods)
+		}
+		if len(externalIntent.Addresses) == 0 {
+			reasons = append(reasons, model.UnevaluatedIntentReasonNoDestinationIPS)
+		}
+		if len(reasons) != 0 {
+			simulation.addUnevaluated(model.UnevaluatedIntent{Client: &client, DNSName: lo.ToPtr(externalIntent.DNSName), Reasons: reasons})
+			continue
+		}
+		if deniedIntent, denied := evaluateExternalIntent(evaluator, client, externalIntent, clientPods); denied {
+			simulation.DeniedIntents = append(simulation.DeniedIntents, deniedIntent)
+		}
+	}
+
+	slices.SortStableFunc(simulation.DeniedIntents, func(a, b model.DeniedIntent) int {
+		return compareIntents(a.Client, a.Server, a.DNSName, b.Client, b.Server, b.DNSName)
+	})
+	slices.SortStableFunc(simulation.UnevaluatedIntents, func(a, b model.UnevaluatedIntent) int {
+		return compareIntents(a.Client, a.Server, a.DNSName, b.Client, b.Server, b.DNSName)
+	})
+	return simulation, nil
+}
+
+func (s *Simulation) addUnevaluated(intent model.UnevaluatedIntent) {
+	logrus.WithFields(logrus.Fields{
+		"client":  intent.Client.AsNamespacedName().String(),
+		"server":  lo.TernaryF(intent.Server != nil, func() string { return intent.Server.AsNamespacedName().String() }, func() string { return lo.FromPtr(intent.DNSName) }),
+		"reasons": intent.Reasons,
+	}).Debug("Intent can't be evaluated against network policies")
+	s.UnevaluatedIntents = append(s.UnevaluatedIntents, intent)
+}
+
+// compareIntents orders intents by client, then in-cluster servers before DNS names.
+func compareIntents(clientA *model.OtterizeServiceIdentity, serverA *model.OtterizeServiceIdentity, dnsNameA *string, clientB *model.OtterizeServiceIdentity, serverB *model.OtterizeServiceIdentity, dnsNameB *string) int {
+	return cmp.Or(
+		cmp.Compare(clientA.Namespace, clientB.Namespace),
+		cmp.Compare(clientA.Name, clientB.Name),
+		cmp.Compare(lo.Ternary(serverA == nil, 1, 0), lo.Ternary(serverB == nil, 1, 0)),
+		cmp.Compare(lo.FromPtr(serverA).Namespace, lo.FromPtr(serverB).Namespace),
+		cmp.Compare(lo.FromPtr(serverA).Name, lo.FromPtr(serverB).Name),
+		cmp.Compare(lo.FromPtr(dnsNameA), lo.FromPtr(dnsNameB)),
+	)
+}
+
+// listExternalIntents returns the stored external intents of clients in namespaces, or of all clients if namespaces
+// is empty.
+func (s *Simulator) listExternalIntents(ctx context.Context, namespaces []string) ([]sqlstore.ExternalIntentRecord, error) {
+	if s.externalIntents == nil {
+		return nil, nil
+	}
+	records := make([]sqlstore.ExternalIntentRecord, 0)
+	cursor := ""
+	for {
+		page, err := s.externalIntents.GetExternalIntents(ctx, sqlstore.ExternalIntentsFilter{Namespaces: namespaces}, externalIntentsPageSize, cursor)
+		if err != nil {
+			return nil, errors.Wrap(err)
+		}
+		records = append(records, page.Intents...)
+		if page.NextCursor == "" {
+			return records, nil
+		}
+		cursor = page.NextCursor
+	}
+}
+
+// evaluateExternalIntent evaluates the egress policies of the client's pods for every IP and port stored for an
+// external intent. Addresses seen without a port are allowed if any port would be.
+func evaluateExternalIntent(evaluator *evaluator, client model.OtterizeServiceIdentity, externalIntent sqlstore.ExternalIntentRecord, clientPods []corev1.Pod) (model.DeniedIntent, bool) {
+	policies := make([]string, 0)
+	deniedIPs := make([]string, 0)
+	deniedPorts := make([]model.IntentPort, 0)
+	for _, address := range externalIntent.Addresses {
+		var port *podPort
+		if address.Port != 0 {
+			port = &podPort{number: int32(address.Port), protocol: corev1.Protocol(lo.CoalesceOrEmpty(address.Protocol, string(model.IntentProtocolTCP)))}
+		}
+		for i := range clientPods {
+			verdict := evaluator.egressToIP(&clientPods[i], address.IP, port)
+			if verdict.allowed {
+				continue
+			}
+			policies = append(policies, verdict.policies...)
+			deniedIPs = append(deniedIPs, address.IP)
+			if port != nil {
+				deniedPorts = append(deniedPorts, model.IntentPort{Port: int64(port.number), Protocol: model.IntentProtocol(port.protocol)})
+			}
+		}
+	}
+	if len(deniedIPs) == 0 {
+		return model.DeniedIntent{}, false
+	}
+
+	policies = lo.Uniq(policies)
+	slices.Sort(policies)
+	deniedIPs = lo.Uniq(deniedIPs)
+	slices.Sort(deniedIPs)
+	return model.DeniedIntent{
+		Client:   &client,
+		DNSName:  lo.ToPtr(externalIntent.DNSName),
+		Ips:      deniedIPs,
+		Ports:    lo.Uniq(deniedPorts),
+		DeniedBy: []model.NetworkPolicyDirection{model.NetworkPolicyDirectionEgress},
+		Policies: policies,
+	}, true
+}
+
+func evaluateEdge(evaluator *evaluator, edge edge, clientPods []corev1.Pod, serverPods []corev1.Pod) (model.DeniedIntent, bool) {
+	ports := lo.Map(edge.ports, func(port observedPort, _ int) *observedPort { return &port })
+	if len(ports) == 0 {
+		ports = []*observedPort{nil}
+	}
+
+	deniedBy := make(map[model.NetworkPolicyDirection]struct{})
+	policies := make([]string, 0)
+	deniedPorts := make([]model.IntentPort, 0)
+	for _, port := range ports {
+		var podPort *podPort
+		if port != nil {
+			podPort = &port.podPort
+		}
+		portDenied := false
+		for i := range clientPods {
+			for j := range serverPods {
+				for direction, verdict := range map[model.NetworkPolicyDirection]verdict{
+					model.NetworkPolicyDirectionIngress: evaluator.ingress(&clientPods[i], &serverPods[j], podPort),
+					model.NetworkPolicyDirectionEgress:  evaluator.egress(&clientPods[i], &serverPods[j], podPort),
+				} {
+					if !verdict.allowed {
+						portDenied = true
+						deniedBy[direction] = struct{}{}
+						policies = append(policies, verdict.policies...)
+					}
+				}
+			}
+		}
+		if portDenied && port != nil {
+			deniedPorts = append(deniedPorts, port.intentPort)
+		}
+	}
+	if len(deniedBy) == 0 {
+		return model.DeniedIntent{}, false
+	}
+
+	policies = lo.Uniq(policies)
+	slices.Sort(policies)
+	return model.DeniedIntent{
+		Client:   &edge.client,
+		Server:   &edge.server,
+		Ports:    lo.Uniq(deniedPorts),
+		DeniedBy: lo.Filter(model.AllNetworkPolicyDirection, func(direction model.NetworkPolicyDirection, _ int) bool { return lo.HasKey(deniedBy, direction) }),
+		Policies: policies,
+	}, true
+}
+
+// newEdges merges the intents between the same client and server into edges, ordered by client and server. The ports
+// of intents to Kubernetes services are mapped to the target ports of the services.
+func newEdges(intents []intentsstore.TimestampedIntent, services []corev1.Service, namespaces []string) []edge {
+	servicesByName := lo.KeyBy(services, func(service corev1.Service) types.NamespacedName {
+		return types.NamespacedName{Namespace: service.Namespace, Name: service.Name}
+	})
+
+	type edgeKey struct {
+		client types.NamespacedName
+		server types.NamespacedName
+	}
+	edgeIndexes := make(map[edgeKey]int)
+	edges := make([]edge, 0)
+	for _, intent := range intents {
+		if intent.Intent.Client == nil || intent.Intent.Server == nil {
+			continue
+		}
+		if len(namespaces) > 0 && !lo.Contains(namespaces, intent.Intent.Client.Namespace) && !lo.Contains(namespaces, intent.Intent.Server.Namespace) {
+			continue
+		}
+		key := edgeKey{client: intent.Intent.Client.AsNamespacedName(), server: intent.Intent.Server.AsNamespacedName()}
+		if _, ok := edgeIndexes[key]; !ok {
+			edgeIndexes[key] = len(edges)
+			edges = append(edges, edge{client: *intent.Intent.Client, server: *intent.Intent.Server})
+		}
+
+		var service *corev1.Service
+		if serviceName := intent.Intent.Server.KubernetesService; serviceName != nil {
+			if found, ok := servicesByName[types.NamespacedName{Namespace: intent.Intent.Server.Namespace, Name: *serviceName}]; ok {
+				service = &found
+			}
+		}
+		current := &edges[edgeIndexes[key]]
+		for _, intentPort := range intent.Intent.Ports {
+			port := observedPort{intentPort: intentPort, podPort: targetPort(service, intentPort)}
+			if !slices.Contains(current.ports, port) {
+				current.ports = append(current.ports, port)
+			}
+		}
+	}
+
+	slices.SortFunc(edges, func(a, b edge) int {
+		return cmp.Or(
+			cmp.Compare(a.client.Namespace, b.client.Namespace),
+			cmp.Compare(a.client.Name, b.client.Name),
+			cmp.Compare(a.server.Namespace, b.server.Namespace),
+			cmp.Compare(a.server.Name, b.server.Name),
+		)
+	})
+	return edges
+}
+
+// targetPort returns the port on the service's pods the intent port reaches. The port is used as is if service is nil
+// or does not expose it.
+func targetPort(service *corev1.Service, intentPort model.IntentPort) podPort {
+	port := podPort{number: int32(intentPort.Port), protocol: corev1.Protocol(intentPort.Protocol)}
+	if service == nil {
+		return port
+	}
+	servicePort, found := lo.Find(service.Spec.Ports, func(servicePort corev1.ServicePort) bool {
+		return servicePort.Port == port.number && lo.CoalesceOrEmpty(servicePort.Protocol, corev1.ProtocolTCP) == port.protocol
+	})
+	if !found {
+		return port
+	}
+	switch {
+	case servicePort.TargetPort.Type == intstr.String:
+		return podPort{name: servicePort.TargetPort.StrVal, protocol: port.protocol}
+	case servicePort.TargetPort.IntVal != 0:
+		return podPort{number: servicePort.TargetPort.IntVal, protocol: port.protocol}
+	}
+	return port
+}
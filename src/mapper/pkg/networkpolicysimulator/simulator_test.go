@@ -0,0 +1,318 @@
+package networkpolicysimulator
+
+import (
+	"context"
+	"github.com/otterize/network-mapper/src/mapper/pkg/graph/model"
+	"github.com/otterize/network-mapper/src/mapper/pkg/intentsstore"
+	"github.com/otterize/network-mapper/src/mapper/pkg/kubefinder"
+	"github.com/otterize/network-mapper/src/mapper/pkg/sqlstore"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/suite"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"testing"
+	"time"
+)
+
+type fakeWorkloadPodsLister []kubefinder.WorkloadPods
+
+func (f fakeWorkloadPodsLister) ListWorkloadPods(context.Context) ([]kubefinder.WorkloadPods, error) {
+	return f, nil
+}
+
+type fakeExternalIntentsLister []sqlstore.ExternalIntentRecord
+
+func (f fakeExternalIntentsLister) GetExternalIntents(_ context.Context, filter sqlstore.ExternalIntentsFilter, _ int, _ string) (sqlstore.ExternalIntentsPage, error) {
+	return sqlstore.ExternalIntentsPage{Intents: lo.Filter(f, func(record sqlstore.ExternalIntentRecord, _ int) bool {
+		return len(filter.Namespaces) == 0 || lo.Contains(filter.Namespaces, record.ClientNamespace)
+	})}, nil
+}
+
+type SimulatorTestSuite struct {
+	suite.Suite
+	intentsHolder   *intentsstore.IntentsHolder
+	workloads       fakeWorkloadPodsLister
+	externalIntents fakeExternalIntentsLister
+	objects         []client.Object
+}
+
+func (s *SimulatorTestSuite) SetupTest() {
+	s.intentsHolder = intentsstore.NewIntentsHolder()
+	s.workloads = nil
+	s.externalIntents = nil
+	s.objects = []client.Object{
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "shop", Labels: map[string]string{"team": "shop"}}},
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "billing", Labels: map[string]string{"team": "billing"}}},
+	}
+}
+
+func (s *SimulatorTestSuite) addWorkload(namespace string, name string, ip string, containerPorts ...corev1.ContainerPort) {
+	pod := corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: name + "-0", Namespace: namespace, Labels: map[string]string{"app": name}},
+		Spec:       corev1.PodSpec{Containers: []corev1.Container{{Name: name, Ports: containerPorts}}},
+		Status:     corev1.PodStatus{Phase: corev1.PodRunning, PodIPs: []corev1.PodIP{{IP: ip}}},
+	}
+	s.workloads = append(s.workloads, kubefinder.WorkloadPods{
+		Identity: model.OtterizeServiceIdentity{Name: name, Namespace: namespace},
+		Pods:     []corev1.Pod{pod},
+	})
+}
+
+func (s *SimulatorTestSuite) addIntent(client string, server model.OtterizeServiceIdentity, ports ...int64) {
+	s.intentsHolder.AddIntent(time.Now(), model.Intent{
+		Client: &model.OtterizeServiceIdentity{Name: client, Namespace: "shop"},
+		Server: &server,
+		Ports: lo.Map(ports, func(port int64, _ int) model.IntentPort {
+			return model.IntentPort{Port: port, Protocol: model.IntentProtocolTCP}
+		}),
+	}, nil)
+}
+
+func (s *SimulatorTestSuite) addExternalIntent(client string, dnsName string, addresses ...sqlstore.ExternalIntentAddressRecord) {
+	s.externalIntents = append(s.externalIntents, sqlstore.ExternalIntentRecord{
+		ClientName:      client,
+		ClientNamespace: "shop",
+		ClientKind:      "Deployment",
+		DNSName:         dnsName,
+		Addresses:       addresses,
+	})
+}
+
+func (s *SimulatorTestSuite) simulate(filter Filter) Simulation {
+	k8sClient := fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(s.objects...).Build()
+	simulation, err := NewSimulator(k8sClient, s.workloads, s.intentsHolder, s.externalIntents).Simulate(context.Background(), filter)
+	s.Require().NoError(err)
+	return simulation
+}
+
+func (s *SimulatorTestSuite) deniedIntents(filter Filter) []model.DeniedIntent {
+	return s.simulate(filter).DeniedIntents
+}
+
+func ingressPolicy(namespace string, name string, app string, rules ...networkingv1.NetworkPolicyIngressRule) *networkingv1.NetworkPolicy {
+	return &networkingv1.NetworkPolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+		Spec: networkingv1.NetworkPolicySpec{
+			PodSelector: metav1.LabelSelector{MatchLabels: map[string]string{"app": app}},
+			PolicyTypes: []networkingv1.PolicyType{networkingv1.PolicyTypeIngress},
+			Ingress:     rules,
+		},
+	}
+}
+
+func tcpPort(port intstr.IntOrString) networkingv1.NetworkPolicyPort {
+	return networkingv1.NetworkPolicyPort{Protocol: lo.ToPtr(corev1.ProtocolTCP), Port: &port}
+}
+
+func (s *SimulatorTestSuite) TestNoPoliciesAllowEverything() {
+	s.addWorkload("shop", "checkout", "10.0.0.1")
+	s.addWorkload("shop", "cart", "10.0.0.2")
+	s.addIntent("checkout", model.OtterizeServiceIdentity{Name: "cart", Namespace: "shop"}, 8080)
+
+	s.Require().Empty(s.deniedIntents(Filter{}))
+}
+
+func (s *SimulatorTestSuite) TestSimulatedDefaultDeny() {
+	s.addWorkload("shop", "checkout", "10.0.0.1")
+	s.addWorkload("shop", "cart", "10.0.0.2")
+	s.addWorkload("billing", "payments", "10.0.1.1")
+	s.addIntent("checkout", model.OtterizeServiceIdentity{Name: "cart", Namespace: "shop"}, 8080)
+	s.addIntent("checkout", model.OtterizeServiceIdentity{Name: "payments", Namespace: "billing"})
+	// Intents to workloads without running pods can't be evaluated
+	s.addIntent("checkout", model.OtterizeServiceIdentity{Name: "gone", Namespace: "billing"})
+
+	deniedIntents := s.deniedIntents(Filter{DefaultDenyIngressNamespaces: []string{"billing"}})
+	s.Require().Len(deniedIntents, 1)
+	s.Require().Equal("payments", deniedIntents[0].Server.Name)
+	s.Require().Empty(deniedIntents[0].Ports)
+	s.Require().Equal([]model.NetworkPolicyDirection{model.NetworkPolicyDirectionIngress}, deniedIntents[0].DeniedBy)
+	s.Require().Equal([]string{"billing/default-deny-ingress (simulated)"}, deniedIntents[0].Policies)
+
+	deniedIntents = s.deniedIntents(Filter{DefaultDenyEgressNamespaces: []string{"shop"}, Namespaces: []string{"shop"}})
+	s.Require().Len(deniedIntents, 2)
+	s.Require().Equal([]model.NetworkPolicyDirection{model.NetworkPolicyDirectionEgress}, deniedIntents[0].DeniedBy)
+	s.Require().Equal([]model.IntentPort{{Port: 8080, Protocol: model.IntentProtocolTCP}}, deniedIntents[1].Ports)
+}
+
+func (s *SimulatorTestSuite) TestSelectors() {
+	s.addWorkload("shop", "checkout", "10.0.0.1")
+	s.addWorkload("shop", "search", "10.0.0.3")
+	s.addWorkload("billing", "payments", "10.0.1.1")
+	s.addIntent("checkout", model.OtterizeServiceIdentity{Name: "payments", Namespace: "billing"})
+	s.addIntent("search", model.OtterizeServiceIdentity{Name: "payments", Namespace: "billing"})
+	s.objects = append(s.objects, ingressPolicy("billing", "allow-checkout", "payments", networkingv1.NetworkPolicyIngressRule{
+		From: []networkingv1.NetworkPolicyPeer{{
+			NamespaceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"team": "shop"}},
+			PodSelector:       &metav1.LabelSelector{MatchLabels: map[string]string{"app": "checkout"}},
+		}},
+	}))
+
+	deniedIntents := s.deniedIntents(Filter{})
+	s.Require().Len(deniedIntents, 1)
+	s.Require().Equal("search", deniedIntents[0].Client.Name)
+	s.Require().Equal([]string{"billing/allow-checkout"}, deniedIntents[0].Policies)
+}
+
+func (s *SimulatorTestSuite) TestPodSelectorWithoutNamespaceSelectorMatchesPolicyNamespace() {
+	s.addWorkload("shop", "checkout", "10.0.0.1")
+	s.addWorkload("billing", "payments", "10.0.1.1")
+	s.addIntent("checkout", model.OtterizeServiceIdentity{Name: "payments", Namespace: "billing"})
+	s.objects = append(s.objects, ingressPolicy("billing", "allow-checkout", "payments", networkingv1.NetworkPolicyIngressRule{
+		From: []networkingv1.NetworkPolicyPeer{{PodSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "checkout"}}}},
+	}))
+
+	s.Require().Len(s.deniedIntents(Filter{}), 1)
+}
+
+func (s *SimulatorTestSuite) TestIPBlocks() {
+	s.addWorkload("shop", "checkout", "10.0.0.1")
+	s.addWorkload("shop", "search", "10.0.0.3")
+	s.addWorkload("billing", "payments", "10.0.1.1")
+	s.addIntent("checkout", model.OtterizeServiceIdentity{Name: "payments", Namespace: "billing"})
+	s.addIntent("search", model.OtterizeServiceIdentity{Name: "payments", Namespace: "billing"})
+	s.objects = append(s.objects, ingressPolicy("billing", "allow-shop-subnet", "payments", networkingv1.NetworkPolicyIngressRule{
+		From: []networkingv1.NetworkPolicyPeer{{IPBlock: &networkingv1.IPBlock{CIDR: "10.0.0.0/24", Except: []string{"10.0.0.3/32"}}}},
+	}))
+
+	deniedIntents := s.deniedIntents(Filter{})
+	s.Require().Len(deniedIntents, 1)
+	s.Require().Equal("search", deniedIntents[0].Client.Name)
+}
+
+func (s *SimulatorTestSuite) TestPorts() {
+	s.addWorkload("shop", "checkout", "10.0.0.1")
+	s.addWorkload("billing", "payments", "10.0.1.1", corev1.ContainerPort{Name: "http", ContainerPort: 8080})
+	s.addWorkload("billing", "ledger", "10.0.1.2", corev1.ContainerPort{Name: "http", ContainerPort: 8080})
+	s.objects = append(s.objects,
+		&corev1.Service{
+			ObjectMeta: metav1.ObjectMeta{Name: "payments", Namespace: "billing"},
+			Spec:       corev1.ServiceSpec{Ports: []corev1.ServicePort{{Port: 80, Protocol: corev1.ProtocolTCP, TargetPort: intstr.FromString("http")}}},
+		},
+		ingressPolicy("billing", "allow-http", "payments", networkingv1.NetworkPolicyIngressRule{
+			Ports: []networkingv1.NetworkPolicyPort{tcpPort(intstr.FromInt32(8080))},
+		}),
+		ingressPolicy("billing", "allow-range", "ledger", networkingv1.NetworkPolicyIngressRule{
+			Ports: []networkingv1.NetworkPolicyPort{{Port: lo.ToPtr(intstr.FromInt32(9000)), EndPort: lo.ToPtr(int32(9100))}},
+		}),
+	)
+	// Port 80 of the service reaches the pods' 'http' port, 8080
+	s.addIntent("checkout", model.OtterizeServiceIdentity{Name: "payments", Namespace: "billing", KubernetesService: lo.ToPtr("payments")}, 80, 443)
+	s.addIntent("checkout", model.OtterizeServiceIdentity{Name: "ledger", Namespace: "billing"}, 8080, 9050)
+
+	deniedIntents := s.deniedIntents(Filter{})
+	s.Require().Len(deniedIntents, 2)
+	s.Require().Equal("ledger", deniedIntents[0].Server.Name)
+	s.Require().Equal([]model.IntentPort{{Port: 8080, Protocol: model.IntentProtocolTCP}}, deniedIntents[0].Ports)
+	s.Require().Equal("payments", deniedIntents[1].Server.Name)
+	s.Require().Equal([]model.IntentPort{{Port: 443, Protocol: model.IntentProtocolTCP}}, deniedIntents[1].Ports)
+}
+
+func (s *SimulatorTestSuite) TestNamedRulePorts() {
+	s.addWorkload("shop", "checkout", "10.0.0.1")
+	s.addWorkload("billing", "payments", "10.0.1.1", corev1.ContainerPort{Name: "http", ContainerPort: 8080})
+	s.objects = append(s.objects, ingressPolicy("billing", "allow-http", "payments", networkingv1.NetworkPolicyIngressRule{
+		Ports: []networkingv1.NetworkPolicyPort{tcpPort(intstr.FromString("http"))},
+	}))
+	s.addIntent("checkout", model.OtterizeServiceIdentity{Name: "payments", Namespace: "billing"}, 8080, 9090)
+
+	deniedIntents := s.deniedIntents(Filter{})
+	s.Require().Len(deniedIntents, 1)
+	s.Require().Equal([]model.IntentPort{{Port: 9090, Protocol: model.IntentProtocolTCP}}, deniedIntents[0].Ports)
+}
+
+func (s *SimulatorTestSuite) TestEgressAndIngressAreBothEvaluated() {
+	s.addWorkload("shop", "checkout", "10.0.0.1")
+	s.addWorkload("billing", "payments", "10.0.1.1")
+	s.addIntent("checkout", model.OtterizeServiceIdentity{Name: "payments", Namespace: "billing"})
+	s.objects = append(s.objects, &networkingv1.NetworkPolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: "checkout-egress", Namespace: "shop"},
+		Spec: networkingv1.NetworkPolicySpec{
+			PodSelector: metav1.LabelSelector{MatchLabels: map[string]string{"app": "checkout"}},
+			// Policies without types apply to egress once they have egress rules
+			Egress: []networkingv1.NetworkPolicyEgressRule{{
+				To: []networkingv1.NetworkPolicyPeer{{NamespaceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"team": "shop"}}}},
+			}},
+		},
+	})
+
+	deniedIntents := s.deniedIntents(Filter{DefaultDenyIngressNamespaces: []string{"billing"}})
+	s.Require().Len(deniedIntents, 1)
+	s.Require().Equal([]model.NetworkPolicyDirection{model.NetworkPolicyDirectionIngress, model.NetworkPolicyDirectionEgress}, deniedIntents[0].DeniedBy)
+	s.Require().Equal([]string{"billing/default-deny-ingress (simulated)", "shop/checkout-egress"}, deniedIntents[0].Policies)
+}
+
+func (s *SimulatorTestSuite) TestIntentsWithoutPodsAreReported() {
+	s.addWorkload("shop", "checkout", "10.0.0.1")
+	s.addIntent("checkout", model.OtterizeServiceIdentity{Name: "gone", Namespace: "billing"})
+	s.addIntent("stopped", model.OtterizeServiceIdentity{Name: "checkout", Namespace: "shop"})
+	s.addExternalIntent("stopped", "api.example.com", sqlstore.ExternalIntentAddressRecord{IP: "203.0.113.10", Port: 443, Protocol: "TCP"})
+	s.addExternalIntent("checkout", "unresolved.example.com")
+
+	simulation := s.simulate(Filter{DefaultDenyIngressNamespaces: []string{"billing"}, DefaultDenyEgressNamespaces: []string{"shop"}})
+	s.Require().Empty(simulation.DeniedIntents)
+	s.Require().Len(simulation.UnevaluatedIntents, 4)
+	s.Require().Equal("gone", simulation.UnevaluatedIntents[0].Server.Name)
+	s.Require().Equal([]model.UnevaluatedIntentReason{model.UnevaluatedIntentReasonNoServerPods}, simulation.UnevaluatedIntents[0].Reasons)
+	s.Require().Equal("unresolved.example.com", lo.FromPtr(simulation.UnevaluatedIntents[1].DNSName))
+	s.Require().Equal([]model.UnevaluatedIntentReason{model.UnevaluatedIntentReasonNoDestinationIPS}, simulation.UnevaluatedIntents[1].Reasons)
+	s.Require().Equal("checkout", simulation.UnevaluatedIntents[2].Server.Name)
+	s.Require().Equal([]model.UnevaluatedIntentReason{model.UnevaluatedIntentReasonNoClientPods}, simulation.UnevaluatedIntents[2].Reasons)
+	s.Require().Equal("api.example.com", lo.FromPtr(simulation.UnevaluatedIntents[3].DNSName))
+	s.Require().Equal([]model.UnevaluatedIntentReason{model.UnevaluatedIntentReasonNoClientPods}, simulation.UnevaluatedIntents[3].Reasons)
+}
+
+func (s *SimulatorTestSuite) TestExternalIntentsAreEvaluatedAgainstEgress() {
+	s.addWorkload("shop", "checkout", "10.0.0.1")
+	s.addWorkload("shop", "search", "10.0.0.3")
+	s.addWorkload("billing", "payments", "10.0.1.1")
+	s.addIntent("checkout", model.OtterizeServiceIdentity{Name: "payments", Namespace: "billing"}, 8080)
+	s.addExternalIntent("checkout", "api.example.com",
+		sqlstore.ExternalIntentAddressRecord{IP: "198.51.100.1", Port: 443, Protocol: "TCP"},
+		// Seen without a port, and allowed on port 443
+		sqlstore.ExternalIntentAddressRecord{IP: "203.0.113.10"},
+		sqlstore.ExternalIntentAddressRecord{IP: "203.0.113.10", Port: 80, Protocol: "TCP"},
+		sqlstore.ExternalIntentAddressRecord{IP: "203.0.113.10", Port: 443, Protocol: "TCP"},
+	)
+	s.addExternalIntent("search", "api.example.com", sqlstore.ExternalIntentAddressRecord{IP: "203.0.113.10", Port: 443, Protocol: "TCP"})
+	s.objects = append(s.objects, &networkingv1.NetworkPolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: "checkout-egress", Namespace: "shop"},
+		Spec: networkingv1.NetworkPolicySpec{
+			PodSelector: metav1.LabelSelector{MatchLabels: map[string]string{"app": "checkout"}},
+			PolicyTypes: []networkingv1.PolicyType{networkingv1.PolicyTypeEgress},
+			Egress: []networkingv1.NetworkPolicyEgressRule{
+				{To: []networkingv1.NetworkPolicyPeer{{NamespaceSelector: &metav1.LabelSelector{}}}},
+				{
+					To:    []networkingv1.NetworkPolicyPeer{{IPBlock: &networkingv1.IPBlock{CIDR: "203.0.113.0/24"}}},
+					Ports: []networkingv1.NetworkPolicyPort{tcpPort(intstr.FromInt32(443))},
+				},
+			},
+		},
+	})
+
+	deniedIntents := s.deniedIntents(Filter{})
+	s.Require().Len(deniedIntents, 1)
+	s.Require().Equal("checkout", deniedIntents[0].Client.Name)
+	s.Require().Nil(deniedIntents[0].Server)
+	s.Require().Equal("api.example.com", lo.FromPtr(deniedIntents[0].DNSName))
+	s.Require().Equal([]string{"198.51.100.1", "203.0.113.10"}, deniedIntents[0].Ips)
+	s.Require().Equal([]model.IntentPort{{Port: 443, Protocol: model.IntentProtocolTCP}, {Port: 80, Protocol: model.IntentProtocolTCP}}, deniedIntents[0].Ports)
+	s.Require().Equal([]model.NetworkPolicyDirection{model.NetworkPolicyDirectionEgress}, deniedIntents[0].DeniedBy)
+	s.Require().Equal([]string{"shop/checkout-egress"}, deniedIntents[0].Policies)
+
+	// External traffic is denied by simulated default-deny egress policies, and ordered after in-cluster traffic
+	deniedIntents = s.deniedIntents(Filter{DefaultDenyIngressNamespaces: []string{"billing"}, DefaultDenyEgressNamespaces: []string{"shop"}})
+	s.Require().Len(deniedIntents, 3)
+	s.Require().Equal("payments", deniedIntents[0].Server.Name)
+	s.Require().Equal("api.example.com", lo.FromPtr(deniedIntents[1].DNSName))
+	s.Require().Equal("search", deniedIntents[2].Client.Name)
+	s.Require().Equal([]string{"shop/default-deny-egress (simulated)"}, deniedIntents[2].Policies)
+}
+
+func TestSimulatorTestSuite(t *testing.T) {
+	suite.Run(t, new(SimulatorTestSuite))
+}
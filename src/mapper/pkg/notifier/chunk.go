@@ -0,0 +1,96 @@
+package notifier
+
+import (
+	"github.com/google/uuid"
+	"github.com/otterize/intents-operator/src/shared/errors"
+	"github.com/samber/lo"
+)
+
+// ChunkConfig limits the size of each notification. Intents exceeding the limits are split into several chunks, each
+// sent separately and carrying the same correlation ID, so receivers can reassemble them. Zero values are unlimited.
+type ChunkConfig struct {
+	// MaxIntents is the maximum number of intents sent in a single notification.
+	MaxIntents int `json:"maxIntents,omitempty"`
+	// MaxBytes is the maximum size of a single notification's payload. An intent that does not fit in MaxBytes on its
+	// own is still sent, in a chunk of its own.
+	MaxBytes int `json:"maxBytes,omitempty"`
+	// GroupByNamespace sends the intents of each client namespace in separate chunks.
+	GroupByNamespace bool `json:"groupByNamespace,omitempty"`
+}
+
+// Chunk is a set of intents sent in a single notification.
+type Chunk struct {
+	Event         Event
+	CorrelationID string
+	// Index is the chunk's 1-based position among the Count chunks sharing its correlation ID.
+	Index int
+	Count int
+	// Namespace is the client namespace of all the chunk's intents, when grouping by namespace.
+	Namespace string
+	Intents   []ExternalIntent
+}
+
+// chunk splits the intents matching the notifier's filter into chunks, measuring payloads with the notifier's payload
+// function. Returns no chunks if no intent matches.
+func (b base) chunk(event Event, intents []ExternalIntent, payload func(Chunk) ([]byte, error)) ([]Chunk, error) {
+	matching := lo.Filter(intents, func(intent ExternalIntent, _ int) bool { return b.filter.Matches(intent) })
+	if len(matching) == 0 {
+		return nil, nil
+	}
+
+	groups := [][]ExternalIntent{matching}
+	if b.chunking.GroupByNamespace {
+		byNamespace := lo.GroupBy(matching, func(intent ExternalIntent) string { return intent.ClientNamespace })
+		namespaces := lo.Uniq(lo.Map(matching, func(intent ExternalIntent, _ int) string { return intent.ClientNamespace }))
+		groups = lo.Map(namespaces, func(namespace string, _ int) []ExternalIntent { return byNamespace[namespace] })
+	}
+
+	correlationID := uuid.NewString()
+	chunks := make([]Chunk, 0)
+	for _, group := range groups {
+		groupChunks, err := b.chunkGroup(event, group, len(matching), payload)
+		if err != nil {
+			return nil, errors.Wrap(err)
+		}
+		chunks = append(chunks, groupChunks...)
+	}
+	for i := range chunks {
+		chunks[i].Event = event
+		chunks[i].CorrelationID = correlationID
+		chunks[i].Index = i + 1
+		chunks[i].Count = len(chunks)
+	}
+	return chunks, nil
+}
+
+func (b base) chunkGroup(event Event, intents []ExternalIntent, maxChunks int, payload func(Chunk) ([]byte, error)) ([]Chunk, error) {
+	namespace := ""
+	if b.chunking.GroupByNamespace {
+		namespace = intents[0].ClientNamespace
+	}
+
+	chunks := make([]Chunk, 0)
+	current := make([]ExternalIntent, 0)
+	for _, intent := range intents {
+		if b.chunking.MaxIntents > 0 && len(current) == b.chunking.MaxIntents {
+			chunks = append(chunks, Chunk{Namespace: namespace, Intents: current})
+			current = make([]ExternalIntent, 0)
+		}
+
+		if b.chunking.MaxBytes > 0 && len(current) > 0 {
+			// The chunk's position is not known yet, so the payload is measured with the largest position possible.
+			candidate := Chunk{Event: event, CorrelationID: uuid.Nil.String(), Index: maxChunks, Count: maxChunks, Namespace: namespace, Intents: append(current[:len(current):len(current)], intent)}
+			rendered, err := payload(candidate)
+			if err != nil {
+				return nil, errors.Wrap(err)
+			}
+			if len(rendered) > b.chunking.MaxBytes {
+				chunks = append(chunks, Chunk{Namespace: namespace, Intents: current})
+				current = make([]ExternalIntent, 0)
+			}
+		}
+		current = append(current, intent)
+	}
+	chunks = append(chunks, Chunk{Namespace: namespace, Intents: current})
+	return chunks, nil
+}
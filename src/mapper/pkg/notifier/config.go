@@ -0,0 +1,190 @@
+package notifier
+
+import (
+	"fmt"
+	"github.com/otterize/intents-operator/src/shared/errors"
+	"github.com/otterize/network-mapper/src/mapper/pkg/config"
+	"github.com/samber/lo"
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/viper"
+	"os"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	TypeWebhook = "webhook"
+	TypeSlack   = "slack"
+	TypeGitHub  = "github"
+)
+
+// GitHubDispatchNotifierName is the name of the GitHub dispatch notifier configured by the gha-* options.
+const GitHubDispatchNotifierName = "github-dispatch"
+
+// NotifierConfig configures a single notifier in the notifiers config file. URLs, secrets, tokens and header values
+// may reference environment variables as ${VAR}, so they can be kept in Kubernetes secrets.
+type NotifierConfig struct {
+	Name string `json:"name"`
+	Type string `json:"type"`
+	// Template is a text/template rendering the JSON payload; see TemplateData. Defaults to the type's default template.
+	Template string `json:"template,omitempty"`
+	Filter   Filter `json:"filter,omitempty"`
+	// Chunking limits the size of each notification. Defaults to unlimited, except for github notifiers, which default
+	// to DefaultGitHubMaxIntents and DefaultGitHubMaxBytes.
+	Chunking *ChunkConfig `json:"chunking,omitempty"`
+
+	// webhook
+	URL     string            `json:"url,omitempty"`
+	Secret  string            `json:"secret,omitempty"`
+	Headers map[string]string `json:"headers,omitempty"`
+
+	// slack
+	WebhookURL string `json:"webhookURL,omitempty"`
+
+	// github
+	APIHost   string `json:"apiHost,omitempty"`
+	Owner     string `json:"owner,omitempty"`
+	Repo      string `json:"repo,omitempty"`
+	Token     string `json:"token,omitempty"`
+	EventType string `json:"eventType,omitempty"`
+}
+
+type FileConfig struct {
+	Notifiers []NotifierConfig `json:"notifiers"`
+}
+
+func LoadConfigFile(path string) (FileConfig, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return FileConfig{}, errors.Wrap(err)
+	}
+	var fileConfig FileConfig
+	if err := yaml.UnmarshalStrict(content, &fileConfig); err != nil {
+		return FileConfig{}, errors.Errorf("failed parsing notifiers config file %s: %w", path, err)
+	}
+	return fileConfig, nil
+}
+
+func orDefault(value string, defaultValue string) string {
+	if value == "" {
+		return defaultValue
+	}
+	return value
+}
+
+// NewNotifier creates the notifier described by notifierConfig.
+func NewNotifier(notifierConfig NotifierConfig, cluster string) (Notifier, error) {
+	if notifierConfig.Name == "" {
+		return nil, errors.Errorf("notifier of type %q has no name", notifierConfig.Type)
+	}
+	n, err := newNotifier(notifierConfig, cluster)
+	if err != nil {
+		return nil, errors.Wrap(err)
+	}
+	return n, nil
+}
+
+func newNotifier(notifierConfig NotifierConfig, cluster string) (Notifier, error) {
+	chunking := lo.FromPtr(notifierConfig.Chunking)
+	switch notifierConfig.Type {
+	case TypeWebhook:
+		headers := make(map[string]string, len(notifierConfig.Headers))
+		for key, value := range notifierConfig.Headers {
+			headers[key] = os.ExpandEnv(value)
+		}
+		return NewWebhookNotifier(
+			notifierConfig.Name,
+			cluster,
+			notifierConfig.Filter,
+			chunking,
+			orDefault(notifierConfig.Template, DefaultWebhookTemplate),
+			os.ExpandEnv(notifierConfig.URL),
+			os.ExpandEnv(notifierConfig.Secret),
+			headers,
+		)
+	case TypeSlack:
+		return NewSlackNotifier(
+			notifierConfig.Name,
+			cluster,
+			notifierConfig.Filter,
+			chunking,
+			orDefault(notifierConfig.Template, DefaultSlackTemplate),
+			os.ExpandEnv(notifierConfig.WebhookURL),
+		)
+	case TypeGitHub:
+		if notifierConfig.Chunking == nil {
+			chunking = ChunkConfig{MaxIntents: DefaultGitHubMaxIntents, MaxBytes: DefaultGitHubMaxBytes}
+		}
+		return NewGitHubDispatchNotifier(
+			notifierConfig.Name,
+			cluster,
+			notifierConfig.Filter,
+			chunking,
+			orDefault(notifierConfig.Template, DefaultGitHubTemplate),
+			orDefault(notifierConfig.APIHost, config.GhaUrlDefault),
+			notifierConfig.Owner,
+			notifierConfig.Repo,
+			os.ExpandEnv(notifierConfig.Token),
+			orDefault(notifierConfig.EventType, fmt.Sprintf("%s-%s", cluster, config.GhaEventTypeDefault)),
+		)
+	default:
+		return nil, errors.Errorf("notifier %s has unknown type %q, expected one of %s, %s, %s", notifierConfig.Name, notifierConfig.Type, TypeWebhook, TypeSlack, TypeGitHub)
+	}
+}
+
+// NotifiersFromViper creates the notifiers listed in the notifiers config file, along with the GitHub dispatch
+// notifier configured by the gha-* options when it is enabled. Notifier names must be unique, as pending notifications
+// are matched to their notifier by name.
+func NotifiersFromViper() ([]Notifier, error) {
+	cluster := viper.GetString(config.ClusterKey)
+	notifiers := make([]Notifier, 0)
+
+	if viper.GetBool(config.GhaDispatchEnabledKey) {
+		if viper.GetString(config.GhaOwnerKey) == "" || viper.GetString(config.GhaRepoKey) == "" {
+			logrus.Warn("GitHub Actions dispatch is enabled, but the GitHub owner or repo is not configured, not dispatching")
+		} else {
+			gitHubNotifier, err := NewGitHubDispatchNotifier(
+				GitHubDispatchNotifierName,
+				cluster,
+				Filter{},
+				ChunkConfig{
+					MaxIntents:       viper.GetInt(config.GhaChunkMaxIntentsKey),
+					MaxBytes:         viper.GetInt(config.GhaChunkMaxBytesKey),
+					GroupByNamespace: viper.GetBool(config.GhaChunkGroupByNamespaceKey),
+				},
+				DefaultGitHubTemplate,
+				viper.GetString(config.GhaUrlKey),
+				viper.GetString(config.GhaOwnerKey),
+				viper.GetString(config.GhaRepoKey),
+				viper.GetString(config.GhaTokenKey),
+				fmt.Sprintf("%s-%s", cluster, viper.GetString(config.GhaEventTypeKey)),
+			)
+			if err != nil {
+				return nil, errors.Wrap(err)
+			}
+			notifiers = append(notifiers, gitHubNotifier)
+		}
+	}
+
+	configFile := viper.GetString(config.NotifiersConfigFileKey)
+	if configFile == "" {
+		return notifiers, nil
+	}
+	fileConfig, err := LoadConfigFile(configFile)
+	if err != nil {
+		return nil, errors.Wrap(err)
+	}
+	for _, notifierConfig := range fileConfig.Notifiers {
+		if _, found := lo.Find(notifiers, func(n Notifier) bool { return n.Name() == notifierConfig.Name }); found {
+			if notifierConfig.Name == GitHubDispatchNotifierName {
+				return nil, errors.Errorf("notifier name %s in %s is reserved for the GitHub dispatch notifier enabled by %s", notifierConfig.Name, configFile, config.GhaDispatchEnabledKey)
+			}
+			return nil, errors.Errorf("notifier name %s appears more than once in %s", notifierConfig.Name, configFile)
+		}
+		n, err := NewNotifier(notifierConfig, cluster)
+		if err != nil {
+			return nil, errors.Wrap(err)
+		}
+		notifiers = append(notifiers, n)
+	}
+	return notifiers, nil
+}
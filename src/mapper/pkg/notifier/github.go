@@ -0,0 +1,79 @@
+package notifier
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/otterize/intents-operator/src/shared/errors"
+	"github.com/otterize/network-mapper/src/mapper/pkg/config"
+	"net/http"
+)
+
+const (
+	// DefaultGitHubTemplate renders the dispatch event's client_payload. GitHub allows at most 10 top-level properties.
+	DefaultGitHubTemplate = DefaultWebhookTemplate
+
+	// DefaultGitHubMaxIntents and DefaultGitHubMaxBytes keep dispatch events well below GitHub's payload size limit.
+	DefaultGitHubMaxIntents = config.GhaChunkMaxIntentsDefault
+	DefaultGitHubMaxBytes   = config.GhaChunkMaxBytesDefault
+)
+
+// GitHubDispatchNotifier triggers a GitHub repository_dispatch event, so workflows can act on new intents.
+type GitHubDispatchNotifier struct {
+	base
+	url       string
+	token     string
+	eventType string
+	client    *http.Client
+}
+
+// NewGitHubDispatchNotifier creates a notifier dispatching to https://<apiHost>/repos/<owner>/<repo>/dispatches. The
+// template renders the event's client_payload.
+func NewGitHubDispatchNotifier(name string, cluster string, filter Filter, chunking ChunkConfig, templateText string, apiHost string, owner string, repo string, token string, eventType string) (*GitHubDispatchNotifier, error) {
+	if owner == "" || repo == "" {
+		return nil, errors.Errorf("github notifier %s: owner and repo are required", name)
+	}
+	b, err := newBase(name, cluster, filter, chunking, templateText)
+	if err != nil {
+		return nil, errors.Wrap(err)
+	}
+	return &GitHubDispatchNotifier{
+		base:      b,
+		url:       fmt.Sprintf("https://%s/repos/%s/%s/dispatches", apiHost, owner, repo),
+		token:     token,
+		eventType: eventType,
+		client:    &http.Client{Timeout: httpTimeout},
+	}, nil
+}
+
+type gitHubDispatchEvent struct {
+	EventType     string          `json:"event_type"`
+	ClientPayload json.RawMessage `json:"client_payload"`
+}
+
+func (n *GitHubDispatchNotifier) Chunks(event Event, intents []ExternalIntent) ([]Chunk, error) {
+	return n.chunk(event, intents, n.payload)
+}
+
+func (n *GitHubDispatchNotifier) payload(chunk Chunk) ([]byte, error) {
+	clientPayload, err := n.render(chunk)
+	if err != nil {
+		return nil, errors.Wrap(err)
+	}
+	payload, err := json.Marshal(gitHubDispatchEvent{EventType: n.eventType, ClientPayload: clientPayload})
+	if err != nil {
+		return nil, errors.Wrap(err)
+	}
+	return payload, nil
+}
+
+func (n *GitHubDispatchNotifier) Notify(ctx context.Context, chunk Chunk) error {
+	payload, err := n.payload(chunk)
+	if err != nil {
+		return errors.Wrap(err)
+	}
+	return errors.Wrap(post(ctx, n.client, n.url, payload, map[string]string{
+		"Accept":        "application/vnd.github+json",
+		"Authorization": fmt.Sprintf("Bearer %s", n.token),
+	}))
+}
@@ -0,0 +1,230 @@
+package notifier
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/otterize/intents-operator/src/shared/errors"
+	"github.com/samber/lo"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"text/template"
+	"time"
+)
+
+const httpTimeout = 10 * time.Second
+
+// ExternalIntent is a newly discovered external traffic intent, as sent to notifiers.
+type ExternalIntent struct {
+	ClientName      string `json:"client_name"`
+	ClientNamespace string `json:"client_namespace"`
+	ClientKind      string `json:"client_kind"`
+	DNSName         string `json:"dns_name"`
+}
+
+// Event is what happened to the intents a notification is sent for.
+type Event string
+
+const (
+	// EventNewIntent is sent when external intents are seen for the first time.
+	EventNewIntent Event = "new_intent"
+	// EventDeniedIntentSeen is sent when external intents that were denied are seen again, at most once a day per intent.
+	EventDeniedIntentSeen Event = "denied_intent_seen"
+)
+
+// Notifier sends newly discovered external intents to an outside system.
+type Notifier interface {
+	Name() string
+	// Matches returns whether the intent passes the notifier's filter.
+	Matches(intent ExternalIntent) bool
+	// Chunks splits the intents matching the notifier's filter into the notifications to send, according to the
+	// notifier's chunking config. Intents that don't match are dropped silently, and no chunks are returned if none match.
+	Chunks(event Event, intents []ExternalIntent) ([]Chunk, error)
+	// Notify sends a single chunk.
+	Notify(ctx context.Context, chunk Chunk) error
+}
+
+// Filter selects the intents a notifier is interested in. Empty lists match everything.
+type Filter struct {
+	ClientNames []string `json:"clientNames,omitempty"`
+	Namespaces  []string `json:"namespaces,omitempty"`
+	// DNSNames are matched exactly, or as all subdomains of a wildcard such as '*.example.com'.
+	DNSNames []string `json:"dnsNames,omitempty"`
+}
+
+func (f Filter) Matches(intent ExternalIntent) bool {
+	if len(f.ClientNames) > 0 && !lo.Contains(f.ClientNames, intent.ClientName) {
+		return false
+	}
+	if len(f.Namespaces) > 0 && !lo.Contains(f.Namespaces, intent.ClientNamespace) {
+		return false
+	}
+	if len(f.DNSNames) > 0 && !lo.ContainsBy(f.DNSNames, func(dnsName string) bool { return matchDNSName(dnsName, intent.DNSName) }) {
+		return false
+	}
+	return true
+}
+
+func matchDNSName(pattern string, dnsName string) bool {
+	if suffix, isWildcard := strings.CutPrefix(pattern, "*"); isWildcard {
+		return strings.HasSuffix(dnsName, suffix)
+	}
+	return pattern == dnsName
+}
+
+// TemplateData is what payload templates are executed with.
+type TemplateData struct {
+	Cluster       string           `json:"cluster"`
+	Event         Event            `json:"event"`
+	CorrelationID string           `json:"correlation_id"`
+	Chunk         int              `json:"chunk"`
+	ChunkCount    int              `json:"chunk_count"`
+	Namespace     string           `json:"namespace"`
+	Intents       []ExternalIntent `json:"intents"`
+}
+
+var templateFuncs = template.FuncMap{
+	// json encodes a value as JSON, so templates can safely embed strings and lists in their payload.
+	"json": func(value any) (string, error) {
+		encoded, err := json.Marshal(value)
+		if err != nil {
+			return "", errors.Wrap(err)
+		}
+		return string(encoded), nil
+	},
+}
+
+// payloadTemplate renders a notifier's JSON payload from a text/template.
+type payloadTemplate struct {
+	template *template.Template
+}
+
+func newPayloadTemplate(name string, text string) (payloadTemplate, error) {
+	tmpl, err := template.New(name).Funcs(templateFuncs).Option("missingkey=error").Parse(text)
+	if err != nil {
+		return payloadTemplate{}, errors.Errorf("invalid payload template for notifier %s: %w", name, err)
+	}
+	return payloadTemplate{template: tmpl}, nil
+}
+
+func (t payloadTemplate) render(data TemplateData) ([]byte, error) {
+	var payload bytes.Buffer
+	if err := t.template.Execute(&payload, data); err != nil {
+		return nil, errors.Wrap(err)
+	}
+	if !json.Valid(payload.Bytes()) {
+		return nil, errors.Errorf("payload template %s did not render valid JSON", t.template.Name())
+	}
+	return payload.Bytes(), nil
+}
+
+// base holds the behavior shared by all notifiers - filtering and chunking intents, and rendering the payload.
+type base struct {
+	name     string
+	cluster  string
+	filter   Filter
+	chunking ChunkConfig
+	template payloadTemplate
+}
+
+func newBase(name string, cluster string, filter Filter, chunking ChunkConfig, templateText string) (base, error) {
+	tmpl, err := newPayloadTemplate(name, templateText)
+	if err != nil {
+		return base{}, errors.Wrap(err)
+	}
+	return base{name: name, cluster: cluster, filter: filter, chunking: chunking, template: tmpl}, nil
+}
+
+func (b base) Name() string {
+	return b.name
+}
+
+func (b base) Matches(intent ExternalIntent) bool {
+	return b.filter.Matches(intent)
+}
+
+func (b base) render(chunk Chunk) ([]byte, error) {
+	payload, err := b.template.render(TemplateData{
+		Cluster:       b.cluster,
+		Event:         chunk.Event,
+		CorrelationID: chunk.CorrelationID,
+		Chunk:         chunk.Index,
+		ChunkCount:    chunk.Count,
+		Namespace:     chunk.Namespace,
+		Intents:       chunk.Intents,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err)
+	}
+	return payload, nil
+}
+
+// StatusError is returned when the receiving side responds with a non-2xx status.
+type StatusError struct {
+	StatusCode int
+	Header     http.Header
+	Body       string
+}
+
+func (e *StatusError) Error() string {
+	return fmt.Sprintf("unexpected response status %d: %s", e.StatusCode, e.Body)
+}
+
+// Retryable returns whether sending the same request again may succeed. Client errors are not retryable, except for
+// timeouts and rate limiting.
+func (e *StatusError) Retryable() bool {
+	if e.StatusCode < 400 || e.StatusCode >= 500 {
+		return true
+	}
+	if e.StatusCode == http.StatusRequestTimeout || e.StatusCode == http.StatusTooManyRequests {
+		return true
+	}
+	_, rateLimited := e.RetryAt(time.Now())
+	return rateLimited
+}
+
+// RetryAt returns when the receiving side asked to be retried, from the Retry-After header (in seconds or as an HTTP
+// date), or from X-RateLimit-Reset (a unix timestamp, as sent by GitHub) when the rate limit is exhausted.
+func (e *StatusError) RetryAt(now time.Time) (time.Time, bool) {
+	if retryAfter := e.Header.Get("Retry-After"); retryAfter != "" {
+		if seconds, err := strconv.Atoi(retryAfter); err == nil {
+			return now.Add(time.Duration(seconds) * time.Second), true
+		}
+		if date, err := http.ParseTime(retryAfter); err == nil {
+			return date, true
+		}
+	}
+	if e.Header.Get("X-RateLimit-Remaining") == "0" {
+		if reset, err := strconv.ParseInt(e.Header.Get("X-RateLimit-Reset"), 10, 64); err == nil {
+			return time.Unix(reset, 0), true
+		}
+	}
+	return time.Time{}, false
+}
+
+func post(ctx context.Context, client *http.Client, url string, payload []byte, headers map[string]string) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(payload))
+	if err != nil {
+		return errors.Wrap(err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	for key, value := range headers {
+		req.Header.Set(key, value)
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return errors.Wrap(err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		// The body is only used for the error message, so a failure to read it is not interesting.
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return &StatusError{StatusCode: resp.StatusCode, Header: resp.Header, Body: strings.TrimSpace(string(body))}
+	}
+	return nil
+}
@@ -0,0 +1,396 @@
+package notifier
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/otterize/intents-operator/src/shared/errors"
+	"github.com/otterize/network-mapper/src/mapper/pkg/config"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+type receivedRequest struct {
+	path   string
+	header http.Header
+	body   []byte
+}
+
+type NotifierSuite struct {
+	suite.Suite
+	server   *httptest.Server
+	requests []receivedRequest
+	status   int
+}
+
+func (s *NotifierSuite) SetupTest() {
+	s.requests = nil
+	s.status = http.StatusNoContent
+	s.server = httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		s.Require().NoError(err)
+		s.requests = append(s.requests, receivedRequest{path: r.URL.Path, header: r.Header, body: body})
+		w.WriteHeader(s.status)
+	}))
+}
+
+func (s *NotifierSuite) TearDownTest() {
+	s.server.Close()
+}
+
+var testIntents = []ExternalIntent{
+	{ClientName: "frontend", ClientNamespace: "production", ClientKind: "Deployment", DNSName: "api.example.com"},
+	{ClientName: "worker", ClientNamespace: "batch", ClientKind: "CronJob", DNSName: "storage.googleapis.com"},
+}
+
+func notifyAll(n Notifier, intents []ExternalIntent) error {
+	return notifyAllOfEvent(n, EventNewIntent, intents)
+}
+
+func notifyAllOfEvent(n Notifier, event Event, intents []ExternalIntent) error {
+	chunks, err := n.Chunks(event, intents)
+	if err != nil {
+		return errors.Wrap(err)
+	}
+	for _, chunk := range chunks {
+		if err := n.Notify(context.Background(), chunk); err != nil {
+			return errors.Wrap(err)
+		}
+	}
+	return nil
+}
+
+func (s *NotifierSuite) TestWebhookIsSigned() {
+	n, err := NewWebhookNotifier("tickets", "prod", Filter{}, ChunkConfig{}, DefaultWebhookTemplate, s.server.URL+"/hook", "s3cr3t", map[string]string{"X-Team": "platform"})
+	s.Require().NoError(err)
+	n.client = s.server.Client()
+	n.now = func() time.Time { return time.Unix(1700000000, 0) }
+
+	s.Require().NoError(notifyAll(n, testIntents))
+	s.Require().Len(s.requests, 1)
+	request := s.requests[0]
+	s.Require().Equal("/hook", request.path)
+	s.Require().Equal("platform", request.header.Get("X-Team"))
+	s.Require().Equal("1700000000", request.header.Get(TimestampHeader))
+	s.Require().Equal(Sign("s3cr3t", "1700000000", request.body), request.header.Get(SignatureHeader))
+
+	var payload TemplateData
+	s.Require().NoError(json.Unmarshal(request.body, &payload))
+	s.Require().Equal("prod", payload.Cluster)
+	s.Require().Equal(EventNewIntent, payload.Event)
+	s.Require().Equal(testIntents, payload.Intents)
+}
+
+func (s *NotifierSuite) TestSlackMessageListsIntents() {
+	n, err := NewSlackNotifier("slack", "prod", Filter{}, ChunkConfig{}, DefaultSlackTemplate, s.server.URL)
+	s.Require().NoError(err)
+	n.client = s.server.Client()
+
+	s.Require().NoError(notifyAll(n, testIntents))
+	s.Require().Len(s.requests, 1)
+	var message struct {
+		Text string `json:"text"`
+	}
+	s.Require().NoError(json.Unmarshal(s.requests[0].body, &message))
+	s.Require().Equal("New external traffic intents discovered in cluster *prod*:\n"+
+		"• production/frontend (Deployment) → api.example.com\n"+
+		"• batch/worker (CronJob) → storage.googleapis.com", message.Text)
+}
+
+func (s *NotifierSuite) TestSlackMessageForDeniedIntents() {
+	n, err := NewSlackNotifier("slack", "prod", Filter{}, ChunkConfig{}, DefaultSlackTemplate, s.server.URL)
+	s.Require().NoError(err)
+	n.client = s.server.Client()
+
+	s.Require().NoError(notifyAllOfEvent(n, EventDeniedIntentSeen, testIntents[:1]))
+	s.Require().Len(s.requests, 1)
+	var message struct {
+		Text string `json:"text"`
+	}
+	s.Require().NoError(json.Unmarshal(s.requests[0].body, &message))
+	s.Require().Equal("Denied external traffic intents seen again in cluster *prod*:\n"+
+		"• production/frontend (Deployment) → api.example.com", message.Text)
+}
+
+func (s *NotifierSuite) TestGitHubDispatch() {
+	apiHost := strings.TrimPrefix(s.server.URL, "https://")
+	n, err := NewGitHubDispatchNotifier("github", "prod", Filter{}, ChunkConfig{}, DefaultGitHubTemplate, apiHost, "otterize", "policies", "token", "prod-newIntents")
+	s.Require().NoError(err)
+	n.client = s.server.Client()
+
+	s.Require().NoError(notifyAll(n, testIntents))
+	s.Require().Len(s.requests, 1)
+	s.Require().Equal("/repos/otterize/policies/dispatches", s.requests[0].path)
+	s.Require().Equal("Bearer token", s.requests[0].header.Get("Authorization"))
+
+	var event struct {
+		EventType     string       `json:"event_type"`
+		ClientPayload TemplateData `json:"client_payload"`
+	}
+	s.Require().NoError(json.Unmarshal(s.requests[0].body, &event))
+	s.Require().Equal("prod-newIntents", event.EventType)
+	s.Require().Equal(testIntents, event.ClientPayload.Intents)
+}
+
+func (s *NotifierSuite) TestFilterAndCustomTemplate() {
+	template := `{"dns": [{{ range $i, $intent := .Intents }}{{ if $i }}, {{ end }}{{ json $intent.DNSName }}{{ end }}]}`
+	n, err := NewWebhookNotifier("filtered", "prod", Filter{DNSNames: []string{"*.googleapis.com"}}, ChunkConfig{}, template, s.server.URL, "", nil)
+	s.Require().NoError(err)
+	n.client = s.server.Client()
+
+	s.Require().NoError(notifyAll(n, testIntents))
+	s.Require().Len(s.requests, 1)
+	s.Require().JSONEq(`{"dns": ["storage.googleapis.com"]}`, string(s.requests[0].body))
+	s.Require().Empty(s.requests[0].header.Get(SignatureHeader))
+
+	// Nothing is sent when no intent matches.
+	s.Require().NoError(notifyAll(n, testIntents[:1]))
+	s.Require().Len(s.requests, 1)
+}
+
+func (s *NotifierSuite) TestErrorStatusIsReturned() {
+	s.status = http.StatusTooManyRequests
+	n, err := NewSlackNotifier("slack", "prod", Filter{}, ChunkConfig{}, DefaultSlackTemplate, s.server.URL)
+	s.Require().NoError(err)
+	n.client = s.server.Client()
+
+	err = notifyAll(n, testIntents)
+	var statusErr *StatusError
+	s.Require().True(errors.As(err, &statusErr))
+	s.Require().Equal(http.StatusTooManyRequests, statusErr.StatusCode)
+}
+
+func (s *NotifierSuite) TestTemplateMustRenderJSON() {
+	n, err := NewWebhookNotifier("broken", "prod", Filter{}, ChunkConfig{}, `{"cluster": {{ .Cluster }}}`, s.server.URL, "", nil)
+	s.Require().NoError(err)
+	n.client = s.server.Client()
+
+	s.Require().Error(notifyAll(n, testIntents))
+	s.Require().Empty(s.requests)
+}
+
+func (s *NotifierSuite) TestChunksShareCorrelationID() {
+	apiHost := strings.TrimPrefix(s.server.URL, "https://")
+	chunking := ChunkConfig{MaxIntents: 2, GroupByNamespace: true}
+	n, err := NewGitHubDispatchNotifier("github", "prod", Filter{}, chunking, DefaultGitHubTemplate, apiHost, "otterize", "policies", "token", "prod-newIntents")
+	s.Require().NoError(err)
+	n.client = s.server.Client()
+
+	intents := []ExternalIntent{
+		{ClientName: "frontend", ClientNamespace: "production", DNSName: "a.example.com"},
+		{ClientName: "worker", ClientNamespace: "batch", DNSName: "b.example.com"},
+		{ClientName: "frontend", ClientNamespace: "production", DNSName: "c.example.com"},
+		{ClientName: "frontend", ClientNamespace: "production", DNSName: "d.example.com"},
+	}
+	s.Require().NoError(notifyAll(n, intents))
+	s.Require().Len(s.requests, 3)
+
+	payloads := make([]TemplateData, 0, len(s.requests))
+	for _, request := range s.requests {
+		var event struct {
+			ClientPayload TemplateData `json:"client_payload"`
+		}
+		s.Require().NoError(json.Unmarshal(request.body, &event))
+		payloads = append(payloads, event.ClientPayload)
+	}
+	s.Require().NotEmpty(payloads[0].CorrelationID)
+	for i, payload := range payloads {
+		s.Require().Equal(payloads[0].CorrelationID, payload.CorrelationID)
+		s.Require().Equal(i+1, payload.Chunk)
+		s.Require().Equal(3, payload.ChunkCount)
+	}
+	s.Require().Equal("production", payloads[0].Namespace)
+	s.Require().Equal(intents[0:1], payloads[0].Intents[:1])
+	s.Require().Len(payloads[0].Intents, 2)
+	s.Require().Equal("production", payloads[1].Namespace)
+	s.Require().Equal([]ExternalIntent{intents[3]}, payloads[1].Intents)
+	s.Require().Equal("batch", payloads[2].Namespace)
+	s.Require().Equal([]ExternalIntent{intents[1]}, payloads[2].Intents)
+}
+
+func (s *NotifierSuite) TestSlackMessageMentionsPart() {
+	n, err := NewSlackNotifier("slack", "prod", Filter{}, ChunkConfig{MaxIntents: 1}, DefaultSlackTemplate, s.server.URL)
+	s.Require().NoError(err)
+	n.client = s.server.Client()
+
+	s.Require().NoError(notifyAll(n, testIntents))
+	s.Require().Len(s.requests, 2)
+	var message struct {
+		Text string `json:"text"`
+	}
+	s.Require().NoError(json.Unmarshal(s.requests[1].body, &message))
+	s.Require().Equal("New external traffic intents discovered in cluster *prod* (part 2/2):\n"+
+		"• batch/worker (CronJob) → storage.googleapis.com", message.Text)
+}
+
+func TestNotifierSuite(t *testing.T) {
+	suite.Run(t, new(NotifierSuite))
+}
+
+func TestFilterMatches(t *testing.T) {
+	filter := Filter{Namespaces: []string{"production"}, DNSNames: []string{"*.example.com", "example.org"}}
+	for dnsName, expected := range map[string]bool{
+		"api.example.com": true,
+		"example.com":     false,
+		"example.org":     true,
+		"api.example.org": false,
+	} {
+		intent := ExternalIntent{ClientName: "frontend", ClientNamespace: "production", DNSName: dnsName}
+		require.Equal(t, expected, filter.Matches(intent), dnsName)
+	}
+	require.False(t, filter.Matches(ExternalIntent{ClientNamespace: "staging", DNSName: "api.example.com"}))
+}
+
+func TestNotifiersFromConfigFile(t *testing.T) {
+	t.Setenv("SLACK_WEBHOOK_URL", "https://hooks.slack.com/services/T000/B000/XXX")
+	path := filepath.Join(t.TempDir(), "notifiers.yaml")
+	content := `
+notifiers:
+  - name: platform-slack
+    type: slack
+    webhookURL: ${SLACK_WEBHOOK_URL}
+    filter:
+      namespaces: [production]
+  - name: tickets
+    type: webhook
+    url: https://tickets.example.com/hooks/intents
+    secret: s3cr3t
+    chunking:
+      maxIntents: 20
+  - name: policies-repo
+    type: github
+    owner: otterize
+    repo: policies
+`
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+
+	fileConfig, err := LoadConfigFile(path)
+	require.NoError(t, err)
+	require.Len(t, fileConfig.Notifiers, 3)
+
+	slack, err := NewNotifier(fileConfig.Notifiers[0], "prod")
+	require.NoError(t, err)
+	require.Equal(t, "https://hooks.slack.com/services/T000/B000/XXX", slack.(*SlackNotifier).webhookURL)
+	require.Equal(t, []string{"production"}, slack.(*SlackNotifier).filter.Namespaces)
+
+	tickets, err := NewNotifier(fileConfig.Notifiers[1], "prod")
+	require.NoError(t, err)
+	require.Equal(t, ChunkConfig{MaxIntents: 20}, tickets.(*WebhookNotifier).chunking)
+
+	gitHub, err := NewNotifier(fileConfig.Notifiers[2], "prod")
+	require.NoError(t, err)
+	require.Equal(t, ChunkConfig{MaxIntents: DefaultGitHubMaxIntents, MaxBytes: DefaultGitHubMaxBytes}, gitHub.(*GitHubDispatchNotifier).chunking)
+
+	_, err = NewNotifier(NotifierConfig{Name: "pager", Type: "pagerduty"}, "prod")
+	require.Error(t, err)
+}
+
+func TestNotifiersFromViperRejectsDuplicateNames(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "notifiers.yaml")
+	viper.Set(config.NotifiersConfigFileKey, path)
+	defer viper.Set(config.NotifiersConfigFileKey, config.NotifiersConfigFileDefault)
+	viper.Set(config.GhaDispatchEnabledKey, false)
+
+	require.NoError(t, os.WriteFile(path, []byte(`
+notifiers:
+  - name: tickets
+    type: webhook
+    url: https://tickets.example.com/hooks/intents
+  - name: tickets
+    type: slack
+    webhookURL: https://hooks.slack.com/services/T000/B000/XXX
+`), 0o600))
+	_, err := NotifiersFromViper()
+	require.ErrorContains(t, err, "tickets appears more than once")
+
+	require.NoError(t, os.WriteFile(path, []byte(`
+notifiers:
+  - name: github-dispatch
+    type: github
+    owner: otterize
+    repo: policies
+`), 0o600))
+	notifiers, err := NotifiersFromViper()
+	require.NoError(t, err)
+	require.Len(t, notifiers, 1)
+
+	viper.Set(config.GhaDispatchEnabledKey, true)
+	viper.Set(config.GhaOwnerKey, "otterize")
+	viper.Set(config.GhaRepoKey, "network-policies")
+	defer func() {
+		viper.Set(config.GhaDispatchEnabledKey, config.GhaDispatchEnabledDefault)
+		viper.Set(config.GhaOwnerKey, config.GhaOwnerDefault)
+		viper.Set(config.GhaRepoKey, config.GhaRepoDefault)
+	}()
+	_, err = NotifiersFromViper()
+	require.ErrorContains(t, err, "reserved for the GitHub dispatch notifier")
+}
+
+func TestStatusErrorRetry(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+
+	retryAfter := &StatusError{StatusCode: http.StatusServiceUnavailable, Header: http.Header{"Retry-After": []string{"120"}}}
+	require.True(t, retryAfter.Retryable())
+	retryAt, ok := retryAfter.RetryAt(now)
+	require.True(t, ok)
+	require.Equal(t, now.Add(2*time.Minute), retryAt)
+
+	retryAfterDate := &StatusError{StatusCode: http.StatusTooManyRequests, Header: http.Header{"Retry-After": []string{"Wed, 15 Nov 2023 00:00:00 GMT"}}}
+	retryAt, ok = retryAfterDate.RetryAt(now)
+	require.True(t, ok)
+	require.Equal(t, time.Date(2023, 11, 15, 0, 0, 0, 0, time.UTC), retryAt.UTC())
+
+	// GitHub responds 403 when the rate limit is exhausted.
+	rateLimited := &StatusError{StatusCode: http.StatusForbidden, Header: http.Header{
+		"X-Ratelimit-Remaining": []string{"0"},
+		"X-Ratelimit-Reset":     []string{"1700000300"},
+	}}
+	require.True(t, rateLimited.Retryable())
+	retryAt, ok = rateLimited.RetryAt(now)
+	require.True(t, ok)
+	require.Equal(t, time.Unix(1700000300, 0), retryAt)
+
+	forbidden := &StatusError{StatusCode: http.StatusForbidden, Header: http.Header{}}
+	require.False(t, forbidden.Retryable())
+	_, ok = forbidden.RetryAt(now)
+	require.False(t, ok)
+}
+
+func TestChunksRespectMaxBytes(t *testing.T) {
+	const maxBytes = 512
+	n, err := NewWebhookNotifier("webhook", "prod", Filter{}, ChunkConfig{MaxBytes: maxBytes}, DefaultWebhookTemplate, "https://example.com", "", nil)
+	require.NoError(t, err)
+
+	intents := make([]ExternalIntent, 0)
+	for i := 0; i < 20; i++ {
+		intents = append(intents, ExternalIntent{ClientName: "frontend", ClientNamespace: "production", ClientKind: "Deployment", DNSName: fmt.Sprintf("api-%d.example.com", i)})
+	}
+	chunks, err := n.Chunks(EventNewIntent, intents)
+	require.NoError(t, err)
+	require.Greater(t, len(chunks), 1)
+
+	sent := make([]ExternalIntent, 0)
+	for _, chunk := range chunks {
+		payload, err := n.render(chunk)
+		require.NoError(t, err)
+		require.LessOrEqual(t, len(payload), maxBytes)
+		sent = append(sent, chunk.Intents...)
+	}
+	require.Equal(t, intents, sent)
+
+	// An intent too large for a chunk on its own is still sent.
+	huge := ExternalIntent{ClientName: "frontend", DNSName: strings.Repeat("a", maxBytes) + ".example.com"}
+	chunks, err = n.Chunks(EventNewIntent, []ExternalIntent{intents[0], huge, intents[1]})
+	require.NoError(t, err)
+	require.Len(t, chunks, 3)
+	require.Equal(t, []ExternalIntent{huge}, chunks[1].Intents)
+}
@@ -0,0 +1,52 @@
+package notifier
+
+import (
+	"context"
+	"github.com/otterize/intents-operator/src/shared/errors"
+	"net/http"
+)
+
+// DefaultSlackTemplate lists the intents in a message, mentioning the part when they are sent in several chunks.
+const DefaultSlackTemplate = `
+{{- $text := printf "New external traffic intents discovered in cluster *%s*" .Cluster -}}
+{{- if eq .Event "denied_intent_seen" }}{{ $text = printf "Denied external traffic intents seen again in cluster *%s*" .Cluster }}{{ end -}}
+{{- if gt .ChunkCount 1 }}{{ $text = printf "%s (part %d/%d)" $text .Chunk .ChunkCount }}{{ end -}}
+{{- $text = printf "%s:" $text -}}
+{{- range .Intents -}}
+{{- $text = printf "%s\n• %s/%s (%s) → %s" $text .ClientNamespace .ClientName .ClientKind .DNSName -}}
+{{- end -}}
+{"text": {{ json $text }}}`
+
+// SlackNotifier posts intents to a Slack incoming webhook.
+type SlackNotifier struct {
+	base
+	webhookURL string
+	client     *http.Client
+}
+
+func NewSlackNotifier(name string, cluster string, filter Filter, chunking ChunkConfig, templateText string, webhookURL string) (*SlackNotifier, error) {
+	if webhookURL == "" {
+		return nil, errors.Errorf("slack notifier %s: webhookURL is required", name)
+	}
+	b, err := newBase(name, cluster, filter, chunking, templateText)
+	if err != nil {
+		return nil, errors.Wrap(err)
+	}
+	return &SlackNotifier{
+		base:       b,
+		webhookURL: webhookURL,
+		client:     &http.Client{Timeout: httpTimeout},
+	}, nil
+}
+
+func (n *SlackNotifier) Chunks(event Event, intents []ExternalIntent) ([]Chunk, error) {
+	return n.chunk(event, intents, n.render)
+}
+
+func (n *SlackNotifier) Notify(ctx context.Context, chunk Chunk) error {
+	payload, err := n.render(chunk)
+	if err != nil {
+		return errors.Wrap(err)
+	}
+	return errors.Wrap(post(ctx, n.client, n.webhookURL, payload, nil))
+}
@@ -0,0 +1,80 @@
+package notifier
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"github.com/otterize/intents-operator/src/shared/errors"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+const (
+	SignatureHeader = "X-Otterize-Signature"
+	TimestampHeader = "X-Otterize-Timestamp"
+
+	DefaultWebhookTemplate = `{"cluster": {{ json .Cluster }}, "event": {{ json .Event }}, "correlation_id": {{ json .CorrelationID }}, "chunk": {{ .Chunk }}, "chunk_count": {{ .ChunkCount }}, "namespace": {{ json .Namespace }}, "intents": {{ json .Intents }}}`
+)
+
+// WebhookNotifier posts intents to a generic HTTP endpoint. When a secret is configured, requests are signed with
+// HMAC-SHA256 over "<timestamp>.<body>", sent as "sha256=<hex digest>" in the X-Otterize-Signature header along with
+// the unix timestamp in X-Otterize-Timestamp, so receivers can verify the sender and reject replayed requests.
+type WebhookNotifier struct {
+	base
+	url     string
+	secret  string
+	headers map[string]string
+	client  *http.Client
+	now     func() time.Time
+}
+
+func NewWebhookNotifier(name string, cluster string, filter Filter, chunking ChunkConfig, templateText string, url string, secret string, headers map[string]string) (*WebhookNotifier, error) {
+	if url == "" {
+		return nil, errors.Errorf("webhook notifier %s: url is required", name)
+	}
+	b, err := newBase(name, cluster, filter, chunking, templateText)
+	if err != nil {
+		return nil, errors.Wrap(err)
+	}
+	return &WebhookNotifier{
+		base:    b,
+		url:     url,
+		secret:  secret,
+		headers: headers,
+		client:  &http.Client{Timeout: httpTimeout},
+		now:     time.Now,
+	}, nil
+}
+
+// Sign returns the signature of the payload sent at timestamp, as sent in the X-Otterize-Signature header.
+func Sign(secret string, timestamp string, payload []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(payload)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+func (n *WebhookNotifier) Chunks(event Event, intents []ExternalIntent) ([]Chunk, error) {
+	return n.chunk(event, intents, n.render)
+}
+
+func (n *WebhookNotifier) Notify(ctx context.Context, chunk Chunk) error {
+	payload, err := n.render(chunk)
+	if err != nil {
+		return errors.Wrap(err)
+	}
+
+	headers := make(map[string]string, len(n.headers)+2)
+	for key, value := range n.headers {
+		headers[key] = value
+	}
+	if n.secret != "" {
+		timestamp := strconv.FormatInt(n.now().Unix(), 10)
+		headers[TimestampHeader] = timestamp
+		headers[SignatureHeader] = Sign(n.secret, timestamp, payload)
+	}
+	return errors.Wrap(post(ctx, n.client, n.url, payload, headers))
+}
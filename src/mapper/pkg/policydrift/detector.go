@@ -0,0 +1,193 @@
+package policydrift
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	otterizev2alpha1 "github.com/otterize/intents-operator/src/operator/api/v2alpha1"
+	"github.com/otterize/intents-operator/src/shared/errors"
+	"github.com/otterize/network-mapper/src/mapper/pkg/graph/model"
+	"github.com/otterize/network-mapper/src/mapper/pkg/intentsstore"
+	"github.com/samber/lo"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"slices"
+	"time"
+)
+
+var ErrNotEnabled = errors.NewSentinelError("policy drift detection is not enabled, or the ClientIntents CRD is not installed")
+
+// workload identifies a client by name and namespace, the way ClientIntents name it.
+type workload struct {
+	name      string
+	namespace string
+}
+
+// serverKey identifies a server by workload name, or by Kubernetes service name when isService is set.
+type serverKey struct {
+	name      string
+	namespace string
+	isService bool
+}
+
+// undeclaredEdge identifies observed traffic, through a Kubernetes service unless service is empty.
+type undeclaredEdge struct {
+	client  workload
+	server  serverKey
+	service string
+}
+
+// Detector diffs the ClientIntents resources with the in-cluster traffic held by the intents holder.
+type Detector struct {
+	k8sClient     client.Client
+	intentsHolder *intentsstore.IntentsHolder
+}
+
+func NewDetector(k8sClient client.Client, intentsHolder *intentsstore.IntentsHolder) *Detector {
+	return &Detector{
+		k8sClient:     k8sClient,
+		intentsHolder: intentsHolder,
+	}
+}
+
+// Drift returns the drift of the clients in namespaces, or of all clients if it is empty, ordered by namespace,
+// client, type and server.
+func (d *Detector) Drift(ctx context.Context, namespaces []string) ([]model.PolicyDrift, error) {
+	drift, _, err := d.drift(ctx, namespaces)
+	if err != nil {
+		return nil, errors.Wrap(err)
+	}
+	return drift, nil
+}
+
+// drift returns the drift of the clients in namespaces along with their ClientIntents resources.
+func (d *Detector) drift(ctx context.Context, namespaces []string) ([]model.PolicyDrift, []otterizev2alpha1.ClientIntents, error) {
+	var intentsList otterizev2alpha1.ClientIntentsList
+	listOptions := make([]client.ListOption, 0)
+	if len(namespaces) == 1 {
+		listOptions = append(listOptions, client.InNamespace(namespaces[0]))
+	}
+	if err := d.k8sClient.List(ctx, &intentsList, listOptions...); err != nil {
+		return nil, nil, errors.Wrap(err)
+	}
+	clientIntentsList := lo.Filter(intentsList.Items, func(clientIntents otterizev2alpha1.ClientIntents, _ int) bool {
+		return clientIntents.Spec != nil && clientIntents.DeletionTimestamp.IsZero() &&
+			(len(namespaces) == 0 || lo.Contains(namespaces, clientIntents.Namespace))
+	})
+
+	intents, err := d.intentsHolder.GetIntents(namespaces, nil, nil, false, nil)
+	if err != nil {
+		return nil, nil, errors.Wrap(err)
+	}
+
+	declared := make(map[workload]map[serverKey]struct{})
+	for _, clientIntents := range clientIntentsList {
+		client := workload{name: clientIntents.GetWorkloadName(), namespace: clientIntents.Namespace}
+		if _, ok := declared[client]; !ok {
+			declared[client] = make(map[serverKey]struct{})
+		}
+		for _, target := range clientIntents.GetTargetList() {
+			if key, ok := targetServerKey(target, clientIntents.Namespace); ok {
+				declared[client][key] = struct{}{}
+			}
+		}
+	}
+
+	drift := make([]model.PolicyDrift, 0)
+	observed := make(map[workload]map[serverKey]struct{})
+	// The same edge may be held more than once, for different intent types or protocols.
+	undeclaredIndexes := make(map[undeclaredEdge]int)
+	for _, intent := range intents {
+		if intent.Intent.Client == nil || intent.Intent.Server == nil {
+			continue
+		}
+		client := workload{name: intent.Intent.Client.Name, namespace: intent.Intent.Client.Namespace}
+		workloadKey := serverKey{name: intent.Intent.Server.Name, namespace: intent.Intent.Server.Namespace}
+		if _, ok := observed[client]; !ok {
+			observed[client] = make(map[serverKey]struct{})
+		}
+		observed[client][workloadKey] = struct{}{}
+		_, isDeclared := declared[client][workloadKey]
+		service := lo.FromPtr(intent.Intent.Server.KubernetesService)
+		if service != "" {
+			serviceKey := serverKey{name: service, namespace: workloadKey.namespace, isService: true}
+			observed[client][serviceKey] = struct{}{}
+			_, isServiceDeclared := declared[client][serviceKey]
+			isDeclared = isDeclared || isServiceDeclared
+		}
+		if isDeclared {
+			continue
+		}
+		edge := undeclaredEdge{client: client, server: workloadKey, service: service}
+		if i, ok := undeclaredIndexes[edge]; ok {
+			if intent.Timestamp.After(lo.FromPtr(drift[i].LastSeen)) {
+				drift[i].LastSeen = lo.ToPtr(intent.Timestamp)
+			}
+			continue
+		}
+		undeclaredIndexes[edge] = len(drift)
+		drift = append(drift, undeclaredDrift(client, service, intent))
+	}
+
+	for _, clientIntents := range clientIntentsList {
+		client := workload{name: clientIntents.GetWorkloadName(), namespace: clientIntents.Namespace}
+		unobserved := make(map[serverKey]struct{})
+		for _, target := range clientIntents.GetTargetList() {
+			key, ok := targetServerKey(target, clientIntents.Namespace)
+			if !ok {
+				continue
+			}
+			if _, isObserved := observed[client][key]; isObserved {
+				continue
+			}
+			if _, isListed := unobserved[key]; isListed {
+				continue
+			}
+			unobserved[key] = struct{}{}
+			drift = append(drift, model.PolicyDrift{
+				Type:              model.PolicyDriftTypeUnobserved,
+				Namespace:         client.namespace,
+				Client:            client.name,
+				ClientIntentsName: lo.ToPtr(clientIntents.Name),
+				Server:            fmt.Sprintf("%s.%s", key.name, key.namespace),
+			})
+		}
+	}
+
+	slices.SortFunc(drift, func(a, b model.PolicyDrift) int {
+		return cmp.Or(
+			cmp.Compare(a.Namespace, b.Namespace),
+			cmp.Compare(a.Client, b.Client),
+			cmp.Compare(a.Type, b.Type),
+			cmp.Compare(a.Server, b.Server),
+			cmp.Compare(lo.FromPtr(a.ServerKubernetesService), lo.FromPtr(b.ServerKubernetesService)),
+			cmp.Compare(lo.FromPtr(a.ClientIntentsName), lo.FromPtr(b.ClientIntentsName)),
+		)
+	})
+	return drift, clientIntentsList, nil
+}
+
+func targetServerKey(target otterizev2alpha1.Target, clientIntentsNamespace string) (serverKey, bool) {
+	if !target.IsTargetInCluster() {
+		return serverKey{}, false
+	}
+	return serverKey{
+		name:      target.GetTargetServerName(),
+		namespace: target.GetTargetServerNamespace(clientIntentsNamespace),
+		isService: target.IsTargetServerKubernetesService(),
+	}, true
+}
+
+func undeclaredDrift(client workload, service string, intent intentsstore.TimestampedIntent) model.PolicyDrift {
+	var lastSeen *time.Time
+	if !intent.Timestamp.IsZero() {
+		lastSeen = lo.ToPtr(intent.Timestamp)
+	}
+	return model.PolicyDrift{
+		Type:                    model.PolicyDriftTypeUndeclared,
+		Namespace:               client.namespace,
+		Client:                  client.name,
+		Server:                  fmt.Sprintf("%s.%s", intent.Intent.Server.Name, intent.Intent.Server.Namespace),
+		ServerKubernetesService: lo.EmptyableToPtr(service),
+		LastSeen:                lastSeen,
+	}
+}
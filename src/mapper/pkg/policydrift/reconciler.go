@@ -0,0 +1,181 @@
+package policydrift
+
+import (
+	"context"
+	"fmt"
+	otterizev2alpha1 "github.com/otterize/intents-operator/src/operator/api/v2alpha1"
+	"github.com/otterize/intents-operator/src/shared/errors"
+	"github.com/otterize/intents-operator/src/shared/injectablerecorder"
+	"github.com/otterize/network-mapper/src/mapper/pkg/config"
+	"github.com/otterize/network-mapper/src/mapper/pkg/graph/model"
+	"github.com/otterize/network-mapper/src/mapper/pkg/prometheus"
+	"github.com/samber/lo"
+	"github.com/spf13/viper"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	ReasonUndeclaredTraffic   = "UndeclaredTraffic"
+	ReasonUnobservedTargets   = "UnobservedTargets"
+	ReasonPolicyDriftResolved = "PolicyDriftResolved"
+
+	// maxServersInEvent limits the servers listed in an event message, so events stay readable.
+	maxServersInEvent = 10
+)
+
+// Reconciler diffs the ClientIntents of a namespace with the traffic observed from its clients, whenever the
+// namespace's ClientIntents change and periodically since traffic keeps being observed. The drift is exported as
+// Prometheus gauges, and reported as events on the ClientIntents when it changes.
+type Reconciler struct {
+	client.Client
+	injectablerecorder.InjectableRecorder
+	detector *Detector
+	interval time.Duration
+	lock     sync.Mutex
+	// reportedDrift holds the last drift reported as events on each ClientIntents.
+	reportedDrift map[types.NamespacedName]clientIntentsDrift
+}
+
+// clientIntentsDrift is the drift reported on a ClientIntents, as the sorted servers of each drift type.
+type clientIntentsDrift struct {
+	undeclared string
+	unobserved string
+}
+
+func (d clientIntentsDrift) isEmpty() bool {
+	return d.undeclared == "" && d.unobserved == ""
+}
+
+func NewReconciler(client client.Client, detector *Detector) *Reconciler {
+	return &Reconciler{
+		Client:        client,
+		detector:      detector,
+		interval:      viper.GetDuration(config.PolicyDriftIntervalKey),
+		reportedDrift: make(map[types.NamespacedName]clientIntentsDrift),
+	}
+}
+
+func (r *Reconciler) SetupWithManager(mgr ctrl.Manager) error {
+	recorder := mgr.GetEventRecorderFor("network-mapper")
+	r.InjectRecorder(recorder)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named("policy-drift").
+		For(&corev1.Namespace{}).
+		Watches(&otterizev2alpha1.ClientIntents{}, handler.EnqueueRequestsFromMapFunc(r.mapClientIntentsToNamespace)).
+		WithOptions(controller.Options{RecoverPanic: lo.ToPtr(true)}).
+		Complete(r)
+}
+
+func (r *Reconciler) InjectRecorder(recorder record.EventRecorder) {
+	r.Recorder = recorder
+}
+
+func (r *Reconciler) mapClientIntentsToNamespace(_ context.Context, obj client.Object) []reconcile.Request {
+	return []reconcile.Request{{NamespacedName: types.NamespacedName{Name: obj.GetNamespace()}}}
+}
+
+func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	namespace := &corev1.Namespace{}
+	err := r.Get(ctx, req.NamespacedName, namespace)
+	if err != nil && client.IgnoreNotFound(err) == nil {
+		r.forgetNamespace(req.Name)
+		return ctrl.Result{}, nil
+	}
+	if err != nil {
+		return ctrl.Result{}, errors.Wrap(err)
+	}
+
+	if !namespace.DeletionTimestamp.IsZero() || config.ExcludedNamespaces().Contains(namespace.Name) {
+		r.forgetNamespace(namespace.Name)
+		return ctrl.Result{}, nil
+	}
+
+	drift, clientIntentsList, err := r.detector.drift(ctx, []string{namespace.Name})
+	if err != nil {
+		return ctrl.Result{}, errors.Wrap(err)
+	}
+
+	counts := make(map[string]map[string]int)
+	for _, item := range drift {
+		if _, ok := counts[item.Client]; !ok {
+			counts[item.Client] = make(map[string]int)
+		}
+		counts[item.Client][strings.ToLower(item.Type.String())]++
+	}
+	prometheus.SetNamespacePolicyDrift(namespace.Name, counts)
+
+	r.reportEvents(namespace.Name, drift, clientIntentsList)
+	return ctrl.Result{RequeueAfter: r.interval}, nil
+}
+
+// reportEvents records events on the ClientIntents whose drift changed since it was last reported. Undeclared traffic
+// is reported on every ClientIntents of the client.
+func (r *Reconciler) reportEvents(namespace string, drift []model.PolicyDrift, clientIntentsList []otterizev2alpha1.ClientIntents) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	existing := make(map[types.NamespacedName]struct{})
+	for i := range clientIntentsList {
+		clientIntents := &clientIntentsList[i]
+		key := types.NamespacedName{Namespace: clientIntents.Namespace, Name: clientIntents.Name}
+		existing[key] = struct{}{}
+
+		current := clientIntentsDrift{
+			undeclared: formatServers(lo.Filter(drift, func(item model.PolicyDrift, _ int) bool {
+				return item.Type == model.PolicyDriftTypeUndeclared && item.Client == clientIntents.GetWorkloadName()
+			})),
+			unobserved: formatServers(lo.Filter(drift, func(item model.PolicyDrift, _ int) bool {
+				return item.Type == model.PolicyDriftTypeUnobserved && lo.FromPtr(item.ClientIntentsName) == clientIntents.Name
+			})),
+		}
+		previous := r.reportedDrift[key]
+		if current == previous {
+			continue
+		}
+		r.reportedDrift[key] = current
+
+		if current.undeclared != "" && current.undeclared != previous.undeclared {
+			r.RecordWarningEventf(clientIntents, ReasonUndeclaredTraffic,
+				"Traffic to servers not declared in the client's ClientIntents was observed, and will be blocked once enforced: %s", current.undeclared)
+		}
+		if current.unobserved != "" && current.unobserved != previous.unobserved {
+			r.RecordNormalEventf(clientIntents, ReasonUnobservedTargets, "No traffic was observed to declared targets: %s", current.unobserved)
+		}
+		if current.isEmpty() && !previous.isEmpty() {
+			r.RecordNormalEvent(clientIntents, ReasonPolicyDriftResolved, "Declared targets match the observed traffic")
+		}
+	}
+
+	for key := range r.reportedDrift {
+		if _, ok := existing[key]; !ok && key.Namespace == namespace {
+			delete(r.reportedDrift, key)
+		}
+	}
+}
+
+func (r *Reconciler) forgetNamespace(namespace string) {
+	prometheus.SetNamespacePolicyDrift(namespace, nil)
+	r.reportEvents(namespace, nil, nil)
+}
+
+// formatServers lists the servers of drift, which is sorted by server, without duplicates.
+func formatServers(drift []model.PolicyDrift) string {
+	servers := lo.Uniq(lo.Map(drift, func(item model.PolicyDrift, _ int) string {
+		return item.Server
+	}))
+	if len(servers) > maxServersInEvent {
+		return fmt.Sprintf("%s and %d more", strings.Join(servers[:maxServersInEvent], ", "), len(servers)-maxServersInEvent)
+	}
+	return strings.Join(servers, ", ")
+}
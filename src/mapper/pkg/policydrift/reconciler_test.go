@@ -0,0 +1,138 @@
+package policydrift
+
+import (
+	"context"
+	otterizev2alpha1 "github.com/otterize/intents-operator/src/operator/api/v2alpha1"
+	"github.com/otterize/network-mapper/src/mapper/pkg/graph/model"
+	"github.com/otterize/network-mapper/src/mapper/pkg/intentsstore"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/suite"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"strings"
+	"testing"
+	"time"
+)
+
+type PolicyDriftTestSuite struct {
+	suite.Suite
+	intentsHolder *intentsstore.IntentsHolder
+	k8sClient     client.Client
+	recorder      *record.FakeRecorder
+	reconciler    *Reconciler
+}
+
+func (s *PolicyDriftTestSuite) SetupTest() {
+	s.intentsHolder = intentsstore.NewIntentsHolder()
+	scheme := runtime.NewScheme()
+	s.Require().NoError(otterizev2alpha1.AddToScheme(scheme))
+	s.Require().NoError(corev1.AddToScheme(scheme))
+	s.k8sClient = fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "shop"}},
+		newClientIntents("checkout",
+			otterizev2alpha1.Target{Kubernetes: &otterizev2alpha1.KubernetesTarget{Name: "cart"}},
+			otterizev2alpha1.Target{Service: &otterizev2alpha1.ServiceTarget{Name: "payments.billing"}},
+			otterizev2alpha1.Target{Kubernetes: &otterizev2alpha1.KubernetesTarget{Name: "inventory"}},
+			otterizev2alpha1.Target{Internet: &otterizev2alpha1.Internet{Domains: []string{"api.stripe.com"}}},
+		),
+	).Build()
+	s.recorder = record.NewFakeRecorder(10)
+	s.reconciler = NewReconciler(s.k8sClient, NewDetector(s.k8sClient, s.intentsHolder))
+	s.reconciler.InjectRecorder(s.recorder)
+}
+
+func newClientIntents(clientName string, targets ...otterizev2alpha1.Target) *otterizev2alpha1.ClientIntents {
+	return &otterizev2alpha1.ClientIntents{
+		ObjectMeta: metav1.ObjectMeta{Name: clientName, Namespace: "shop"},
+		Spec: &otterizev2alpha1.IntentsSpec{
+			Workload: otterizev2alpha1.Workload{Name: clientName, Kind: "Deployment"},
+			Targets:  targets,
+		},
+	}
+}
+
+func (s *PolicyDriftTestSuite) addIntent(clientName string, server model.OtterizeServiceIdentity) {
+	s.intentsHolder.AddIntent(time.Now(), model.Intent{
+		Client: &model.OtterizeServiceIdentity{Name: clientName, Namespace: "shop"},
+		Server: &server,
+	}, nil)
+}
+
+func (s *PolicyDriftTestSuite) reconcile() {
+	result, err := s.reconciler.Reconcile(context.Background(), ctrl.Request{NamespacedName: types.NamespacedName{Name: "shop"}})
+	s.Require().NoError(err)
+	s.Require().NotZero(result.RequeueAfter)
+}
+
+func (s *PolicyDriftTestSuite) events() []string {
+	events := make([]string, 0)
+	for {
+		select {
+		case event := <-s.recorder.Events:
+			events = append(events, event)
+		default:
+			return events
+		}
+	}
+}
+
+func (s *PolicyDriftTestSuite) TestDrift() {
+	s.addIntent("checkout", model.OtterizeServiceIdentity{Name: "cart", Namespace: "shop"})
+	s.addIntent("checkout", model.OtterizeServiceIdentity{Name: "payments-7d9f", Namespace: "billing", KubernetesService: lo.ToPtr("payments")})
+	s.addIntent("checkout", model.OtterizeServiceIdentity{Name: "redis", Namespace: "shop", KubernetesService: lo.ToPtr("redis")})
+	s.addIntent("search", model.OtterizeServiceIdentity{Name: "elastic", Namespace: "shop"})
+	s.addIntent("other", model.OtterizeServiceIdentity{Name: "elastic", Namespace: "shop"})
+
+	drift, err := s.reconciler.detector.Drift(context.Background(), []string{"shop"})
+	s.Require().NoError(err)
+	s.Require().Equal([]model.PolicyDrift{
+		{Type: model.PolicyDriftTypeUndeclared, Namespace: "shop", Client: "checkout", Server: "redis.shop", ServerKubernetesService: lo.ToPtr("redis"), LastSeen: drift[0].LastSeen},
+		{Type: model.PolicyDriftTypeUnobserved, Namespace: "shop", Client: "checkout", ClientIntentsName: lo.ToPtr("checkout"), Server: "inventory.shop"},
+		{Type: model.PolicyDriftTypeUndeclared, Namespace: "shop", Client: "other", Server: "elastic.shop", LastSeen: drift[2].LastSeen},
+		{Type: model.PolicyDriftTypeUndeclared, Namespace: "shop", Client: "search", Server: "elastic.shop", LastSeen: drift[3].LastSeen},
+	}, drift)
+	s.Require().NotNil(drift[0].LastSeen)
+
+	drift, err = s.reconciler.detector.Drift(context.Background(), []string{"billing"})
+	s.Require().NoError(err)
+	s.Require().Empty(drift)
+}
+
+func (s *PolicyDriftTestSuite) TestEventsAreRecordedWhenDriftChanges() {
+	s.addIntent("checkout", model.OtterizeServiceIdentity{Name: "cart", Namespace: "shop"})
+	s.addIntent("checkout", model.OtterizeServiceIdentity{Name: "payments-7d9f", Namespace: "billing", KubernetesService: lo.ToPtr("payments")})
+	s.addIntent("checkout", model.OtterizeServiceIdentity{Name: "redis", Namespace: "shop"})
+
+	s.reconcile()
+	events := s.events()
+	s.Require().Len(events, 2)
+	s.Require().True(strings.HasPrefix(events[0], "Warning "+ReasonUndeclaredTraffic))
+	s.Require().Contains(events[0], "redis.shop")
+	s.Require().True(strings.HasPrefix(events[1], "Normal "+ReasonUnobservedTargets))
+	s.Require().Contains(events[1], "inventory.shop")
+
+	// Unchanged drift is not reported again
+	s.reconcile()
+	s.Require().Empty(s.events())
+
+	s.addIntent("checkout", model.OtterizeServiceIdentity{Name: "inventory", Namespace: "shop"})
+	clientIntents := &otterizev2alpha1.ClientIntents{}
+	s.Require().NoError(s.k8sClient.Get(context.Background(), types.NamespacedName{Namespace: "shop", Name: "checkout"}, clientIntents))
+	clientIntents.Spec.Targets = append(clientIntents.Spec.Targets, otterizev2alpha1.Target{Kubernetes: &otterizev2alpha1.KubernetesTarget{Name: "redis"}})
+	s.Require().NoError(s.k8sClient.Update(context.Background(), clientIntents))
+
+	s.reconcile()
+	events = s.events()
+	s.Require().Len(events, 1)
+	s.Require().True(strings.HasPrefix(events[0], "Normal "+ReasonPolicyDriftResolved))
+}
+
+func TestPolicyDriftTestSuite(t *testing.T) {
+	suite.Run(t, new(PolicyDriftTestSuite))
+}
@@ -3,6 +3,7 @@ package prometheus
 import (
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
+	"time"
 )
 
 var (
@@ -18,6 +19,10 @@ var (
 		Name: "tcp_reported_connections",
 		Help: "The total number of TCP-sourced reported connections",
 	})
+	udpCaptureReports = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "udp_reported_connections",
+		Help: "The total number of UDP-sourced reported connections",
+	})
 	kafkaReports = promauto.NewCounter(prometheus.CounterOpts{
 		Name: "kafka_reported_topics",
 		Help: "The total number of Kafka-sourced topics",
@@ -39,6 +44,14 @@ var (
 		Name: "tcp_dropped_connections",
 		Help: "The total number of TCP-sourced reported connections that were dropped for performance",
 	})
+	udpCaptureDrops = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "udp_dropped_connections",
+		Help: "The total number of UDP-sourced reported connections that were dropped for performance",
+	})
+	udpExternalFlows = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "udp_external_unrecorded_flows",
+		Help: "The total number of reported UDP flows to IPs outside the cluster, which are not recorded as external traffic",
+	})
 	kafkaReportsDrops = promauto.NewCounter(prometheus.CounterOpts{
 		Name: "kafka_dropped_topics",
 		Help: "The total number of Kafka-sourced reported topics that were dropped for performance",
@@ -74,8 +87,33 @@ var (
 		Name: "azure_dropped_reports",
 		Help: "The total number of Azure operations reported that were dropped for performance",
 	})
+
+	staleIntentTargets = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "stale_intent_targets",
+		Help: "The number of targets declared in ClientIntents that the client was not seen accessing recently",
+	}, []string{"namespace", "client", "target_type"})
+	staleIntentTargetLastSeen = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "stale_intent_target_last_seen_timestamp_seconds",
+		Help: "When the client was last seen accessing a stale target declared in ClientIntents, 0 if it was not seen",
+	}, []string{"namespace", "client_intents", "client", "target_type", "target", "kafka_topic"})
+	policyDriftEdges = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "policy_drift_edges",
+		Help: "The number of edges that differ between ClientIntents and the observed traffic, by drift type",
+	}, []string{"namespace", "client", "type"})
 )
 
+// StaleIntentTarget is a target declared in ClientIntents that the client was not seen accessing recently.
+type StaleIntentTarget struct {
+	Namespace         string
+	ClientIntentsName string
+	Client            string
+	TargetType        string
+	Target            string
+	KafkaTopic        string
+	// LastSeen is zero if the client was not seen accessing the target.
+	LastSeen time.Time
+}
+
 func IncrementTCPCaptureReports(count int) {
 	tcpCaptureReports.Add(float64(count))
 }
@@ -84,6 +122,18 @@ func IncrementTCPCaptureDrops(count int) {
 	tcpCaptureDrops.Add(float64(count))
 }
 
+func IncrementUDPCaptureReports(count int) {
+	udpCaptureReports.Add(float64(count))
+}
+
+func IncrementUDPCaptureDrops(count int) {
+	udpCaptureDrops.Add(float64(count))
+}
+
+func IncrementUDPExternalFlows(count int) {
+	udpExternalFlows.Add(float64(count))
+}
+
 func IncrementSocketScanReports(count int) {
 	socketScanReports.Add(float64(count))
 }
@@ -139,3 +189,30 @@ func IncrementAzureOperationReports(count int) {
 func IncrementAzureOperationDrops(count int) {
 	azureReportsDrops.Add(float64(count))
 }
+
+// SetStaleIntentTargets replaces the stale intent gauges with targets, so targets that are no longer stale are dropped.
+func SetStaleIntentTargets(targets []StaleIntentTarget) {
+	staleIntentTargets.Reset()
+	staleIntentTargetLastSeen.Reset()
+	for _, target := range targets {
+		staleIntentTargets.WithLabelValues(target.Namespace, target.Client, target.TargetType).Inc()
+		lastSeen := 0.0
+		if !target.LastSeen.IsZero() {
+			lastSeen = float64(target.LastSeen.Unix())
+		}
+		staleIntentTargetLastSeen.WithLabelValues(
+			target.Namespace, target.ClientIntentsName, target.Client, target.TargetType, target.Target, target.KafkaTopic,
+		).Set(lastSeen)
+	}
+}
+
+// SetNamespacePolicyDrift replaces the policy drift gauges of a namespace with the edge counts per client and drift
+// type. An empty counts clears the namespace.
+func SetNamespacePolicyDrift(namespace string, counts map[string]map[string]int) {
+	policyDriftEdges.DeletePartialMatch(prometheus.Labels{"namespace": namespace})
+	for client, countsByType := range counts {
+		for driftType, count := range countsByType {
+			policyDriftEdges.WithLabelValues(namespace, client, driftType).Set(float64(count))
+		}
+	}
+}
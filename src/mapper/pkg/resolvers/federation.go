@@ -0,0 +1,72 @@
+package resolvers
+
+import (
+	"context"
+	"github.com/otterize/intents-operator/src/shared/errors"
+	"github.com/otterize/network-mapper/src/mapper/pkg/config"
+	"github.com/otterize/network-mapper/src/mapper/pkg/federation"
+	"github.com/otterize/network-mapper/src/mapper/pkg/graph/model"
+	"github.com/otterize/network-mapper/src/mapper/pkg/sqlstore"
+	"github.com/samber/lo"
+	"github.com/spf13/viper"
+)
+
+// LocalClusterSnapshot returns what this mapper knows about its own cluster, as served to federation aggregators.
+func (r *Resolver) LocalClusterSnapshot(ctx context.Context) (federation.ClusterSnapshot, error) {
+	query := &queryResolver{r}
+	intents, err := query.Intents(ctx, nil, nil, nil, nil, nil)
+	if err != nil {
+		return federation.ClusterSnapshot{}, errors.Wrap(err)
+	}
+	externalIntents, err := r.allExternalIntents(ctx)
+	if err != nil {
+		return federation.ClusterSnapshot{}, errors.Wrap(err)
+	}
+	workloadAddresses, err := r.kubeFinder.ListWorkloadAddresses(ctx)
+	if err != nil {
+		return federation.ClusterSnapshot{}, errors.Wrap(err)
+	}
+	return federation.ClusterSnapshot{
+		Cluster:           viper.GetString(config.ClusterKey),
+		Intents:           intents,
+		ExternalIntents:   externalIntents,
+		WorkloadAddresses: workloadAddresses,
+	}, nil
+}
+
+func (r *Resolver) allExternalIntents(ctx context.Context) ([]model.ExternalIntent, error) {
+	externalIntents := make([]model.ExternalIntent, 0)
+	if r.dbClient == nil {
+		return externalIntents, nil
+	}
+	cursor := ""
+	for {
+		page, err := r.dbClient.GetExternalIntents(ctx, sqlstore.ExternalIntentsFilter{}, maxExternalIntentsPageSize, cursor)
+		if err != nil {
+			return nil, errors.Wrap(err)
+		}
+		for _, record := range page.Intents {
+			externalIntents = append(externalIntents, externalIntentRecordToModel(record))
+		}
+		if page.NextCursor == "" {
+			return externalIntents, nil
+		}
+		cursor = page.NextCursor
+	}
+}
+
+// federatedSnapshots returns the snapshots of this cluster and the federated clusters, limited to clusters if it is
+// not empty.
+func (r *Resolver) federatedSnapshots(ctx context.Context, clusters []string) ([]federation.ClusterSnapshot, error) {
+	localSnapshot, err := r.LocalClusterSnapshot(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err)
+	}
+	snapshots := append([]federation.ClusterSnapshot{localSnapshot}, r.federation.Snapshots()...)
+	if len(clusters) == 0 {
+		return snapshots, nil
+	}
+	return lo.Filter(snapshots, func(snapshot federation.ClusterSnapshot, _ int) bool {
+		return lo.Contains(clusters, snapshot.Cluster)
+	}), nil
+}
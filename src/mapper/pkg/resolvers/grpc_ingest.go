@@ -0,0 +1,236 @@
+package resolvers
+
+import (
+	"context"
+	"github.com/otterize/intents-operator/src/shared/errors"
+	"github.com/otterize/network-mapper/src/mapper/pkg/config"
+	"github.com/otterize/network-mapper/src/mapper/pkg/graph/model"
+	"github.com/otterize/network-mapper/src/mappergrpc/ingestpb"
+	"github.com/samber/lo"
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/viper"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// retryAfterErrorDomain is the domain of the google.rpc.ErrorInfo detail of retry-after errors.
+const retryAfterErrorDomain = "network-mapper.otterize.com"
+
+// ingestServer serves the gRPC ingest API. Reports are handed to the matching mutation resolvers, so they are queued,
+// counted and rejected when the queue is full exactly as reports sent over GraphQL are.
+type ingestServer struct {
+	ingestpb.UnimplementedIngestServer
+	mutations *mutationResolver
+}
+
+func (r *Resolver) RegisterGRPC(server *grpc.Server) {
+	ingestpb.RegisterIngestServer(server, &ingestServer{mutations: &mutationResolver{r}})
+}
+
+func (s *ingestServer) ReportCaptureResults(stream grpc.ClientStreamingServer[ingestpb.CaptureResults, ingestpb.ReportResponse]) error {
+	return handleReportStream(stream, (*ingestpb.CaptureResults).GetResults, func(ctx context.Context, results []*ingestpb.RecordedDestinationsForSrc) (bool, error) {
+		return s.mutations.ReportCaptureResults(ctx, model.CaptureResults{Results: recordedDestinationsFromProto(results)})
+	})
+}
+
+func (s *ingestServer) ReportTCPCaptureResults(stream grpc.ClientStreamingServer[ingestpb.CaptureResults, ingestpb.ReportResponse]) error {
+	return handleReportStream(stream, (*ingestpb.CaptureResults).GetResults, func(ctx context.Context, results []*ingestpb.RecordedDestinationsForSrc) (bool, error) {
+		return s.mutations.ReportTCPCaptureResults(ctx, model.CaptureTCPResults{Results: recordedDestinationsFromProto(results)})
+	})
+}
+
+func (s *ingestServer) ReportUDPCaptureResults(stream grpc.ClientStreamingServer[ingestpb.CaptureResults, ingestpb.ReportResponse]) error {
+	return handleReportStream(stream, (*ingestpb.CaptureResults).GetResults, func(ctx context.Context, results []*ingestpb.RecordedDestinationsForSrc) (bool, error) {
+		return s.mutations.ReportUDPCaptureResults(ctx, model.CaptureUDPResults{Results: recordedDestinationsFromProto(results)})
+	})
+}
+
+func (s *ingestServer) ReportSocketScanResults(stream grpc.ClientStreamingServer[ingestpb.CaptureResults, ingestpb.ReportResponse]) error {
+	return handleReportStream(stream, (*ingestpb.CaptureResults).GetResults, func(ctx context.Context, results []*ingestpb.RecordedDestinationsForSrc) (bool, error) {
+		return s.mutations.ReportSocketScanResults(ctx, model.SocketScanResults{Results: recordedDestinationsFromProto(results)})
+	})
+}
+
+func (s *ingestServer) ReportKafkaMapperResults(stream grpc.ClientStreamingServer[ingestpb.KafkaMapperResults, ingestpb.ReportResponse]) error {
+	return handleReportStream(stream, (*ingestpb.KafkaMapperResults).GetResults, func(ctx context.Context, results []*ingestpb.KafkaMapperResult) (bool, error) {
+		return s.mutations.ReportKafkaMapperResults(ctx, model.KafkaMapperResults{Results: lo.Map(results, func(result *ingestpb.KafkaMapperResult, _ int) model.KafkaMapperResult {
+			return model.KafkaMapperResult{
+				SrcIP:           result.SrcIp,
+				ServerPodName:   result.ServerPodName,
+				ServerNamespace: result.ServerNamespace,
+				Topic:           result.Topic,
+				Operation:       result.Operation,
+				LastSeen:        result.LastSeen.AsTime(),
+			}
+		})})
+	})
+}
+
+func (s *ingestServer) ReportIstioConnectionResults(stream grpc.ClientStreamingServer[ingestpb.IstioConnectionResults, ingestpb.ReportResponse]) error {
+	return handleReportStream(stream, (*ingestpb.IstioConnectionResults).GetResults, func(ctx context.Context, results []*ingestpb.IstioConnection) (bool, error) {
+		return s.mutations.ReportIstioConnectionResults(ctx, model.IstioConnectionResults{Results: lo.Map(results, func(connection *ingestpb.IstioConnection, _ int) model.IstioConnection {
+			return model.IstioConnection{
+				SrcWorkload:          connection.SrcWorkload,
+				SrcWorkloadNamespace: connection.SrcWorkloadNamespace,
+				DstWorkload:          connection.DstWorkload,
+				DstServiceName:       connection.DstServiceName,
+				DstWorkloadNamespace: connection.DstWorkloadNamespace,
+				Path:                 connection.Path,
+				Methods: lo.Map(connection.Methods, func(method ingestpb.HttpMethod, _ int) model.HTTPMethod {
+					return model.HTTPMethod(strings.TrimPrefix(method.String(), "HTTP_METHOD_"))
+				}),
+				LastSeen: connection.LastSeen.AsTime(),
+			}
+		})})
+	})
+}
+
+func (s *ingestServer) ReportAWSOperation(stream grpc.ClientStreamingServer[ingestpb.AWSOperations, ingestpb.ReportResponse]) error {
+	return handleReportStream(stream, (*ingestpb.AWSOperations).GetOperations, func(ctx context.Context, operations []*ingestpb.AWSOperation) (bool, error) {
+		return s.mutations.ReportAWSOperation(ctx, lo.Map(operations, func(operation *ingestpb.AWSOperation, _ int) model.AWSOperation {
+			return model.AWSOperation{
+				Resource: operation.Resource,
+				Actions:  operation.Actions,
+				SrcIP:    operation.SrcIp,
+				IamRole:  operation.IamRole,
+				Client:   namespacedNameFromProto(operation.Client),
+			}
+		}))
+	})
+}
+
+func (s *ingestServer) ReportAzureOperation(stream grpc.ClientStreamingServer[ingestpb.AzureOperations, ingestpb.ReportResponse]) error {
+	return handleReportStream(stream, (*ingestpb.AzureOperations).GetOperations, func(ctx context.Context, operations []*ingestpb.AzureOperation) (bool, error) {
+		return s.mutations.ReportAzureOperation(ctx, lo.Map(operations, func(operation *ingestpb.AzureOperation, _ int) model.AzureOperation {
+			return model.AzureOperation{
+				Scope:           operation.Scope,
+				Actions:         operation.Actions,
+				DataActions:     operation.DataActions,
+				ClientName:      operation.ClientName,
+				ClientNamespace: operation.ClientNamespace,
+			}
+		}))
+	})
+}
+
+func (s *ingestServer) ReportGCPOperation(stream grpc.ClientStreamingServer[ingestpb.GCPOperations, ingestpb.ReportResponse]) error {
+	return handleReportStream(stream, (*ingestpb.GCPOperations).GetOperations, func(ctx context.Context, operations []*ingestpb.GCPOperation) (bool, error) {
+		return s.mutations.ReportGCPOperation(ctx, lo.Map(operations, func(operation *ingestpb.GCPOperation, _ int) model.GCPOperation {
+			return model.GCPOperation{
+				Resource:    operation.Resource,
+				Permissions: operation.Permissions,
+				SrcIP:       operation.SrcIp,
+				Client:      namespacedNameFromProto(operation.Client),
+			}
+		}))
+	})
+}
+
+func (s *ingestServer) ReportTrafficLevelResults(stream grpc.ClientStreamingServer[ingestpb.TrafficLevelResults, ingestpb.ReportResponse]) error {
+	return handleReportStream(stream, (*ingestpb.TrafficLevelResults).GetResults, func(ctx context.Context, results []*ingestpb.TrafficLevelResult) (bool, error) {
+		return s.mutations.ReportTrafficLevelResults(ctx, model.TrafficLevelResults{Results: lo.Map(results, func(result *ingestpb.TrafficLevelResult, _ int) model.TrafficLevelResult {
+			return model.TrafficLevelResult{
+				SrcIP:     result.SrcIp,
+				DstIP:     result.DstIp,
+				BytesSent: result.BytesSent,
+				Flows:     result.Flows,
+			}
+		})})
+	})
+}
+
+// handleReportStream receives all the messages of a report stream, and reports their items once the client closes the
+// stream, so a report is accepted or rejected as a whole however it was split. Reports of more than the configured
+// maximum number of items are rejected with RESOURCE_EXHAUSTED as soon as they exceed it, so a stream that is never
+// closed can't hold an unbounded number of items in memory.
+func handleReportStream[Msg any, Item any](
+	stream grpc.ClientStreamingServer[Msg, ingestpb.ReportResponse],
+	items func(*Msg) []Item,
+	report func(ctx context.Context, items []Item) (bool, error),
+) error {
+	maxItems := viper.GetInt(config.GRPCIngestMaxReportItemsKey)
+	received := make([]Item, 0)
+	for {
+		msg, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		received = append(received, items(msg)...)
+		if len(received) > maxItems {
+			return status.Errorf(codes.ResourceExhausted, "report exceeds the maximum of %d items", maxItems)
+		}
+	}
+
+	if _, err := report(stream.Context(), received); err != nil {
+		return toGRPCError(err)
+	}
+	return stream.SendAndClose(&ingestpb.ReportResponse{})
+}
+
+// toGRPCError converts the errors of the mutation resolvers to gRPC status errors. Retry-after errors become
+// RESOURCE_EXHAUSTED, with their extensions as error details.
+func toGRPCError(err error) error {
+	var gqlErr *gqlerror.Error
+	if errors.As(err, &gqlErr) && gqlErr.Extensions["code"] == RetryAfterErrorCode {
+		retryAfterMs, _ := gqlErr.Extensions["retryAfterMs"].(int64)
+		queueDepth, _ := gqlErr.Extensions["queueDepth"].(int)
+		queueCapacity, _ := gqlErr.Extensions["queueCapacity"].(int)
+		st, detailsErr := status.New(codes.ResourceExhausted, gqlErr.Message).WithDetails(
+			&errdetails.RetryInfo{RetryDelay: durationpb.New(time.Duration(retryAfterMs) * time.Millisecond)},
+			&errdetails.ErrorInfo{
+				Reason: RetryAfterErrorCode,
+				Domain: retryAfterErrorDomain,
+				Metadata: map[string]string{
+					"queueDepth":    strconv.Itoa(queueDepth),
+					"queueCapacity": strconv.Itoa(queueCapacity),
+				},
+			},
+		)
+		if detailsErr != nil {
+			logrus.WithError(detailsErr).Error("Failed to add details to retry-after error")
+			return status.Error(codes.ResourceExhausted, gqlErr.Message)
+		}
+		return st.Err()
+	}
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return status.FromContextError(err).Err()
+	}
+	return status.Error(codes.Internal, err.Error())
+}
+
+func recordedDestinationsFromProto(results []*ingestpb.RecordedDestinationsForSrc) []model.RecordedDestinationsForSrc {
+	return lo.Map(results, func(result *ingestpb.RecordedDestinationsForSrc, _ int) model.RecordedDestinationsForSrc {
+		return model.RecordedDestinationsForSrc{
+			SrcIP:       result.SrcIp,
+			SrcHostname: result.SrcHostname,
+			Destinations: lo.Map(result.Destinations, func(destination *ingestpb.Destination, _ int) model.Destination {
+				return model.Destination{
+					Destination:     destination.Destination,
+					DestinationIP:   destination.DestinationIp,
+					DestinationPort: destination.DestinationPort,
+					TTL:             destination.Ttl,
+					LastSeen:        destination.LastSeen.AsTime(),
+					SrcPorts:        destination.SrcPorts,
+				}
+			}),
+		}
+	})
+}
+
+func namespacedNameFromProto(name *ingestpb.NamespacedName) *model.NamespacedName {
+	if name == nil {
+		return nil
+	}
+	return &model.NamespacedName{Name: name.Name, Namespace: name.Namespace}
+}
@@ -0,0 +1,132 @@
+package resolvers
+
+import (
+	"context"
+	"fmt"
+	"github.com/otterize/intents-operator/src/shared/errors"
+	"github.com/otterize/network-mapper/src/mapper/pkg/config"
+	"github.com/otterize/network-mapper/src/mapper/pkg/graph/model"
+	"github.com/otterize/network-mapper/src/mapperclient"
+	"github.com/otterize/nilable"
+	"github.com/samber/lo"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/suite"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"net"
+	"testing"
+	"time"
+)
+
+type GRPCIngestTestSuite struct {
+	suite.Suite
+	resolver *Resolver
+	client   *mapperclient.Client
+}
+
+func (s *GRPCIngestTestSuite) SetupTest() {
+	viper.Set(config.ReportQueueRetryAfterKey, time.Millisecond)
+	s.resolver = &Resolver{
+		dnsCaptureResults: make(chan model.CaptureResults, 1),
+		awsOperations:     make(chan model.AWSOperationResults, 1),
+	}
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	s.Require().NoError(err)
+	server := grpc.NewServer()
+	s.resolver.RegisterGRPC(server)
+	go func() {
+		_ = server.Serve(listener)
+	}()
+	s.T().Cleanup(server.Stop)
+
+	s.client = mapperclient.New("http://127.0.0.1:1", mapperclient.WithGRPCIngest(listener.Addr().String()))
+}
+
+func (s *GRPCIngestTestSuite) TearDownTest() {
+	viper.Set(config.ReportQueueRetryAfterKey, config.ReportQueueRetryAfterDefault)
+	viper.Set(config.GRPCIngestMaxReportItemsKey, config.GRPCIngestMaxReportItemsDefault)
+}
+
+func (s *GRPCIngestTestSuite) TestReportIsReceivedAsWhole() {
+	lastSeen := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	// Large enough to be split across several messages
+	results := lo.Times(250, func(i int) mapperclient.RecordedDestinationsForSrc {
+		return mapperclient.RecordedDestinationsForSrc{
+			SrcIp:       fmt.Sprintf("10.0.%d.%d", i/256, i%256),
+			SrcHostname: "client",
+			Destinations: []mapperclient.Destination{
+				{
+					Destination:     "server.ns.svc.cluster.local",
+					DestinationIP:   nilable.From("10.1.0.1"),
+					DestinationPort: nilable.From(8080),
+					LastSeen:        lastSeen,
+					SrcPorts:        []int{40000},
+				},
+			},
+		}
+	})
+	s.Require().NoError(s.client.ReportCaptureResults(context.Background(), mapperclient.CaptureResults{Results: results}))
+
+	received := <-s.resolver.dnsCaptureResults
+	s.Require().Len(received.Results, 250)
+	s.Require().Equal(model.RecordedDestinationsForSrc{
+		SrcIP:       "10.0.0.0",
+		SrcHostname: "client",
+		Destinations: []model.Destination{
+			{
+				Destination:     "server.ns.svc.cluster.local",
+				DestinationIP:   lo.ToPtr("10.1.0.1"),
+				DestinationPort: lo.ToPtr(int64(8080)),
+				LastSeen:        lastSeen,
+				SrcPorts:        []int64{40000},
+			},
+		},
+	}, received.Results[0])
+}
+
+func (s *GRPCIngestTestSuite) TestOptionalFields() {
+	operation := mapperclient.AWSOperation{
+		Resource: "arn:aws:s3:::bucket",
+		Actions:  []string{"s3:GetObject"},
+		Client:   nilable.From(mapperclient.NamespacedName{Name: "client", Namespace: "ns"}),
+	}
+	s.Require().NoError(s.client.ReportAWSOperation(context.Background(), []mapperclient.AWSOperation{operation}))
+
+	s.Require().Equal(model.AWSOperationResults{
+		{
+			Resource: "arn:aws:s3:::bucket",
+			Actions:  []string{"s3:GetObject"},
+			Client:   &model.NamespacedName{Name: "client", Namespace: "ns"},
+		},
+	}, <-s.resolver.awsOperations)
+}
+
+func (s *GRPCIngestTestSuite) TestFullQueueReturnsRetryAfterError() {
+	s.resolver.dnsCaptureResults <- model.CaptureResults{}
+	err := s.client.ReportCaptureResults(context.Background(), mapperclient.CaptureResults{})
+
+	var retryAfterErr *mapperclient.RetryAfterError
+	s.Require().True(errors.As(err, &retryAfterErr))
+	s.Require().Equal(mapperclient.RetryAfterError{RetryAfter: time.Millisecond, QueueDepth: 1, QueueCapacity: 1}, *retryAfterErr)
+}
+
+func (s *GRPCIngestTestSuite) TestReportOverMaxItemsIsRejected() {
+	viper.Set(config.GRPCIngestMaxReportItemsKey, 150)
+	results := lo.Times(250, func(i int) mapperclient.RecordedDestinationsForSrc {
+		return mapperclient.RecordedDestinationsForSrc{SrcIp: fmt.Sprintf("10.0.%d.%d", i/256, i%256), SrcHostname: "client"}
+	})
+	err := s.client.ReportCaptureResults(context.Background(), mapperclient.CaptureResults{Results: results})
+	var grpcErr interface{ GRPCStatus() *status.Status }
+	s.Require().True(errors.As(err, &grpcErr))
+	s.Require().Equal(codes.ResourceExhausted, grpcErr.GRPCStatus().Code())
+
+	var retryAfterErr *mapperclient.RetryAfterError
+	s.Require().False(errors.As(err, &retryAfterErr))
+	s.Require().Empty(s.resolver.dnsCaptureResults)
+}
+
+func TestGRPCIngestTestSuite(t *testing.T) {
+	suite.Run(t, new(GRPCIngestTestSuite))
+}
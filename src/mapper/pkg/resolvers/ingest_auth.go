@@ -0,0 +1,47 @@
+package resolvers
+
+import (
+	"context"
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/otterize/network-mapper/src/mapper/pkg/graph/generated"
+	"github.com/otterize/network-mapper/src/mapper/pkg/ingestauth"
+	"github.com/samber/lo"
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+	"strings"
+)
+
+// UnauthenticatedErrorCode is the extensions code of the error mutations return when the caller did not authenticate
+// with any of the schemes the ingest auth policy accepts for them.
+const UnauthenticatedErrorCode = "UNAUTHENTICATED"
+
+// MutationNames returns the names of the mutations in the schema, which the ingest auth policy may configure.
+func MutationNames() []string {
+	mutation := generated.NewExecutableSchema(generated.Config{}).Schema().Mutation
+	names := lo.Map(mutation.Fields, func(field *ast.FieldDefinition, _ int) string {
+		return field.Name
+	})
+	return lo.Reject(names, func(name string, _ int) bool {
+		return strings.HasPrefix(name, "__")
+	})
+}
+
+// authorizeMutations rejects mutations whose caller the policy does not allow. The caller's credentials are taken
+// from the request by Register. Queries are not authorized.
+func authorizeMutations(policy *ingestauth.Policy) graphql.RootFieldMiddleware {
+	return func(ctx context.Context, next graphql.RootResolver) graphql.Marshaler {
+		fieldCtx := graphql.GetRootFieldContext(ctx)
+		if fieldCtx == nil || graphql.GetOperationContext(ctx).Operation.Operation != ast.Mutation {
+			return next(ctx)
+		}
+		if err := policy.Authorize(ctx, fieldCtx.Field.Name, ingestauth.CredentialsFromContext(ctx)); err != nil {
+			graphql.AddError(ctx, &gqlerror.Error{
+				Message:    err.Error(),
+				Path:       ast.Path{ast.PathName(fieldCtx.Field.Alias)},
+				Extensions: map[string]interface{}{"code": UnauthenticatedErrorCode},
+			})
+			return graphql.Null
+		}
+		return next(ctx)
+	}
+}
@@ -0,0 +1,118 @@
+package resolvers
+
+import (
+	"context"
+	"github.com/labstack/echo/v4"
+	"github.com/otterize/intents-operator/src/shared/errors"
+	"github.com/otterize/network-mapper/src/mapper/pkg/graph/model"
+	"github.com/otterize/network-mapper/src/mapper/pkg/ingestauth"
+	"github.com/otterize/network-mapper/src/mapperclient"
+	"github.com/stretchr/testify/suite"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"net"
+	"net/http/httptest"
+	"testing"
+)
+
+const testIngestToken = "ingest-token"
+
+type IngestAuthTestSuite struct {
+	suite.Suite
+	resolver    *Resolver
+	policy      *ingestauth.Policy
+	graphqlURL  string
+	grpcAddress string
+}
+
+func (s *IngestAuthTestSuite) SetupTest() {
+	s.resolver = &Resolver{
+		dnsCaptureResults: make(chan model.CaptureResults, 1),
+		tcpCaptureResults: make(chan model.CaptureTCPResults, 1),
+	}
+	var err error
+	s.policy, err = ingestauth.NewPolicy(ingestauth.FileConfig{
+		DefaultSchemes: []string{ingestauth.SchemeBearer},
+		Mutations:      map[string][]string{"reportTCPCaptureResults": {ingestauth.SchemeNone}},
+		Bearer:         &ingestauth.BearerConfig{Tokens: []string{testIngestToken}},
+	}, MutationNames(), nil)
+	s.Require().NoError(err)
+
+	e := echo.New()
+	s.resolver.Register(e, s.policy)
+	graphqlServer := httptest.NewServer(e)
+	s.T().Cleanup(graphqlServer.Close)
+	s.graphqlURL = graphqlServer.URL
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	s.Require().NoError(err)
+	grpcServer := grpc.NewServer(grpc.StreamInterceptor(s.policy.StreamServerInterceptor()))
+	s.resolver.RegisterGRPC(grpcServer)
+	go func() {
+		_ = grpcServer.Serve(listener)
+	}()
+	s.T().Cleanup(grpcServer.Stop)
+	s.grpcAddress = listener.Addr().String()
+}
+
+func (s *IngestAuthTestSuite) TestMutationNamesIncludeReports() {
+	s.Require().Contains(MutationNames(), "reportCaptureResults")
+	s.Require().Contains(MutationNames(), "resetCapture")
+	s.Require().NotContains(MutationNames(), "__typename")
+}
+
+func (s *IngestAuthTestSuite) TestGraphQLRejectsMissingToken() {
+	client := mapperclient.New(s.graphqlURL)
+	err := client.ReportCaptureResults(context.Background(), mapperclient.CaptureResults{Results: []mapperclient.RecordedDestinationsForSrc{}})
+
+	var gqlErrors gqlerror.List
+	s.Require().True(errors.As(err, &gqlErrors))
+	s.Require().Equal(UnauthenticatedErrorCode, gqlErrors[0].Extensions["code"])
+	s.Require().Empty(s.resolver.dnsCaptureResults)
+}
+
+func (s *IngestAuthTestSuite) TestGraphQLRejectsWrongToken() {
+	client := mapperclient.New(s.graphqlURL, mapperclient.WithBearerToken("wrong"))
+	s.Require().Error(client.ReportCaptureResults(context.Background(), mapperclient.CaptureResults{Results: []mapperclient.RecordedDestinationsForSrc{}}))
+	s.Require().Empty(s.resolver.dnsCaptureResults)
+}
+
+func (s *IngestAuthTestSuite) TestGraphQLAcceptsToken() {
+	client := mapperclient.New(s.graphqlURL, mapperclient.WithBearerToken(testIngestToken))
+	s.Require().NoError(client.ReportCaptureResults(context.Background(), mapperclient.CaptureResults{Results: []mapperclient.RecordedDestinationsForSrc{}}))
+	s.Require().Len(s.resolver.dnsCaptureResults, 1)
+}
+
+func (s *IngestAuthTestSuite) TestGraphQLMutationWithSchemeNoneIsOpen() {
+	client := mapperclient.New(s.graphqlURL)
+	s.Require().NoError(client.ReportTCPCaptureResults(context.Background(), mapperclient.CaptureTCPResults{Results: []mapperclient.RecordedDestinationsForSrc{}}))
+	s.Require().Len(s.resolver.tcpCaptureResults, 1)
+}
+
+func (s *IngestAuthTestSuite) TestGRPCRejectsMissingToken() {
+	client := mapperclient.New(s.graphqlURL, mapperclient.WithGRPCIngest(s.grpcAddress))
+	err := client.ReportCaptureResults(context.Background(), mapperclient.CaptureResults{Results: []mapperclient.RecordedDestinationsForSrc{}})
+
+	var grpcErr interface{ GRPCStatus() *status.Status }
+	s.Require().True(errors.As(err, &grpcErr))
+	s.Require().Equal(codes.Unauthenticated, grpcErr.GRPCStatus().Code())
+	s.Require().Empty(s.resolver.dnsCaptureResults)
+}
+
+func (s *IngestAuthTestSuite) TestGRPCAcceptsToken() {
+	client := mapperclient.New(s.graphqlURL, mapperclient.WithGRPCIngest(s.grpcAddress), mapperclient.WithBearerToken(testIngestToken))
+	s.Require().NoError(client.ReportCaptureResults(context.Background(), mapperclient.CaptureResults{Results: []mapperclient.RecordedDestinationsForSrc{}}))
+	s.Require().Len(s.resolver.dnsCaptureResults, 1)
+}
+
+func (s *IngestAuthTestSuite) TestGRPCMutationWithSchemeNoneIsOpen() {
+	client := mapperclient.New(s.graphqlURL, mapperclient.WithGRPCIngest(s.grpcAddress))
+	s.Require().NoError(client.ReportTCPCaptureResults(context.Background(), mapperclient.CaptureTCPResults{Results: []mapperclient.RecordedDestinationsForSrc{}}))
+	s.Require().Len(s.resolver.tcpCaptureResults, 1)
+}
+
+func TestIngestAuthTestSuite(t *testing.T) {
+	suite.Run(t, new(IngestAuthTestSuite))
+}
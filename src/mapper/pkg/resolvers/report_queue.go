@@ -0,0 +1,56 @@
+package resolvers
+
+import (
+	"context"
+	"fmt"
+	"github.com/otterize/network-mapper/src/mapper/pkg/config"
+	"github.com/spf13/viper"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+	"time"
+)
+
+// RetryAfterErrorCode is the extensions code of the error report mutations return when the queue of the reported results
+// is full. The error's extensions also hold retryAfterMs, queueDepth and queueCapacity, so reporters know when to retry.
+const RetryAfterErrorCode = "RETRY_AFTER"
+
+// enqueueResults queues reported results for handling. If the queue is full, it waits for room for up to the configured
+// report queue wait, then returns a retry-after error so the reporter can report the results again later, rather than
+// them being dropped silently.
+func enqueueResults[T Results](ctx context.Context, resultsChan chan T, results T) error {
+	select {
+	case resultsChan <- results:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	default:
+	}
+
+	if wait := viper.GetDuration(config.ReportQueueWaitKey); wait > 0 {
+		timer := time.NewTimer(wait)
+		defer timer.Stop()
+		select {
+		case resultsChan <- results:
+			return nil
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+
+	return newRetryAfterError(len(resultsChan), cap(resultsChan))
+}
+
+// newRetryAfterError is returned as is rather than wrapped, as gqlgen only keeps the extensions of errors that are
+// *gqlerror.Error.
+func newRetryAfterError(queueDepth int, queueCapacity int) *gqlerror.Error {
+	retryAfter := viper.GetDuration(config.ReportQueueRetryAfterKey)
+	return &gqlerror.Error{
+		Message: fmt.Sprintf("report queue is full (%d/%d), retry after %s", queueDepth, queueCapacity, retryAfter),
+		Extensions: map[string]interface{}{
+			"code":          RetryAfterErrorCode,
+			"retryAfterMs":  retryAfter.Milliseconds(),
+			"queueDepth":    queueDepth,
+			"queueCapacity": queueCapacity,
+		},
+	}
+}
@@ -0,0 +1,63 @@
+package resolvers
+
+import (
+	"context"
+	"github.com/otterize/network-mapper/src/mapper/pkg/config"
+	"github.com/otterize/network-mapper/src/mapper/pkg/graph/model"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/suite"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+	"testing"
+	"time"
+)
+
+type ReportQueueTestSuite struct {
+	suite.Suite
+	queue chan model.CaptureResults
+}
+
+func (s *ReportQueueTestSuite) SetupTest() {
+	s.queue = make(chan model.CaptureResults, 1)
+	s.Require().NoError(enqueueResults(context.Background(), s.queue, model.CaptureResults{}))
+}
+
+func (s *ReportQueueTestSuite) TearDownTest() {
+	viper.Set(config.ReportQueueWaitKey, config.ReportQueueWaitDefault)
+}
+
+func (s *ReportQueueTestSuite) TestFullQueueReturnsRetryAfterError() {
+	err := enqueueResults(context.Background(), s.queue, model.CaptureResults{})
+
+	var gqlErr *gqlerror.Error
+	s.Require().ErrorAs(err, &gqlErr)
+	s.Require().Equal(map[string]interface{}{
+		"code":          RetryAfterErrorCode,
+		"retryAfterMs":  config.ReportQueueRetryAfterDefault.Milliseconds(),
+		"queueDepth":    1,
+		"queueCapacity": 1,
+	}, gqlErr.Extensions)
+	s.Require().Len(s.queue, 1)
+}
+
+func (s *ReportQueueTestSuite) TestWaitsForRoomInQueue() {
+	viper.Set(config.ReportQueueWaitKey, 5*time.Second)
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		<-s.queue
+	}()
+
+	s.Require().NoError(enqueueResults(context.Background(), s.queue, model.CaptureResults{}))
+	s.Require().Len(s.queue, 1)
+}
+
+func (s *ReportQueueTestSuite) TestWaitEndsWithContext() {
+	viper.Set(config.ReportQueueWaitKey, 5*time.Second)
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	s.Require().ErrorIs(enqueueResults(ctx, s.queue, model.CaptureResults{}), context.Canceled)
+}
+
+func TestReportQueueTestSuite(t *testing.T) {
+	suite.Run(t, new(ReportQueueTestSuite))
+}
@@ -9,17 +9,25 @@ import (
 	"github.com/otterize/intents-operator/src/shared/serviceidresolver"
 	"github.com/otterize/network-mapper/src/mapper/pkg/awsintentsholder"
 	"github.com/otterize/network-mapper/src/mapper/pkg/azureintentsholder"
+	"github.com/otterize/network-mapper/src/mapper/pkg/clientintentsgenerator"
 	"github.com/otterize/network-mapper/src/mapper/pkg/collectors/traffic"
+	"github.com/otterize/network-mapper/src/mapper/pkg/config"
 	"github.com/otterize/network-mapper/src/mapper/pkg/dnscache"
 	"github.com/otterize/network-mapper/src/mapper/pkg/externaltrafficholder"
+	"github.com/otterize/network-mapper/src/mapper/pkg/federation"
 	"github.com/otterize/network-mapper/src/mapper/pkg/gcpintentsholder"
 	"github.com/otterize/network-mapper/src/mapper/pkg/graph/generated"
 	"github.com/otterize/network-mapper/src/mapper/pkg/graph/model"
 	"github.com/otterize/network-mapper/src/mapper/pkg/incomingtrafficholder"
+	"github.com/otterize/network-mapper/src/mapper/pkg/ingestauth"
 	"github.com/otterize/network-mapper/src/mapper/pkg/intentsstore"
 	"github.com/otterize/network-mapper/src/mapper/pkg/kubefinder"
-	"github.com/otterize/network-mapper/src/mapper/pkg/mysqlstore"
+	"github.com/otterize/network-mapper/src/mapper/pkg/networkpolicysimulator"
+	"github.com/otterize/network-mapper/src/mapper/pkg/policydrift"
+	"github.com/otterize/network-mapper/src/mapper/pkg/sqlstore"
+	"github.com/otterize/network-mapper/src/mapper/pkg/staleintents"
 	"github.com/otterize/network-mapper/src/shared/isrunningonaws"
+	"github.com/spf13/viper"
 	"golang.org/x/sync/errgroup"
 	"sync"
 	"time"
@@ -44,9 +52,15 @@ type Resolver struct {
 	azureIntentsHolder           *azureintentsholder.AzureIntentsHolder
 	dnsCache                     *dnscache.DNSCache
 	trafficCollector             *traffic.Collector
-	dbClient                     *mysqlstore.MySQLIntentStore
+	dbClient                     sqlstore.IntentStore
+	clientIntentsGenerator       *clientintentsgenerator.Generator
+	federation                   *federation.Federation
+	staleIntentsReporter         *staleintents.Reporter
+	policyDriftDetector          *policydrift.Detector
+	networkPolicySimulator       *networkpolicysimulator.Simulator
 	dnsCaptureResults            chan model.CaptureResults
 	tcpCaptureResults            chan model.CaptureTCPResults
+	udpCaptureResults            chan model.CaptureUDPResults
 	socketScanResults            chan model.SocketScanResults
 	kafkaMapperResults           chan model.KafkaMapperResults
 	istioConnectionResults       chan model.IstioConnectionResults
@@ -71,29 +85,40 @@ func NewResolver(
 	dnsCache *dnscache.DNSCache,
 	incomingTrafficHolder *incomingtrafficholder.IncomingTrafficIntentsHolder,
 	trafficCollector *traffic.Collector,
-	dbClient *mysqlstore.MySQLIntentStore,
+	dbClient sqlstore.IntentStore,
+	federation *federation.Federation,
+	staleIntentsReporter *staleintents.Reporter,
+	policyDriftDetector *policydrift.Detector,
+	networkPolicySimulator *networkpolicysimulator.Simulator,
 ) *Resolver {
+	queueSize := viper.GetInt(config.ReportQueueSizeKey)
 	r := &Resolver{
 		kubeFinder:                   kubeFinder,
 		serviceIdResolver:            serviceIdResolver,
 		intentsHolder:                intentsHolder,
 		externalTrafficIntentsHolder: externalTrafficHolder,
 		incomingTrafficHolder:        incomingTrafficHolder,
-		dnsCaptureResults:            make(chan model.CaptureResults, 200),
-		tcpCaptureResults:            make(chan model.CaptureTCPResults, 200),
-		socketScanResults:            make(chan model.SocketScanResults, 200),
-		kafkaMapperResults:           make(chan model.KafkaMapperResults, 200),
-		istioConnectionResults:       make(chan model.IstioConnectionResults, 200),
-		awsOperations:                make(chan model.AWSOperationResults, 200),
-		azureOperations:              make(chan model.AzureOperationResults, 200),
-		gcpOperations:                make(chan model.GCPOperationResults, 200),
-		trafficLevelsResults:         make(chan model.TrafficLevelResults, 200),
+		dnsCaptureResults:            make(chan model.CaptureResults, queueSize),
+		tcpCaptureResults:            make(chan model.CaptureTCPResults, queueSize),
+		udpCaptureResults:            make(chan model.CaptureUDPResults, queueSize),
+		socketScanResults:            make(chan model.SocketScanResults, queueSize),
+		kafkaMapperResults:           make(chan model.KafkaMapperResults, queueSize),
+		istioConnectionResults:       make(chan model.IstioConnectionResults, queueSize),
+		awsOperations:                make(chan model.AWSOperationResults, queueSize),
+		azureOperations:              make(chan model.AzureOperationResults, queueSize),
+		gcpOperations:                make(chan model.GCPOperationResults, queueSize),
+		trafficLevelsResults:         make(chan model.TrafficLevelResults, queueSize),
 		awsIntentsHolder:             awsIntentsHolder,
 		gcpIntentsHolder:             gcpIntentsHolder,
 		azureIntentsHolder:           azureIntentsHolder,
 		trafficCollector:             trafficCollector,
 		dnsCache:                     dnsCache,
 		dbClient:                     dbClient,
+		clientIntentsGenerator:       clientintentsgenerator.NewGenerator(externalTrafficHolder, dbClient, viper.GetInt(config.ClientIntentsWildcardMinSubdomainsKey)),
+		federation:                   federation,
+		staleIntentsReporter:         staleIntentsReporter,
+		policyDriftDetector:          policyDriftDetector,
+		networkPolicySimulator:       networkPolicySimulator,
 		isRunningOnAws:               isrunningonaws.Check(),
 	}
 	r.gotResultsCtx, r.gotResultsSignal = context.WithCancel(context.Background())
@@ -101,13 +126,19 @@ func NewResolver(
 	return r
 }
 
-func (r *Resolver) Register(e *echo.Echo) {
+// Register serves the GraphQL API on e. Mutations are only served to callers authPolicy allows; a nil authPolicy allows
+// everyone.
+func (r *Resolver) Register(e *echo.Echo, authPolicy *ingestauth.Policy) {
 	c := generated.Config{Resolvers: r}
 	srv := handler.NewDefaultServer(generated.NewExecutableSchema(c))
+	srv.AroundRootFields(authorizeMutations(authPolicy))
 	e.Any("/query", func(c echo.Context) error {
-		srv.ServeHTTP(c.Response(), c.Request())
+		req := c.Request()
+		req = req.WithContext(ingestauth.ContextWithCredentials(req.Context(), ingestauth.CredentialsFromHTTPRequest(req)))
+		srv.ServeHTTP(c.Response(), req)
 		return nil
 	})
+	e.GET("/external-client-intents", r.handleExternalClientIntentsRequest)
 }
 
 func (r *Resolver) RunForever(ctx context.Context) error {
@@ -120,6 +151,10 @@ func (r *Resolver) RunForever(ctx context.Context) error {
 		defer bugsnag.AutoNotify(errGrpCtx)
 		return runHandleLoop(errGrpCtx, r.tcpCaptureResults, r.handleReportTCPCaptureResults)
 	})
+	errgrp.Go(func() error {
+		defer bugsnag.AutoNotify(errGrpCtx)
+		return runHandleLoop(errGrpCtx, r.udpCaptureResults, r.handleReportUDPCaptureResults)
+	})
 	errgrp.Go(func() error {
 		defer bugsnag.AutoNotify(errGrpCtx)
 		return runHandleLoop(errGrpCtx, r.socketScanResults, r.handleReportSocketScanResults)
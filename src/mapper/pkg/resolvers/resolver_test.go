@@ -19,10 +19,12 @@ import (
 	"github.com/otterize/network-mapper/src/mapper/pkg/intentsstore"
 	"github.com/otterize/network-mapper/src/mapper/pkg/kubefinder"
 	"github.com/otterize/network-mapper/src/mapper/pkg/resolvers/test_gql_client"
+	sharedconfig "github.com/otterize/network-mapper/src/shared/config"
 	"github.com/otterize/network-mapper/src/shared/testbase"
 	"github.com/otterize/nilable"
 	"github.com/samber/lo"
 	"github.com/sirupsen/logrus"
+	"github.com/spf13/viper"
 	"github.com/stretchr/testify/suite"
 	"golang.org/x/exp/slices"
 	v1 "k8s.io/api/core/v1"
@@ -78,9 +80,14 @@ func (s *ResolverTestSuite) SetupTest() {
 		dnsCache,
 		s.incomingTrafficIntentsHolder,
 		traffic.NewCollector(),
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
 	)
 
-	resolver.Register(e)
+	resolver.Register(e, nil)
 	s.resolver = resolver
 	go func() {
 		err := resolver.RunForever(s.resolverCtx)
@@ -1579,6 +1586,42 @@ func (s *ResolverTestSuite) TestReportTCPResultsIgnoreTargetsWithShortUptime() {
 
 }
 
+func (s *ResolverTestSuite) TestReportUDPResults() {
+	viper.Set(sharedconfig.EnableUDPKey, true)
+	defer viper.Set(sharedconfig.EnableUDPKey, sharedconfig.EnableUDPSnifferDefault)
+
+	srcPodIP := "1.1.1.3"
+	_ = s.AddPod("pod3", srcPodIP, nil, nil)
+	targetPodIP := "1.1.1.2"
+	s.AddDeploymentWithService("statsd", []string{targetPodIP}, map[string]string{"app": "statsd"}, "10.0.0.38")
+	s.Require().True(s.Mgr.GetCache().WaitForCacheSync(context.Background()))
+
+	packetTime := time.Now().Add(config.TimeServerHasToLiveBeforeWeTrustItDefault).Add(time.Minute)
+	err := s.resolver.handleReportUDPCaptureResults(context.Background(), model.CaptureUDPResults{
+		Results: []model.RecordedDestinationsForSrc{
+			{
+				SrcIP: srcPodIP,
+				Destinations: []model.Destination{
+					{
+						Destination:     targetPodIP,
+						DestinationIP:   &targetPodIP,
+						DestinationPort: lo.ToPtr(int64(8125)),
+						LastSeen:        packetTime,
+						SrcPorts:        []int64{40000},
+					},
+				},
+			},
+		},
+	})
+	s.Require().NoError(err)
+
+	intents := s.intentsHolder.GetNewIntentsSinceLastGet()
+	s.Require().Len(intents, 1)
+	s.Require().Equal("statsd", intents[0].Intent.Server.Name)
+	s.Require().Equal(model.IntentProtocolUDP, lo.FromPtr(intents[0].Intent.Protocol))
+	s.Require().Equal([]model.IntentPort{{Port: 8125, Protocol: model.IntentProtocolUDP}}, intents[0].Intent.Ports)
+}
+
 func TestRunSuite(t *testing.T) {
 	suite.Run(t, new(ResolverTestSuite))
 }
@@ -3,11 +3,14 @@ package resolvers
 import (
 	"context"
 	"fmt"
+	"github.com/labstack/echo/v4"
+	otterizev2alpha1 "github.com/otterize/intents-operator/src/operator/api/v2alpha1"
 	"github.com/otterize/intents-operator/src/shared/errors"
 	"github.com/otterize/intents-operator/src/shared/serviceidresolver/serviceidentity"
 	"github.com/otterize/intents-operator/src/shared/telemetries/telemetriesgql"
 	"github.com/otterize/intents-operator/src/shared/telemetries/telemetrysender"
 	"github.com/otterize/network-mapper/src/mapper/pkg/awsintentsholder"
+	"github.com/otterize/network-mapper/src/mapper/pkg/clientintentsgenerator"
 	"github.com/otterize/network-mapper/src/mapper/pkg/concurrentconnectioncounter"
 	"github.com/otterize/network-mapper/src/mapper/pkg/config"
 	"github.com/otterize/network-mapper/src/mapper/pkg/externaltrafficholder"
@@ -16,11 +19,14 @@ import (
 	"github.com/otterize/network-mapper/src/mapper/pkg/incomingtrafficholder"
 	"github.com/otterize/network-mapper/src/mapper/pkg/kubefinder"
 	"github.com/otterize/network-mapper/src/mapper/pkg/prometheus"
+	"github.com/otterize/network-mapper/src/mapper/pkg/sqlstore"
 	sharedconfig "github.com/otterize/network-mapper/src/shared/config"
 	"github.com/samber/lo"
 	"github.com/sirupsen/logrus"
 	"github.com/spf13/viper"
 	corev1 "k8s.io/api/core/v1"
+	"net/http"
+	"strconv"
 	"strings"
 	"time"
 )
@@ -30,6 +36,7 @@ type SourceType string
 const (
 	SourceTypeDNSCapture  SourceType = "Capture"
 	SourceTypeTCPScan     SourceType = "TCPScan"
+	SourceTypeUDPCapture  SourceType = "UDPCapture"
 	SourceTypeSocketScan  SourceType = "SocketScan"
 	SourceTypeKafkaMapper SourceType = "KafkaMapper"
 	SourceTypeIstio       SourceType = "Istio"
@@ -72,6 +79,14 @@ func getDestIp(dest model.Destination, fixParams model.TCPDestResolveBugfixData)
 	return dest.Destination
 }
 
+// intentPorts returns the destination port of a connection as intent ports, or nil if the port is unknown.
+func intentPorts(dest model.Destination, protocol model.IntentProtocol) []model.IntentPort {
+	if dest.DestinationPort == nil {
+		return nil
+	}
+	return []model.IntentPort{{Port: *dest.DestinationPort, Protocol: protocol}}
+}
+
 func (r *Resolver) resolveDestIdentityTCP(ctx context.Context, dest model.Destination, lastSeen time.Time, fixParams model.TCPDestResolveBugfixData) (model.OtterizeServiceIdentity, bool, error) {
 	destIp := getDestIp(dest, fixParams)
 	destSvc, isTargetService, err := r.kubeFinder.ResolveIPToService(ctx, destIp)
@@ -183,6 +198,7 @@ func (r *Resolver) addSocketScanServiceIntent(ctx context.Context, srcSvcIdentit
 	intent := model.Intent{
 		Client:         &srcSvcIdentity,
 		Server:         &dstSvcIdentity,
+		Ports:          intentPorts(dest, model.IntentProtocolTCP),
 		ResolutionData: lo.ToPtr(concurrentconnectioncounter.SocketScanServiceIntentResolution),
 	}
 
@@ -236,6 +252,7 @@ func (r *Resolver) addSocketScanPodIntent(ctx context.Context, srcSvcIdentity mo
 	intent := model.Intent{
 		Client:         &srcSvcIdentity,
 		Server:         dstSvcIdentity,
+		Ports:          intentPorts(dest, model.IntentProtocolTCP),
 		ResolutionData: lo.ToPtr(concurrentconnectioncounter.SocketScanPodIntentResolution),
 	}
 
@@ -269,6 +286,10 @@ func (r *Resolver) handleDNSCaptureResultsAsExternalTraffic(_ context.Context, d
 	if dest.DestinationIP != nil {
 		ip = *dest.DestinationIP
 		intent.IPs = map[externaltrafficholder.IP]struct{}{externaltrafficholder.IP(*dest.DestinationIP): {}}
+		if dest.DestinationPort != nil {
+			port := externaltrafficholder.Port{Number: int(*dest.DestinationPort), Protocol: model.IntentProtocolTCP}
+			intent.Ports = map[externaltrafficholder.IP]map[externaltrafficholder.Port]struct{}{externaltrafficholder.IP(*dest.DestinationIP): {port: {}}}
+		}
 		ttl := 120 * time.Second
 		if dest.TTL != nil {
 			ttl = time.Duration(*dest.TTL) * time.Second
@@ -281,6 +302,32 @@ func (r *Resolver) handleDNSCaptureResultsAsExternalTraffic(_ context.Context, d
 	return nil
 }
 
+// handleTCPResultAsExternalTraffic records the destination port of a connection to an IP outside the cluster, for every
+// DNS name the IP was recently resolved for. Connections to IPs that were not seen in a DNS response are ignored.
+func (r *Resolver) handleTCPResultAsExternalTraffic(srcSvcIdentity model.OtterizeServiceIdentity, dest model.Destination) {
+	if !viper.GetBool(config.ExternalTrafficCaptureEnabledKey) {
+		return
+	}
+	if dest.DestinationIP == nil || dest.DestinationPort == nil {
+		return
+	}
+
+	ip := externaltrafficholder.IP(*dest.DestinationIP)
+	for _, dnsName := range r.dnsCache.GetDNSNamesForIP(*dest.DestinationIP) {
+		if srcSvcIdentity.Name == "otterize-network-mapper" && dnsName != "app.otterize.com" {
+			continue
+		}
+		logrus.Debugf("Saw external traffic, from '%s.%s' to '%s' (IP '%s', port %d)", srcSvcIdentity.Name, srcSvcIdentity.Namespace, dnsName, ip, *dest.DestinationPort)
+		r.externalTrafficIntentsHolder.AddIntent(externaltrafficholder.ExternalTrafficIntent{
+			Client:   srcSvcIdentity,
+			LastSeen: dest.LastSeen,
+			DNSName:  dnsName,
+			IPs:      map[externaltrafficholder.IP]struct{}{ip: {}},
+			Ports:    map[externaltrafficholder.IP]map[externaltrafficholder.Port]struct{}{ip: {{Number: int(*dest.DestinationPort), Protocol: model.IntentProtocolTCP}: {}}},
+		})
+	}
+}
+
 // ReportAWSOperation is the resolver for the reportAWSOperation field.
 func (r *Resolver) handleAWSOperationReport(ctx context.Context, operation model.AWSOperationResults) error {
 	for _, op := range operation {
@@ -617,7 +664,7 @@ func (r *Resolver) handleReportTCPCaptureResults(ctx context.Context, results mo
 	}
 
 	for _, captureItem := range results.Results {
-		err := r.handleTCPCaptureResult(ctx, captureItem)
+		err := r.handleConnectionCaptureResult(ctx, captureItem, r.handleInternalTrafficTCPResult)
 		if err != nil {
 			logrus.WithError(err).
 				WithField("srcIp", captureItem.SrcIP).
@@ -630,8 +677,32 @@ func (r *Resolver) handleReportTCPCaptureResults(ctx context.Context, results mo
 	return nil
 }
 
-func (r *Resolver) handleTCPCaptureResult(ctx context.Context, captureItem model.RecordedDestinationsForSrc) error {
-	logrus.Debugf("Handling TCP capture result from %s to %s:%d", captureItem.SrcIP, captureItem.Destinations[0].Destination, lo.FromPtr(captureItem.Destinations[0].DestinationPort))
+func (r *Resolver) handleReportUDPCaptureResults(ctx context.Context, results model.CaptureUDPResults) error {
+	if !viper.GetBool(sharedconfig.EnableUDPKey) {
+		return nil
+	}
+
+	for _, captureItem := range results.Results {
+		err := r.handleConnectionCaptureResult(ctx, captureItem, r.handleInternalTrafficUDPResult)
+		if err != nil {
+			logrus.WithError(err).
+				WithField("srcIp", captureItem.SrcIP).
+				WithField("srcHostname", captureItem.SrcHostname).
+				Error("could not handle UDP capture result")
+		}
+	}
+	telemetrysender.SendNetworkMapper(telemetriesgql.EventTypeIntentsDiscoveredCapture, len(results.Results))
+	r.gotResultsSignal()
+	return nil
+}
+
+type internalTrafficResultHandlerFunc func(ctx context.Context, srcIdentity model.OtterizeServiceIdentity, dest model.Destination, srcIsControlPlane bool)
+
+// handleConnectionCaptureResult handles the connections a source was seen opening, by TCP SYN or by the first packet of
+// a UDP flow. Connections from outside the cluster are reported as incoming traffic, the rest are passed to
+// handleInternalTraffic.
+func (r *Resolver) handleConnectionCaptureResult(ctx context.Context, captureItem model.RecordedDestinationsForSrc, handleInternalTraffic internalTrafficResultHandlerFunc) error {
+	logrus.Debugf("Handling capture result from %s to %s:%d", captureItem.SrcIP, captureItem.Destinations[0].Destination, lo.FromPtr(captureItem.Destinations[0].DestinationPort))
 	isSrcInCluster, err := r.kubeFinder.IsSrcIpClusterInternal(ctx, captureItem.SrcIP)
 	if err != nil {
 		return errors.Wrap(err)
@@ -653,7 +724,7 @@ func (r *Resolver) handleTCPCaptureResult(ctx context.Context, captureItem model
 	}
 
 	for _, dest := range captureItem.Destinations {
-		r.handleInternalTrafficTCPResult(ctx, srcSvcIdentity, dest, srcIsControlPlane)
+		handleInternalTraffic(ctx, srcSvcIdentity, dest, srcIsControlPlane)
 	}
 	return nil
 }
@@ -682,35 +753,20 @@ func (r *Resolver) reportIncomingInternetTraffic(ctx context.Context, srcIP stri
 }
 
 func (r *Resolver) handleInternalTrafficTCPResult(ctx context.Context, srcIdentity model.OtterizeServiceIdentity, dest model.Destination, srcIsControlPlane bool) {
-	lastSeen := dest.LastSeen
-	tcpResolveDesFixParams := model.TCPDestResolveBugfixData{
-		ResolvedUsingIP:   false,
-		IsSrcControlPlane: srcIsControlPlane,
-	}
-
-	destIdentity, ok, err := r.resolveDestIdentityTCP(ctx, dest, lastSeen, tcpResolveDesFixParams)
+	destIdentity, ok, err := r.resolveInternalTrafficDestIdentity(ctx, dest, srcIsControlPlane)
 	if err != nil {
 		logrus.WithError(err).Error("could not resolve destination identity")
 		return
 	}
-
 	if !ok {
-		// let's try again, now using the fix
-		tcpResolveDesFixParams.ResolvedUsingIP = true
-		destIdentity, ok, err = r.resolveDestIdentityTCP(ctx, dest, lastSeen, tcpResolveDesFixParams)
-		if err != nil {
-			logrus.WithError(err).Error("could not resolve destination identity, even with the fix")
-			return
-		}
-
-		if !ok {
-			return
-		}
+		r.handleTCPResultAsExternalTraffic(srcIdentity, dest)
+		return
 	}
 
 	intent := model.Intent{
 		Client:         &srcIdentity,
 		Server:         &destIdentity,
+		Ports:          intentPorts(dest, model.IntentProtocolTCP),
 		ResolutionData: lo.ToPtr(concurrentconnectioncounter.TCPTrafficIntentResolution),
 	}
 
@@ -722,6 +778,61 @@ func (r *Resolver) handleInternalTrafficTCPResult(ctx context.Context, srcIdenti
 	updateTelemetriesCounters(SourceTypeTCPScan, intent)
 }
 
+func (r *Resolver) handleInternalTrafficUDPResult(ctx context.Context, srcIdentity model.OtterizeServiceIdentity, dest model.Destination, srcIsControlPlane bool) {
+	destIdentity, ok, err := r.resolveInternalTrafficDestIdentity(ctx, dest, srcIsControlPlane)
+	if err != nil {
+		logrus.WithError(err).Error("could not resolve destination identity")
+		return
+	}
+	if !ok {
+		// External traffic is recorded by DNS name and TCP port, so UDP flows to IPs outside the cluster are only counted
+		logrus.Debugf("Not recording UDP flow from '%s.%s' to external IP '%s'", srcIdentity.Name, srcIdentity.Namespace, lo.FromPtrOr(dest.DestinationIP, dest.Destination))
+		prometheus.IncrementUDPExternalFlows(1)
+		return
+	}
+
+	intent := model.Intent{
+		Client:         &srcIdentity,
+		Server:         &destIdentity,
+		Protocol:       lo.ToPtr(model.IntentProtocolUDP),
+		Ports:          intentPorts(dest, model.IntentProtocolUDP),
+		ResolutionData: lo.ToPtr(concurrentconnectioncounter.UDPTrafficIntentResolution),
+	}
+
+	r.intentsHolder.AddIntent(
+		dest.LastSeen,
+		intent,
+		dest.SrcPorts,
+	)
+	updateTelemetriesCounters(SourceTypeUDPCapture, intent)
+}
+
+// resolveInternalTrafficDestIdentity resolves the destination of a captured connection to a pod or service in the
+// cluster, and returns false if it is not one. Lookup errors are returned rather than reported as not found, so traffic
+// in the cluster is never mistaken for external traffic.
+func (r *Resolver) resolveInternalTrafficDestIdentity(ctx context.Context, dest model.Destination, srcIsControlPlane bool) (model.OtterizeServiceIdentity, bool, error) {
+	lastSeen := dest.LastSeen
+	tcpResolveDesFixParams := model.TCPDestResolveBugfixData{
+		ResolvedUsingIP:   false,
+		IsSrcControlPlane: srcIsControlPlane,
+	}
+
+	destIdentity, ok, err := r.resolveDestIdentityTCP(ctx, dest, lastSeen, tcpResolveDesFixParams)
+	if err != nil {
+		return model.OtterizeServiceIdentity{}, false, errors.Wrap(err)
+	}
+
+	if !ok {
+		// let's try again, now using the fix
+		tcpResolveDesFixParams.ResolvedUsingIP = true
+		destIdentity, ok, err = r.resolveDestIdentityTCP(ctx, dest, lastSeen, tcpResolveDesFixParams)
+		if err != nil {
+			return model.OtterizeServiceIdentity{}, false, errors.Errorf("could not resolve destination identity, even with the fix: %w", err)
+		}
+	}
+	return destIdentity, ok, nil
+}
+
 func (r *Resolver) handleReportCaptureResults(ctx context.Context, results model.CaptureResults) error {
 	if !viper.GetBool(sharedconfig.EnableDNSKey) {
 		return nil
@@ -1001,3 +1112,158 @@ func (r *Resolver) resolveIPToIdentity(ctx context.Context, ip string) (servicei
 
 	return identity, nil
 }
+
+const (
+	defaultExternalIntentsPageSize = 100
+	maxExternalIntentsPageSize     = 1000
+)
+
+func externalIntentsFilterFromModel(filter *model.ExternalIntentsFilter) sqlstore.ExternalIntentsFilter {
+	if filter == nil {
+		return sqlstore.ExternalIntentsFilter{}
+	}
+	statuses := lo.Map(filter.Statuses, func(status model.ExternalIntentStatus, _ int) string {
+		return strings.ToLower(string(status))
+	})
+	return sqlstore.ExternalIntentsFilter{
+		Namespaces:   filter.Namespaces,
+		ClientNames:  filter.ClientNames,
+		ClientKinds:  filter.ClientKinds,
+		Statuses:     statuses,
+		DNSName:      lo.FromPtr(filter.DNSName),
+		LastSeenFrom: filter.LastSeenFrom,
+		LastSeenTo:   filter.LastSeenTo,
+	}
+}
+
+func externalIntentRecordToModel(record sqlstore.ExternalIntentRecord) model.ExternalIntent {
+	var statusUpdatedAt *string
+	if record.StatusUpdatedAt != nil {
+		statusUpdatedAt = lo.ToPtr(record.StatusUpdatedAt.Format(time.RFC3339))
+	}
+	return model.ExternalIntent{
+		Client: &model.ExternalClient{
+			Name:      record.ClientName,
+			Namespace: record.ClientNamespace,
+			Kind:      record.ClientKind,
+		},
+		DNSName:  record.DNSName,
+		LastSeen: record.LastSeen.Format(time.RFC3339),
+		Addresses: lo.Map(record.Addresses, func(address sqlstore.ExternalIntentAddressRecord, _ int) model.ExternalIntentAddress {
+			return model.ExternalIntentAddress{
+				IP:        address.IP,
+				Port:      int64(address.Port),
+				Protocol:  lo.Ternary(address.Protocol != "", lo.ToPtr(model.IntentProtocol(address.Protocol)), nil),
+				FirstSeen: address.FirstSeen.Format(time.RFC3339),
+				LastSeen:  address.LastSeen.Format(time.RFC3339),
+			}
+		}),
+		Status:          model.ExternalIntentStatus(strings.ToUpper(record.Status)),
+		StatusReason:    lo.EmptyableToPtr(record.StatusReason),
+		StatusUpdatedAt: statusUpdatedAt,
+	}
+}
+
+func intentHistoryFilterFromModel(client *model.NamespacedName, server *model.IntentHistoryServer, kinds []model.IntentHistoryKind, from *time.Time, to *time.Time) sqlstore.IntentHistoryFilter {
+	filter := sqlstore.IntentHistoryFilter{
+		Kinds: lo.Map(kinds, func(kind model.IntentHistoryKind, _ int) sqlstore.IntentKind {
+			return sqlstore.IntentKind(strings.ToLower(string(kind)))
+		}),
+		From: lo.FromPtr(from),
+		To:   lo.FromPtr(to),
+	}
+	if client != nil {
+		filter.ClientName = client.Name
+		filter.ClientNamespace = client.Namespace
+	}
+	if server != nil {
+		filter.ServerName = server.Name
+		filter.ServerNamespace = server.Namespace
+	}
+	return filter
+}
+
+func intentHistoryRecordToModel(record sqlstore.IntentHistoryRecord) model.IntentHistory {
+	return model.IntentHistory{
+		Kind:            model.IntentHistoryKind(strings.ToUpper(string(record.Kind))),
+		ClientName:      record.ClientName,
+		ClientNamespace: record.ClientNamespace,
+		ServerName:      record.ServerName,
+		ServerNamespace: record.ServerNamespace,
+		FirstSeen:       record.FirstSeen,
+		LastSeen:        record.LastSeen,
+		Activity: lo.Map(record.Days, func(day sqlstore.IntentActivityDay, _ int) model.IntentActivityDay {
+			return model.IntentActivityDay{Date: day.Date.Format("2006-01-02"), Count: int64(day.Count)}
+		}),
+	}
+}
+
+func (r *Resolver) setExternalIntentStatus(ctx context.Context, intent model.ExternalIntentKey, status string, reason *string) (*model.ExternalIntent, error) {
+	if r.dbClient == nil {
+		return nil, errors.New("database is not enabled, external intent statuses cannot be set")
+	}
+
+	record, err := r.dbClient.SetExternalIntentStatus(ctx, sqlstore.ExternalIntentKey{
+		ClientName:      intent.ClientName,
+		ClientNamespace: intent.ClientNamespace,
+		ClientKind:      intent.ClientKind,
+		DNSName:         intent.DNSName,
+	}, status, lo.FromPtr(reason))
+	if err != nil {
+		return nil, errors.Wrap(err)
+	}
+	externalIntent := externalIntentRecordToModel(record)
+	return &externalIntent, nil
+}
+
+func clientIntentsToModel(clientIntents otterizev2alpha1.ClientIntents) (model.ClientIntentsManifest, error) {
+	manifestYAML, err := clientintentsgenerator.MarshalYAML([]otterizev2alpha1.ClientIntents{clientIntents})
+	if err != nil {
+		return model.ClientIntentsManifest{}, errors.Wrap(err)
+	}
+	domains := make([]string, 0)
+	for _, target := range clientIntents.Spec.Targets {
+		if target.Internet != nil {
+			domains = append(domains, target.Internet.Domains...)
+		}
+	}
+	return model.ClientIntentsManifest{
+		ClientName: clientIntents.Spec.Workload.Name,
+		Namespace:  clientIntents.Namespace,
+		Kind:       lo.EmptyableToPtr(clientIntents.Spec.Workload.Kind),
+		Domains:    domains,
+		Yaml:       string(manifestYAML),
+	}, nil
+}
+
+// handleExternalClientIntentsRequest serves the ClientIntents generated for the 'namespace' and optional 'client' query
+// parameters as a multi-document YAML, so they can be piped to kubectl apply.
+func (r *Resolver) handleExternalClientIntentsRequest(c echo.Context) error {
+	approvedOnly := false
+	if value := c.QueryParam("approvedOnly"); value != "" {
+		var err error
+		approvedOnly, err = strconv.ParseBool(value)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, "approvedOnly must be true or false")
+		}
+	}
+
+	clientIntents, err := r.clientIntentsGenerator.Generate(c.Request().Context(), clientintentsgenerator.Filter{
+		Namespace:    c.QueryParam("namespace"),
+		ClientName:   c.QueryParam("client"),
+		ApprovedOnly: approvedOnly,
+	})
+	if errors.Is(err, clientintentsgenerator.ErrNamespaceRequired) {
+		return echo.NewHTTPError(http.StatusBadRequest, "the namespace query parameter is required")
+	}
+	if err != nil {
+		logrus.WithError(err).Error("Failed to generate client intents")
+		return errors.Wrap(err)
+	}
+
+	manifests, err := clientintentsgenerator.MarshalYAML(clientIntents)
+	if err != nil {
+		return errors.Wrap(err)
+	}
+	return c.Blob(http.StatusOK, "application/yaml", manifests)
+}
@@ -7,144 +7,142 @@ package resolvers
 import (
 	"context"
 	"github.com/otterize/intents-operator/src/shared/errors"
+	"github.com/otterize/network-mapper/src/mapper/pkg/clientintentsgenerator"
+	"github.com/otterize/network-mapper/src/mapper/pkg/config"
+	"github.com/otterize/network-mapper/src/mapper/pkg/federation"
 	"github.com/otterize/network-mapper/src/mapper/pkg/graph/generated"
 	"github.com/otterize/network-mapper/src/mapper/pkg/graph/model"
 	"github.com/otterize/network-mapper/src/mapper/pkg/intentsstore"
+	"github.com/otterize/network-mapper/src/mapper/pkg/networkpolicysimulator"
+	"github.com/otterize/network-mapper/src/mapper/pkg/policydrift"
 	"github.com/otterize/network-mapper/src/mapper/pkg/prometheus"
+	"github.com/otterize/network-mapper/src/mapper/pkg/sqlstore"
+	"github.com/otterize/network-mapper/src/mapper/pkg/staleintents"
 	"github.com/samber/lo"
 	"github.com/sirupsen/logrus"
+	"github.com/spf13/viper"
 	"golang.org/x/exp/slices"
+	"time"
 )
 
 // ResetCapture is the resolver for the resetCapture field.
 func (r *mutationResolver) ResetCapture(ctx context.Context) (bool, error) {
 	logrus.Info("Resetting stored intents")
 	r.intentsHolder.Reset()
+	if r.dbClient != nil && viper.GetBool(config.DbPersistIntentsEnabledKey) {
+		if err := r.dbClient.ResetIntents(ctx); err != nil {
+			return false, errors.Wrap(err)
+		}
+	}
 	return true, nil
 }
 
 // ReportCaptureResults is the resolver for the reportCaptureResults field.
 func (r *mutationResolver) ReportCaptureResults(ctx context.Context, results model.CaptureResults) (bool, error) {
-	select {
-	case r.dnsCaptureResults <- results:
-		prometheus.IncrementDNSCaptureReports(len(results.Results))
-		return true, nil
-	case <-ctx.Done():
-		return false, ctx.Err()
-	default:
+	if err := enqueueResults(ctx, r.dnsCaptureResults, results); err != nil {
 		prometheus.IncrementDNSCaptureDrops(len(results.Results))
-		return false, nil
+		return false, err
 	}
+	prometheus.IncrementDNSCaptureReports(len(results.Results))
+	return true, nil
 }
 
 // ReportTCPCaptureResults is the resolver for the reportTCPCaptureResults field.
 func (r *mutationResolver) ReportTCPCaptureResults(ctx context.Context, results model.CaptureTCPResults) (bool, error) {
-	select {
-	case r.tcpCaptureResults <- results:
-		prometheus.IncrementTCPCaptureReports(len(results.Results))
-		return true, nil
-	case <-ctx.Done():
-		return false, ctx.Err()
-	default:
+	if err := enqueueResults(ctx, r.tcpCaptureResults, results); err != nil {
 		prometheus.IncrementTCPCaptureDrops(len(results.Results))
-		return false, nil
+		return false, err
 	}
+	prometheus.IncrementTCPCaptureReports(len(results.Results))
+	return true, nil
+}
+
+// ReportUDPCaptureResults is the resolver for the reportUDPCaptureResults field.
+func (r *mutationResolver) ReportUDPCaptureResults(ctx context.Context, results model.CaptureUDPResults) (bool, error) {
+	if err := enqueueResults(ctx, r.udpCaptureResults, results); err != nil {
+		prometheus.IncrementUDPCaptureDrops(len(results.Results))
+		return false, err
+	}
+	prometheus.IncrementUDPCaptureReports(len(results.Results))
+	return true, nil
 }
 
 // ReportSocketScanResults is the resolver for the reportSocketScanResults field.
 func (r *mutationResolver) ReportSocketScanResults(ctx context.Context, results model.SocketScanResults) (bool, error) {
-	select {
-	case r.socketScanResults <- results:
-		prometheus.IncrementSocketScanReports(len(results.Results))
-		return true, nil
-	case <-ctx.Done():
-		return false, ctx.Err()
-	default:
+	if err := enqueueResults(ctx, r.socketScanResults, results); err != nil {
 		prometheus.IncrementSocketScanDrops(len(results.Results))
-		return false, nil
+		return false, err
 	}
+	prometheus.IncrementSocketScanReports(len(results.Results))
+	return true, nil
 }
 
 // ReportKafkaMapperResults is the resolver for the reportKafkaMapperResults field.
 func (r *mutationResolver) ReportKafkaMapperResults(ctx context.Context, results model.KafkaMapperResults) (bool, error) {
-	select {
-	case r.kafkaMapperResults <- results:
-		prometheus.IncrementKafkaReports(len(results.Results))
-		return true, nil
-	case <-ctx.Done():
-		return false, ctx.Err()
-	default:
+	if err := enqueueResults(ctx, r.kafkaMapperResults, results); err != nil {
 		prometheus.IncrementKafkaDrops(len(results.Results))
-		return false, nil
+		return false, err
 	}
+	prometheus.IncrementKafkaReports(len(results.Results))
+	return true, nil
 }
 
 // ReportIstioConnectionResults is the resolver for the reportIstioConnectionResults field.
 func (r *mutationResolver) ReportIstioConnectionResults(ctx context.Context, results model.IstioConnectionResults) (bool, error) {
-	select {
-	case r.istioConnectionResults <- results:
-		prometheus.IncrementIstioReports(len(results.Results))
-		return true, nil
-	case <-ctx.Done():
-		return false, ctx.Err()
-	default:
+	if err := enqueueResults(ctx, r.istioConnectionResults, results); err != nil {
 		prometheus.IncrementIstioDrops(len(results.Results))
-		return false, nil
+		return false, err
 	}
+	prometheus.IncrementIstioReports(len(results.Results))
+	return true, nil
 }
 
 // ReportAWSOperation is the resolver for the reportAWSOperation field.
 func (r *mutationResolver) ReportAWSOperation(ctx context.Context, operation []model.AWSOperation) (bool, error) {
-	select {
-	case r.awsOperations <- operation:
-		prometheus.IncrementAWSOperationReports(len(operation))
-		return true, nil
-	case <-ctx.Done():
-		return false, ctx.Err()
-	default:
+	if err := enqueueResults(ctx, r.awsOperations, operation); err != nil {
 		prometheus.IncrementAWSOperationDrops(len(operation))
-		return false, nil
+		return false, err
 	}
+	prometheus.IncrementAWSOperationReports(len(operation))
+	return true, nil
 }
 
 // ReportAzureOperation is the resolver for the reportAzureOperation field.
 func (r *mutationResolver) ReportAzureOperation(ctx context.Context, operation []model.AzureOperation) (bool, error) {
-	select {
-	case r.azureOperations <- operation:
-		prometheus.IncrementAzureOperationReports(len(operation))
-		return true, nil
-	case <-ctx.Done():
-		return false, ctx.Err()
-	default:
+	if err := enqueueResults(ctx, r.azureOperations, operation); err != nil {
 		prometheus.IncrementAzureOperationDrops(len(operation))
-		return false, nil
+		return false, err
 	}
+	prometheus.IncrementAzureOperationReports(len(operation))
+	return true, nil
 }
 
 // ReportGCPOperation is the resolver for the reportGCPOperation field.
 func (r *mutationResolver) ReportGCPOperation(ctx context.Context, operation []model.GCPOperation) (bool, error) {
-	select {
-	case r.gcpOperations <- operation:
-		prometheus.IncrementGCPOperationReports(len(operation))
-		return true, nil
-	case <-ctx.Done():
-		return false, ctx.Err()
-	default:
+	if err := enqueueResults(ctx, r.gcpOperations, operation); err != nil {
 		prometheus.IncrementGCPOperationDrops(len(operation))
-		return false, nil
+		return false, err
 	}
+	prometheus.IncrementGCPOperationReports(len(operation))
+	return true, nil
 }
 
 // ReportTrafficLevelResults is the resolver for the reportTrafficLevelResults field.
 func (r *mutationResolver) ReportTrafficLevelResults(ctx context.Context, results model.TrafficLevelResults) (bool, error) {
-	select {
-	case r.trafficLevelsResults <- results:
-		return true, nil
-	case <-ctx.Done():
-		return false, ctx.Err()
-	default:
-		return false, nil
+	if err := enqueueResults(ctx, r.trafficLevelsResults, results); err != nil {
+		return false, err
 	}
+	return true, nil
+}
+
+// ApproveExternalIntent is the resolver for the approveExternalIntent field.
+func (r *mutationResolver) ApproveExternalIntent(ctx context.Context, intent model.ExternalIntentKey, reason *string) (*model.ExternalIntent, error) {
+	return r.setExternalIntentStatus(ctx, intent, sqlstore.ExternalIntentStatusApproved, reason)
+}
+
+// DenyExternalIntent is the resolver for the denyExternalIntent field.
+func (r *mutationResolver) DenyExternalIntent(ctx context.Context, intent model.ExternalIntentKey, reason *string) (*model.ExternalIntent, error) {
+	return r.setExternalIntentStatus(ctx, intent, sqlstore.ExternalIntentStatusDenied, reason)
 }
 
 // ServiceIntents is the resolver for the serviceIntents field.
@@ -242,28 +240,171 @@ func (r *queryResolver) ExternalIntents(ctx context.Context) ([]model.ExternalIn
 		return []model.ExternalIntent{}, nil
 	}
 
-	records, err := r.dbClient.GetExternalIntents(ctx)
+	page, err := r.dbClient.GetExternalIntents(ctx, sqlstore.ExternalIntentsFilter{}, 0, "")
 	if err != nil {
 		logrus.WithError(err).Error("Failed to get external intents from database")
 		return []model.ExternalIntent{}, errors.Wrap(err)
 	}
 
-	// Convert database records to GraphQL model
-	intents := make([]model.ExternalIntent, 0, len(records))
-	for _, record := range records {
-		intent := model.ExternalIntent{
-			Client: &model.ExternalClient{
-				Name:      record.ClientName,
-				Namespace: record.ClientNamespace,
-				Kind:      record.ClientKind,
-			},
-			DNSName:  record.DNSName,
-			LastSeen: record.LastSeen.Format("2006-01-02T15:04:05Z07:00"), // RFC3339 format
+	return lo.Map(page.Intents, func(record sqlstore.ExternalIntentRecord, _ int) model.ExternalIntent {
+		return externalIntentRecordToModel(record)
+	}), nil
+}
+
+// ExternalIntentsPage is the resolver for the externalIntentsPage field.
+func (r *queryResolver) ExternalIntentsPage(ctx context.Context, filter *model.ExternalIntentsFilter, first *int64, after *string) (*model.ExternalIntentsPage, error) {
+	if r.dbClient == nil {
+		logrus.Warning("Database client not initialized, returning empty external intents")
+		return &model.ExternalIntentsPage{Intents: []model.ExternalIntent{}}, nil
+	}
+
+	pageSize := lo.FromPtrOr(first, defaultExternalIntentsPageSize)
+	if pageSize <= 0 || pageSize > maxExternalIntentsPageSize {
+		return nil, errors.Errorf("page size must be between 1 and %d, got %d", maxExternalIntentsPageSize, pageSize)
+	}
+
+	page, err := r.dbClient.GetExternalIntents(ctx, externalIntentsFilterFromModel(filter), int(pageSize), lo.FromPtr(after))
+	if err != nil {
+		logrus.WithError(err).Error("Failed to get external intents from database")
+		return nil, errors.Wrap(err)
+	}
+
+	return &model.ExternalIntentsPage{
+		Intents: lo.Map(page.Intents, func(record sqlstore.ExternalIntentRecord, _ int) model.ExternalIntent {
+			return externalIntentRecordToModel(record)
+		}),
+		NextCursor: lo.EmptyableToPtr(page.NextCursor),
+	}, nil
+}
+
+// ExternalClientIntents is the resolver for the externalClientIntents field.
+func (r *queryResolver) ExternalClientIntents(ctx context.Context, namespace string, clientName *string, approvedOnly *bool) ([]model.ClientIntentsManifest, error) {
+	clientIntents, err := r.clientIntentsGenerator.Generate(ctx, clientintentsgenerator.Filter{
+		Namespace:    namespace,
+		ClientName:   lo.FromPtr(clientName),
+		ApprovedOnly: lo.FromPtr(approvedOnly),
+	})
+	if err != nil {
+		return nil, errors.Wrap(err)
+	}
+
+	manifests := make([]model.ClientIntentsManifest, 0, len(clientIntents))
+	for _, ci := range clientIntents {
+		manifest, err := clientIntentsToModel(ci)
+		if err != nil {
+			return nil, errors.Wrap(err)
 		}
-		intents = append(intents, intent)
+		manifests = append(manifests, manifest)
 	}
+	return manifests, nil
+}
 
-	return intents, nil
+// IntentHistory is the resolver for the intentHistory field.
+func (r *queryResolver) IntentHistory(ctx context.Context, client *model.NamespacedName, server *model.IntentHistoryServer, kinds []model.IntentHistoryKind, from *time.Time, to *time.Time) ([]model.IntentHistory, error) {
+	if r.dbClient == nil {
+		logrus.Warning("Database client not initialized, returning empty intent history")
+		return []model.IntentHistory{}, nil
+	}
+
+	history, err := r.dbClient.GetIntentHistory(ctx, intentHistoryFilterFromModel(client, server, kinds, from, to))
+	if err != nil {
+		logrus.WithError(err).Error("Failed to get intent history from database")
+		return nil, errors.Wrap(err)
+	}
+
+	return lo.Map(history, func(record sqlstore.IntentHistoryRecord, _ int) model.IntentHistory {
+		return intentHistoryRecordToModel(record)
+	}), nil
+}
+
+// WorkloadAddresses is the resolver for the workloadAddresses field.
+func (r *queryResolver) WorkloadAddresses(ctx context.Context) ([]model.WorkloadAddresses, error) {
+	workloadAddresses, err := r.kubeFinder.ListWorkloadAddresses(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err)
+	}
+	return workloadAddresses, nil
+}
+
+// FederatedIntents is the resolver for the federatedIntents field.
+func (r *queryResolver) FederatedIntents(ctx context.Context, clusters []string, namespaces []string) ([]model.Intent, error) {
+	snapshots, err := r.federatedSnapshots(ctx, clusters)
+	if err != nil {
+		return nil, errors.Wrap(err)
+	}
+	intents := federation.MergeIntents(snapshots)
+	if len(namespaces) == 0 {
+		return intents, nil
+	}
+	return lo.Filter(intents, func(intent model.Intent, _ int) bool {
+		return lo.Contains(namespaces, intent.Client.Namespace) || lo.Contains(namespaces, intent.Server.Namespace)
+	}), nil
+}
+
+// FederatedExternalIntents is the resolver for the federatedExternalIntents field.
+func (r *queryResolver) FederatedExternalIntents(ctx context.Context, clusters []string) ([]model.ExternalIntent, error) {
+	snapshots, err := r.federatedSnapshots(ctx, clusters)
+	if err != nil {
+		return nil, errors.Wrap(err)
+	}
+	return federation.MergeExternalIntents(snapshots), nil
+}
+
+// FederatedClusters is the resolver for the federatedClusters field.
+func (r *queryResolver) FederatedClusters(ctx context.Context) ([]model.FederatedCluster, error) {
+	return r.federation.Clusters(), nil
+}
+
+// StaleIntents is the resolver for the staleIntents field.
+func (r *queryResolver) StaleIntents(ctx context.Context, days *int64, namespaces []string) ([]model.StaleIntent, error) {
+	if r.staleIntentsReporter == nil {
+		return nil, errors.Wrap(staleintents.ErrNotEnabled)
+	}
+
+	staleIntents, err := r.staleIntentsReporter.Report(ctx, int(lo.FromPtrOr(days, int64(viper.GetInt(config.StaleIntentsDaysKey)))), namespaces)
+	if err != nil {
+		logrus.WithError(err).Error("Failed to report stale intents")
+		return nil, errors.Wrap(err)
+	}
+	return staleIntents, nil
+}
+
+// PolicyDrift is the resolver for the policyDrift field.
+func (r *queryResolver) PolicyDrift(ctx context.Context, namespaces []string) ([]model.PolicyDrift, error) {
+	if r.policyDriftDetector == nil {
+		return nil, errors.Wrap(policydrift.ErrNotEnabled)
+	}
+
+	drift, err := r.policyDriftDetector.Drift(ctx, namespaces)
+	if err != nil {
+		logrus.WithError(err).Error("Failed to detect policy drift")
+		return nil, errors.Wrap(err)
+	}
+	return drift, nil
+}
+
+// DeniedIntents is the resolver for the deniedIntents field.
+func (r *queryResolver) DeniedIntents(ctx context.Context, namespaces []string, defaultDenyIngressNamespaces []string, defaultDenyEgressNamespaces []string) ([]model.DeniedIntent, error) {
+	simulation, err := r.networkPolicySimulator.Simulate(ctx, networkpolicysimulator.Filter{
+		Namespaces:                   namespaces,
+		DefaultDenyIngressNamespaces: defaultDenyIngressNamespaces,
+		DefaultDenyEgressNamespaces:  defaultDenyEgressNamespaces,
+	})
+	if err != nil {
+		logrus.WithError(err).Error("Failed to simulate network policies")
+		return nil, errors.Wrap(err)
+	}
+	return simulation.DeniedIntents, nil
+}
+
+// UnevaluatedIntents is the resolver for the unevaluatedIntents field.
+func (r *queryResolver) UnevaluatedIntents(ctx context.Context, namespaces []string) ([]model.UnevaluatedIntent, error) {
+	simulation, err := r.networkPolicySimulator.Simulate(ctx, networkpolicysimulator.Filter{Namespaces: namespaces})
+	if err != nil {
+		logrus.WithError(err).Error("Failed to simulate network policies")
+		return nil, errors.Wrap(err)
+	}
+	return simulation.UnevaluatedIntents, nil
 }
 
 // Mutation returns generated.MutationResolver implementation.
@@ -1,44 +1,47 @@
-package mysqlstore
+package sqlstore
 
 import (
 	"github.com/otterize/network-mapper/src/mapper/pkg/config"
 	"github.com/spf13/viper"
+	"time"
 )
 
 type Config struct {
 	ClientIgnoreListByName      string
 	ClientIgnoreListByNamespace string
-	Cluster                     string
+	DbDriver                    string
+	DbSQLitePath                string
 	DbHost                      string
 	DbUsername                  string
 	DbPassword                  string
 	DbPort                      string
 	DbDatabase                  string
-	GhaDispatchEnabled          bool
-	GhaToken                    string
-	GhaUrl                      string
-	GhaOwner                    string
-	GhaRepo                     string
-	GhaEventType                string
 	RetentionDays               int
+	IntentsRetentionDays        int
+	HistoryRetentionDays        int
+	NotificationOutboxInterval  time.Duration
+	NotificationMaxAttempts     int
+	NotificationInitialBackoff  time.Duration
+	NotificationMaxBackoff      time.Duration
 }
 
 func ConfigFromViper() Config {
 	return Config{
 		ClientIgnoreListByName:      viper.GetString(config.ClientIgnoreListByNameKey),
 		ClientIgnoreListByNamespace: viper.GetString(config.ClientIgnoreListByNamespaceKey),
-		Cluster:                     viper.GetString(config.ClusterKey),
+		DbDriver:                    viper.GetString(config.DbDriverKey),
+		DbSQLitePath:                viper.GetString(config.DbSQLitePathKey),
 		DbHost:                      viper.GetString(config.DbHostKey),
 		DbUsername:                  viper.GetString(config.DbUsernameKey),
 		DbPassword:                  viper.GetString(config.DbPasswordKey),
 		DbPort:                      viper.GetString(config.DbPortKey),
 		DbDatabase:                  viper.GetString(config.DbDatabaseKey),
-		GhaDispatchEnabled:          viper.GetBool(config.GhaDispatchEnabledKey),
-		GhaToken:                    viper.GetString(config.GhaTokenKey),
-		GhaUrl:                      viper.GetString(config.GhaUrlKey),
-		GhaOwner:                    viper.GetString(config.GhaOwnerKey),
-		GhaRepo:                     viper.GetString(config.GhaRepoKey),
-		GhaEventType:                viper.GetString(config.GhaEventTypeKey),
 		RetentionDays:               viper.GetInt(config.ExternalIntentsRetentionDaysKey),
+		IntentsRetentionDays:        viper.GetInt(config.IntentsRetentionDaysKey),
+		HistoryRetentionDays:        viper.GetInt(config.IntentHistoryRetentionDaysKey),
+		NotificationOutboxInterval:  viper.GetDuration(config.NotificationOutboxIntervalKey),
+		NotificationMaxAttempts:     viper.GetInt(config.NotificationMaxAttemptsKey),
+		NotificationInitialBackoff:  viper.GetDuration(config.NotificationInitialBackoffKey),
+		NotificationMaxBackoff:      viper.GetDuration(config.NotificationMaxBackoffKey),
 	}
 }
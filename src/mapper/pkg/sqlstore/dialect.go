@@ -0,0 +1,235 @@
+package sqlstore
+
+import (
+	"fmt"
+	"github.com/otterize/intents-operator/src/shared/errors"
+	"net/url"
+	"strconv"
+	"strings"
+
+	_ "github.com/go-sql-driver/mysql"
+	_ "github.com/jackc/pgx/v5/stdlib"
+	_ "modernc.org/sqlite"
+)
+
+const (
+	DriverMySQL    = "mysql"
+	DriverPostgres = "postgres"
+	DriverSQLite   = "sqlite"
+)
+
+// dialect holds everything that differs between the supported SQL backends. Queries in this package are written
+// with '?' placeholders and portable SQL, and are passed through rebind before being executed.
+type dialect interface {
+	driverName() string
+	dsn(config Config) string
+	// migrationsDir is the directory under migrations/ holding this dialect's schema migrations.
+	migrationsDir() string
+	upsertInternalIntentQuery() string
+	upsertExternalIntentIPQuery() string
+	upsertIntentActivityQuery() string
+	upsertIntentEdgeQuery() string
+	rebind(query string) string
+}
+
+func dialectForDriver(driver string) (dialect, error) {
+	switch strings.ToLower(driver) {
+	case DriverMySQL, "":
+		return mysqlDialect{}, nil
+	case DriverPostgres, "postgresql":
+		return postgresDialect{}, nil
+	case DriverSQLite, "sqlite3":
+		return sqliteDialect{}, nil
+	default:
+		return nil, errors.Errorf("unsupported db driver %q, expected one of %s, %s, %s", driver, DriverMySQL, DriverPostgres, DriverSQLite)
+	}
+}
+
+type mysqlDialect struct{}
+
+func (mysqlDialect) driverName() string {
+	return "mysql"
+}
+
+// dsn enables clientFoundRows, so updates report the rows they matched rather than only those whose values changed, as
+// the other backends do.
+func (mysqlDialect) dsn(config Config) string {
+	return fmt.Sprintf("%s:%s@tcp(%s:%s)/%s?parseTime=true&clientFoundRows=true", config.DbUsername, config.DbPassword, config.DbHost, config.DbPort, config.DbDatabase)
+}
+
+func (mysqlDialect) migrationsDir() string {
+	return "mysql"
+}
+
+func (mysqlDialect) upsertInternalIntentQuery() string {
+	return `
+            INSERT INTO internal_intents (client_name, client_namespace, server_name, server_namespace, intent_type, protocol, intent, last_seen)
+            VALUES (?, ?, ?, ?, ?, ?, ?, ?)
+            ON DUPLICATE KEY UPDATE intent = VALUES(intent), last_seen = VALUES(last_seen)
+        `
+}
+
+func (mysqlDialect) upsertExternalIntentIPQuery() string {
+	return `
+            INSERT INTO external_traffic_intent_ips (intent_id, ip, port, protocol, first_seen, last_seen)
+            VALUES (?, ?, ?, ?, ?, ?)
+            ON DUPLICATE KEY UPDATE first_seen = LEAST(first_seen, VALUES(first_seen)), last_seen = GREATEST(last_seen, VALUES(last_seen))
+        `
+}
+
+func (mysqlDialect) upsertIntentActivityQuery() string {
+	return `
+            INSERT INTO intent_activity (edge_hash, kind, client_name, client_namespace, server_name, server_namespace, activity_date, seen_count, first_seen, last_seen)
+            VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+            ON DUPLICATE KEY UPDATE seen_count = seen_count + VALUES(seen_count),
+                                    first_seen = LEAST(first_seen, VALUES(first_seen)), last_seen = GREATEST(last_seen, VALUES(last_seen))
+        `
+}
+
+func (mysqlDialect) upsertIntentEdgeQuery() string {
+	return `
+            INSERT INTO intent_edges (edge_hash, kind, client_name, client_namespace, server_name, server_namespace, first_seen, last_seen)
+            VALUES (?, ?, ?, ?, ?, ?, ?, ?)
+            ON DUPLICATE KEY UPDATE first_seen = LEAST(first_seen, VALUES(first_seen)), last_seen = GREATEST(last_seen, VALUES(last_seen))
+        `
+}
+
+func (mysqlDialect) rebind(query string) string {
+	return query
+}
+
+type postgresDialect struct{}
+
+func (postgresDialect) driverName() string {
+	return "pgx"
+}
+
+func (postgresDialect) dsn(config Config) string {
+	dsn := url.URL{
+		Scheme: "postgres",
+		User:   url.UserPassword(config.DbUsername, config.DbPassword),
+		Host:   fmt.Sprintf("%s:%s", config.DbHost, config.DbPort),
+		Path:   config.DbDatabase,
+	}
+	return dsn.String()
+}
+
+func (postgresDialect) migrationsDir() string {
+	return "postgres"
+}
+
+func (postgresDialect) upsertInternalIntentQuery() string {
+	return `
+            INSERT INTO internal_intents (client_name, client_namespace, server_name, server_namespace, intent_type, protocol, intent, last_seen)
+            VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+            ON CONFLICT (client_name, client_namespace, server_name, server_namespace, intent_type, protocol)
+            DO UPDATE SET intent = excluded.intent, last_seen = excluded.last_seen
+        `
+}
+
+func (postgresDialect) upsertExternalIntentIPQuery() string {
+	return `
+            INSERT INTO external_traffic_intent_ips (intent_id, ip, port, protocol, first_seen, last_seen)
+            VALUES ($1, $2, $3, $4, $5, $6)
+            ON CONFLICT (intent_id, ip, port, protocol)
+            DO UPDATE SET first_seen = LEAST(external_traffic_intent_ips.first_seen, excluded.first_seen),
+                          last_seen = GREATEST(external_traffic_intent_ips.last_seen, excluded.last_seen)
+        `
+}
+
+func (postgresDialect) upsertIntentActivityQuery() string {
+	return `
+            INSERT INTO intent_activity (edge_hash, kind, client_name, client_namespace, server_name, server_namespace, activity_date, seen_count, first_seen, last_seen)
+            VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+            ON CONFLICT (edge_hash, activity_date)
+            DO UPDATE SET seen_count = intent_activity.seen_count + excluded.seen_count,
+                          first_seen = LEAST(intent_activity.first_seen, excluded.first_seen),
+                          last_seen = GREATEST(intent_activity.last_seen, excluded.last_seen)
+        `
+}
+
+func (postgresDialect) upsertIntentEdgeQuery() string {
+	return `
+            INSERT INTO intent_edges (edge_hash, kind, client_name, client_namespace, server_name, server_namespace, first_seen, last_seen)
+            VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+            ON CONFLICT (edge_hash)
+            DO UPDATE SET first_seen = LEAST(intent_edges.first_seen, excluded.first_seen),
+                          last_seen = GREATEST(intent_edges.last_seen, excluded.last_seen)
+        `
+}
+
+// rebind replaces '?' placeholders with the positional '$n' placeholders PostgreSQL expects.
+func (postgresDialect) rebind(query string) string {
+	var builder strings.Builder
+	argIndex := 0
+	for _, char := range query {
+		if char != '?' {
+			builder.WriteRune(char)
+			continue
+		}
+		argIndex++
+		builder.WriteString("$" + strconv.Itoa(argIndex))
+	}
+	return builder.String()
+}
+
+type sqliteDialect struct{}
+
+func (sqliteDialect) driverName() string {
+	return "sqlite"
+}
+
+func (sqliteDialect) dsn(config Config) string {
+	if config.DbSQLitePath == ":memory:" {
+		return config.DbSQLitePath
+	}
+	return fmt.Sprintf("file:%s?_pragma=busy_timeout(5000)&_pragma=journal_mode(WAL)", config.DbSQLitePath)
+}
+
+func (sqliteDialect) migrationsDir() string {
+	return "sqlite"
+}
+
+func (sqliteDialect) upsertInternalIntentQuery() string {
+	return `
+            INSERT INTO internal_intents (client_name, client_namespace, server_name, server_namespace, intent_type, protocol, intent, last_seen)
+            VALUES (?, ?, ?, ?, ?, ?, ?, ?)
+            ON CONFLICT (client_name, client_namespace, server_name, server_namespace, intent_type, protocol)
+            DO UPDATE SET intent = excluded.intent, last_seen = excluded.last_seen
+        `
+}
+
+func (sqliteDialect) upsertExternalIntentIPQuery() string {
+	return `
+            INSERT INTO external_traffic_intent_ips (intent_id, ip, port, protocol, first_seen, last_seen)
+            VALUES (?, ?, ?, ?, ?, ?)
+            ON CONFLICT (intent_id, ip, port, protocol)
+            DO UPDATE SET first_seen = MIN(external_traffic_intent_ips.first_seen, excluded.first_seen),
+                          last_seen = MAX(external_traffic_intent_ips.last_seen, excluded.last_seen)
+        `
+}
+
+func (sqliteDialect) upsertIntentActivityQuery() string {
+	return `
+            INSERT INTO intent_activity (edge_hash, kind, client_name, client_namespace, server_name, server_namespace, activity_date, seen_count, first_seen, last_seen)
+            VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+            ON CONFLICT (edge_hash, activity_date)
+            DO UPDATE SET seen_count = intent_activity.seen_count + excluded.seen_count,
+                          first_seen = MIN(intent_activity.first_seen, excluded.first_seen),
+                          last_seen = MAX(intent_activity.last_seen, excluded.last_seen)
+        `
+}
+
+func (sqliteDialect) upsertIntentEdgeQuery() string {
+	return `
+            INSERT INTO intent_edges (edge_hash, kind, client_name, client_namespace, server_name, server_namespace, first_seen, last_seen)
+            VALUES (?, ?, ?, ?, ?, ?, ?, ?)
+            ON CONFLICT (edge_hash)
+            DO UPDATE SET first_seen = MIN(intent_edges.first_seen, excluded.first_seen),
+                          last_seen = MAX(intent_edges.last_seen, excluded.last_seen)
+        `
+}
+
+func (sqliteDialect) rebind(query string) string {
+	return query
+}
@@ -0,0 +1,447 @@
+package sqlstore
+
+import (
+	"context"
+	"fmt"
+	"github.com/otterize/intents-operator/src/shared/errors"
+	"github.com/otterize/network-mapper/src/mapper/pkg/externaltrafficholder"
+	"github.com/otterize/network-mapper/src/mapper/pkg/notifier"
+	"github.com/samber/lo"
+	"github.com/sirupsen/logrus"
+	"net"
+	"strings"
+	"time"
+)
+
+func (s *SQLIntentStore) LoadCacheFromDb() error {
+	today := time.Now().Format("2006-01-02")
+	yesterday := time.Now().AddDate(0, 0, -1).Format("2006-01-02")
+	rows, err := s.Db.Query(s.dialect.rebind(`
+            SELECT client_name, client_namespace, client_kind, dns_name, last_seen
+              FROM external_traffic_intents
+             WHERE last_seen >= ?
+               AND last_seen <= ?
+        `), today, yesterday)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var clientName, clientNamespace, clientKind, dnsName string
+		var lastSeen time.Time
+
+		if err := rows.Scan(&clientName, &clientNamespace, &clientKind, &dnsName, &lastSeen); err != nil {
+			return err
+		}
+
+		date := lastSeen.Format("2006-01-02")
+		if _, ok := s.localIntentCacheMap[date]; !ok {
+			s.localIntentCacheMap[date] = make(map[string]struct{})
+		}
+
+		cacheKey := fmt.Sprintf("%s|%s|%s|%s", clientName, clientNamespace, clientKind, dnsName)
+		s.localIntentCacheMap[date][cacheKey] = struct{}{}
+	}
+
+	return rows.Err()
+}
+
+func (s *SQLIntentStore) LogExternalTrafficIntentsCallback(ctx context.Context, intents []externaltrafficholder.TimestampedExternalTrafficIntent) {
+
+	today := time.Now().Format("2006-01-02")
+	yesterday := time.Now().AddDate(0, 0, -1).Format("2006-01-02")
+	dayBeforeYesterday := time.Now().AddDate(0, 0, -2).Format("2006-01-02")
+
+	delete(s.localIntentCacheMap, dayBeforeYesterday)
+	delete(s.localIPCacheMap, yesterday)
+
+	if _, ok := s.localIntentCacheMap[today]; !ok {
+		s.localIntentCacheMap[today] = make(map[string]struct{})
+	}
+	if _, ok := s.localIPCacheMap[today]; !ok {
+		s.localIPCacheMap[today] = make(map[string]struct{})
+	}
+
+	ignoreClients := strings.Split(s.config.ClientIgnoreListByName, ",")
+	ignoreClientSet := make(map[string]struct{}, len(ignoreClients))
+	for _, item := range ignoreClients {
+		ignoreClientSet[strings.TrimSpace(item)] = struct{}{}
+	}
+
+	ignoreNamespaces := strings.Split(s.config.ClientIgnoreListByNamespace, ",")
+	ignoreNamespaceSet := make(map[string]struct{}, len(ignoreNamespaces))
+	for _, item := range ignoreNamespaces {
+		if trimmed := strings.TrimSpace(item); trimmed != "" {
+			ignoreNamespaceSet[trimmed] = struct{}{}
+		}
+	}
+
+	for _, ti := range intents {
+		if HasExternalIP(ti.Intent.IPs) {
+			_, clientNameIgnored := ignoreClientSet[ti.Intent.Client.Name]
+			_, namespaceIgnored := ignoreNamespaceSet[ti.Intent.Client.Namespace]
+			if !clientNameIgnored && !namespaceIgnored {
+				exists := s.storeIntent(ctx, ti, today, yesterday)
+				s.storeIntentIPs(ctx, ti, today)
+				if !exists {
+					printLog(ti, "info", "Received new intent")
+				}
+				printLog(ti, "debug", "Received external traffic intent")
+			}
+		}
+	}
+}
+
+// HasExternalIP reports whether any of the IPs is outside the private and loopback ranges, i.e. whether the traffic
+// actually left the cluster.
+func HasExternalIP(ips map[externaltrafficholder.IP]struct{}) bool {
+	privateCIDRs := []string{
+		"127.0.0.1/8",
+		"10.0.0.0/8",
+		"172.16.0.0/12",
+		"192.168.0.0/16",
+		"100.64.0.0/10",
+		"::1/128",
+		"fc00::/7",
+		"fe80::/10",
+	}
+
+	var privateNets []*net.IPNet
+	for _, cidr := range privateCIDRs {
+		_, block, err := net.ParseCIDR(cidr)
+		if err != nil {
+			logrus.WithError(err).Errorf("Warning: invalid CIDR %s, skipping", cidr)
+			continue
+		}
+		privateNets = append(privateNets, block)
+	}
+
+	for ipRaw := range ips {
+		ipStr := string(ipRaw)
+		ip := net.ParseIP(ipStr)
+		if ip == nil {
+			continue
+		}
+
+		isPrivate := false
+		for _, privateNet := range privateNets {
+			if privateNet.Contains(ip) {
+				isPrivate = true
+				break
+			}
+		}
+
+		if !isPrivate {
+			return true // Found an external IP
+		}
+	}
+
+	return false
+}
+
+func printLog(ti externaltrafficholder.TimestampedExternalTrafficIntent, logLevel, logMessage string) {
+	clientKind := ""
+	if ti.Intent.Client.PodOwnerKind != nil && ti.Intent.Client.PodOwnerKind.Kind != "" {
+		clientKind = ti.Intent.Client.PodOwnerKind.Kind
+	}
+	entry := logrus.WithFields(logrus.Fields{
+		"timestamp":        ti.Timestamp.Format("2006-01-02"),
+		"client_name":      ti.Intent.Client.Name,
+		"client_namespace": ti.Intent.Client.Namespace,
+		"client_kind":      clientKind,
+		"DnsName":          ti.Intent.DNSName,
+	})
+
+	switch strings.ToLower(logLevel) {
+	case "trace":
+		entry.Trace(logMessage)
+	case "info":
+		entry.Info(logMessage)
+	case "warn":
+		entry.Warn(logMessage)
+	case "error":
+		entry.Error(logMessage)
+	case "fatal":
+		entry.Fatal(logMessage)
+	case "panic":
+		entry.Panic(logMessage)
+	default:
+		entry.Debug(logMessage)
+	}
+}
+
+func (s *SQLIntentStore) checkIfExists(ctx context.Context, clientName, clientNamespace, clientKind, dnsName, yesterday string) (found bool) {
+	cacheKey := fmt.Sprintf("%s|%s|%s", clientName, clientNamespace, dnsName)
+
+	if _, existedYesterday := s.localIntentCacheMap[yesterday][cacheKey]; existedYesterday {
+		return true
+	}
+	var exists bool
+	err := s.Db.QueryRowContext(ctx, s.dialect.rebind(`
+		    SELECT EXISTS(
+		      SELECT 1 FROM external_traffic_intents
+		       WHERE client_name = ? AND client_namespace = ? AND client_kind = ? AND dns_name = ?
+		    )
+	      `), clientName, clientNamespace, clientKind, dnsName).Scan(&exists)
+	if err != nil {
+		logrus.WithError(err).Error("failed to query db for cache")
+		return false
+	}
+
+	return exists
+}
+
+func (s *SQLIntentStore) storeIntent(ctx context.Context, ti externaltrafficholder.TimestampedExternalTrafficIntent, today, yesterday string) (found bool) {
+	insertSql := `
+            INSERT INTO external_traffic_intents (client_name, client_namespace, client_kind, dns_name, last_seen)
+            VALUES (?, ?, ?, ?, ?)
+        `
+
+	intent := ti.Intent
+	intentDate := ti.Timestamp.Format("2006-01-02")
+
+	clientKind := ""
+	if intent.Client.PodOwnerKind != nil && intent.Client.PodOwnerKind.Kind != "" {
+		clientKind = intent.Client.PodOwnerKind.Kind
+	}
+	cacheKey := fmt.Sprintf("%s|%s|%s|%s", intent.Client.Name, intent.Client.Namespace, clientKind, intent.DNSName)
+
+	if _, exists := s.localIntentCacheMap[today][cacheKey]; exists {
+		logrus.Debug("cache hit: today, skipping insert")
+		return exists
+	}
+
+	intentExists := s.checkIfExists(ctx, intent.Client.Name, intent.Client.Namespace, clientKind, intent.DNSName, yesterday)
+	if intentExists {
+		if err := s.updateIntentLastSeen(ctx, ti, clientKind, intentDate); err != nil {
+			logrus.WithError(err).Error("failed to update intent")
+			return intentExists
+		}
+		s.localIntentCacheMap[today][cacheKey] = struct{}{}
+		return intentExists
+	}
+
+	// The intent is stored along with its notifications, so a notification is never lost once the intent is known.
+	tx, err := s.Db.BeginTx(ctx, nil)
+	if err != nil {
+		logrus.WithError(err).Error("failed to begin transaction")
+		return intentExists
+	}
+	defer func() {
+		// Rollback after a successful commit is a no-op
+		_ = tx.Rollback()
+	}()
+
+	_, err = tx.ExecContext(ctx, s.dialect.rebind(insertSql),
+		intent.Client.Name,
+		intent.Client.Namespace,
+		clientKind,
+		intent.DNSName,
+		intentDate,
+	)
+	if err != nil {
+		logrus.WithError(err).Error("failed to insert intent")
+		return intentExists
+	}
+	err = s.enqueueNotifications(ctx, tx, notifier.EventNewIntent, notifierIntent(intent, clientKind))
+	if err != nil {
+		logrus.WithError(err).Error("failed to queue new intent notifications")
+		return intentExists
+	}
+	if err := tx.Commit(); err != nil {
+		logrus.WithError(err).Error("failed to insert intent")
+		return intentExists
+	}
+	s.localIntentCacheMap[today][cacheKey] = struct{}{}
+	s.signalNotificationOutbox()
+	return intentExists
+}
+
+// updateIntentLastSeen extends the last seen date of an existing intent. Intents that were denied are queued for the
+// notifiers again, so a denied destination that keeps being used is alerted on, once per day it is seen.
+func (s *SQLIntentStore) updateIntentLastSeen(ctx context.Context, ti externaltrafficholder.TimestampedExternalTrafficIntent, clientKind string, intentDate string) error {
+	intent := ti.Intent
+	tx, err := s.Db.BeginTx(ctx, nil)
+	if err != nil {
+		return errors.Wrap(err)
+	}
+	defer func() {
+		// Rollback after a successful commit is a no-op
+		_ = tx.Rollback()
+	}()
+
+	_, err = tx.ExecContext(ctx, s.dialect.rebind(`
+            UPDATE external_traffic_intents
+               SET last_seen = ?
+             WHERE client_name = ? AND client_namespace = ? AND client_kind = ? AND dns_name = ?
+        `), intentDate, intent.Client.Name, intent.Client.Namespace, clientKind, intent.DNSName)
+	if err != nil {
+		return errors.Wrap(err)
+	}
+
+	var status string
+	err = tx.QueryRowContext(ctx, s.dialect.rebind(`
+            SELECT status FROM external_traffic_intents
+             WHERE client_name = ? AND client_namespace = ? AND client_kind = ? AND dns_name = ?
+        `), intent.Client.Name, intent.Client.Namespace, clientKind, intent.DNSName).Scan(&status)
+	if err != nil {
+		return errors.Wrap(err)
+	}
+	isDenied := status == ExternalIntentStatusDenied
+	if isDenied {
+		printLog(ti, "warn", "Denied external traffic intent seen again")
+		if err := s.enqueueNotifications(ctx, tx, notifier.EventDeniedIntentSeen, notifierIntent(intent, clientKind)); err != nil {
+			return errors.Wrap(err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return errors.Wrap(err)
+	}
+	if isDenied {
+		s.signalNotificationOutbox()
+	}
+	return nil
+}
+
+func notifierIntent(intent externaltrafficholder.ExternalTrafficIntent, clientKind string) notifier.ExternalIntent {
+	return notifier.ExternalIntent{
+		ClientName:      intent.Client.Name,
+		ClientNamespace: intent.Client.Namespace,
+		ClientKind:      clientKind,
+		DNSName:         intent.DNSName,
+	}
+}
+
+// storeIntentIPs records the IPs and ports the intent's DNS name resolved to. IPs without a known port are stored with
+// port 0 and no protocol. Each IP and port is written at most once per day it was seen, since it is only tracked at day
+// granularity.
+func (s *SQLIntentStore) storeIntentIPs(ctx context.Context, ti externaltrafficholder.TimestampedExternalTrafficIntent, today string) {
+	intent := ti.Intent
+	intentDate := ti.Timestamp.Format("2006-01-02")
+
+	clientKind := ""
+	if intent.Client.PodOwnerKind != nil && intent.Client.PodOwnerKind.Kind != "" {
+		clientKind = intent.Client.PodOwnerKind.Kind
+	}
+	cacheKeyPrefix := fmt.Sprintf("%s|%s|%s|%s", intent.Client.Name, intent.Client.Namespace, clientKind, intent.DNSName)
+
+	newCacheKeys := make(map[string]intentIPPort)
+	for ip := range intent.IPs {
+		ports := lo.Keys(intent.Ports[ip])
+		if len(ports) == 0 {
+			ports = []externaltrafficholder.Port{{}}
+		}
+		for _, port := range ports {
+			cacheKey := fmt.Sprintf("%s|%s|%d|%s|%s", cacheKeyPrefix, ip, port.Number, port.Protocol, intentDate)
+			if _, exists := s.localIPCacheMap[today][cacheKey]; !exists {
+				newCacheKeys[cacheKey] = intentIPPort{ip: string(ip), port: port.Number, protocol: string(port.Protocol)}
+			}
+		}
+	}
+	if len(newCacheKeys) == 0 {
+		return
+	}
+
+	var intentID int64
+	err := s.Db.QueryRowContext(ctx, s.dialect.rebind(`
+            SELECT id FROM external_traffic_intents
+             WHERE client_name = ? AND client_namespace = ? AND client_kind = ? AND dns_name = ?
+        `), intent.Client.Name, intent.Client.Namespace, clientKind, intent.DNSName).Scan(&intentID)
+	if err != nil {
+		logrus.WithError(err).Error("failed to query intent id")
+		return
+	}
+
+	for cacheKey, ipPort := range newCacheKeys {
+		_, err := s.Db.ExecContext(ctx, s.dialect.rebind(s.dialect.upsertExternalIntentIPQuery()), intentID, ipPort.ip, ipPort.port, ipPort.protocol, intentDate, intentDate)
+		if err != nil {
+			logrus.WithError(err).WithFields(logrus.Fields{"ip": ipPort.ip, "port": ipPort.port, "protocol": ipPort.protocol}).Error("failed to store intent ip")
+			continue
+		}
+		s.localIPCacheMap[today][cacheKey] = struct{}{}
+	}
+}
+
+type intentIPPort struct {
+	ip       string
+	port     int
+	protocol string
+}
+
+// ExternalIntentRecord represents a record from the database
+type ExternalIntentRecord struct {
+	ClientName      string
+	ClientNamespace string
+	ClientKind      string
+	DNSName         string
+	LastSeen        time.Time
+	Addresses       []ExternalIntentAddressRecord
+	// Status is the intent's approval state, one of the ExternalIntentStatus constants.
+	Status          string
+	StatusReason    string
+	StatusUpdatedAt *time.Time
+}
+
+// ExternalIntentAddressRecord is an IP and port an external intent's DNS name resolved to. Port is 0 and Protocol is
+// empty when only the DNS resolution was seen.
+type ExternalIntentAddressRecord struct {
+	IP        string
+	Port      int
+	Protocol  string
+	FirstSeen time.Time
+	LastSeen  time.Time
+}
+
+// CleanupExpiredIntents removes intents older than the configured retention period
+func (s *SQLIntentStore) CleanupExpiredIntents(ctx context.Context) error {
+	if err := s.cleanupExpiredInternalIntents(ctx); err != nil {
+		logrus.WithError(err).Error("failed to cleanup expired internal intents")
+		return err
+	}
+	if err := s.cleanupExpiredIntentActivity(ctx); err != nil {
+		logrus.WithError(err).Error("failed to cleanup expired intent activity")
+		return err
+	}
+
+	if s.config.RetentionDays <= 0 {
+		logrus.Debug("Retention cleanup skipped: retention days not configured or invalid")
+		return nil
+	}
+
+	cutoffDate := time.Now().AddDate(0, 0, -s.config.RetentionDays)
+	query := `
+		DELETE FROM external_traffic_intents
+		WHERE last_seen < ?
+	`
+
+	result, err := s.Db.ExecContext(ctx, s.dialect.rebind(query), cutoffDate.Format("2006-01-02"))
+	if err != nil {
+		logrus.WithError(err).Error("failed to cleanup expired intents")
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		logrus.WithError(err).Warn("failed to get rows affected count")
+	} else if rowsAffected > 0 {
+		logrus.WithFields(logrus.Fields{
+			"rows_deleted":   rowsAffected,
+			"retention_days": s.config.RetentionDays,
+			"cutoff_date":    cutoffDate.Format("2006-01-02"),
+		}).Info("Cleaned up expired external traffic intents")
+	}
+
+	// Rows of deleted intents are removed explicitly as well, since SQLite does not enforce the foreign key by default.
+	_, err = s.Db.ExecContext(ctx, s.dialect.rebind(`
+		DELETE FROM external_traffic_intent_ips
+		WHERE last_seen < ? OR intent_id NOT IN (SELECT id FROM external_traffic_intents)
+	`), cutoffDate.Format("2006-01-02"))
+	if err != nil {
+		logrus.WithError(err).Error("failed to cleanup expired intent ips")
+		return err
+	}
+
+	return nil
+}
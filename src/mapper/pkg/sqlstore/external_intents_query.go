@@ -0,0 +1,257 @@
+package sqlstore
+
+import (
+	"context"
+	"database/sql"
+	"encoding/base64"
+	"fmt"
+	"github.com/otterize/intents-operator/src/shared/errors"
+	"github.com/samber/lo"
+	"github.com/sirupsen/logrus"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var ErrInvalidCursor = errors.NewSentinelError("invalid pagination cursor")
+
+// ExternalIntentsFilter narrows down the external intents returned by GetExternalIntents. Empty fields match everything.
+type ExternalIntentsFilter struct {
+	Namespaces  []string
+	ClientNames []string
+	ClientKinds []string
+	// Statuses are ExternalIntentStatus constants.
+	Statuses []string
+	// DNSName matches the DNS name exactly, or all of its subdomains if it is a wildcard such as '*.example.com'.
+	DNSName string
+	// LastSeenFrom and LastSeenTo bound the last seen date, inclusive. Only the date part is compared.
+	LastSeenFrom *time.Time
+	LastSeenTo   *time.Time
+}
+
+// ExternalIntentsPage is a page of external intents, ordered by last seen, most recent first.
+type ExternalIntentsPage struct {
+	Intents []ExternalIntentRecord
+	// NextCursor is passed to GetExternalIntents to fetch the following page. Empty if this is the last page.
+	NextCursor string
+}
+
+type externalIntentsCursor struct {
+	lastSeen string
+	id       int64
+}
+
+func (c externalIntentsCursor) encode() string {
+	return base64.RawURLEncoding.EncodeToString([]byte(fmt.Sprintf("%s|%d", c.lastSeen, c.id)))
+}
+
+func decodeExternalIntentsCursor(cursor string) (externalIntentsCursor, error) {
+	decoded, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return externalIntentsCursor{}, errors.Wrap(ErrInvalidCursor)
+	}
+	lastSeen, idStr, found := strings.Cut(string(decoded), "|")
+	if !found {
+		return externalIntentsCursor{}, errors.Wrap(ErrInvalidCursor)
+	}
+	if _, err := time.Parse("2006-01-02", lastSeen); err != nil {
+		return externalIntentsCursor{}, errors.Wrap(ErrInvalidCursor)
+	}
+	id, err := strconv.ParseInt(idStr, 10, 64)
+	if err != nil {
+		return externalIntentsCursor{}, errors.Wrap(ErrInvalidCursor)
+	}
+	return externalIntentsCursor{lastSeen: lastSeen, id: id}, nil
+}
+
+// escapeLike escapes the LIKE wildcards in value, to be used with "ESCAPE '!'". A '!' is used rather than a backslash
+// since backslashes are string escapes in MySQL but not in PostgreSQL.
+func escapeLike(value string) string {
+	return strings.NewReplacer("!", "!!", "%", "!%", "_", "!_").Replace(value)
+}
+
+func placeholders(count int) string {
+	return strings.TrimSuffix(strings.Repeat("?, ", count), ", ")
+}
+
+func (f ExternalIntentsFilter) where() (string, []any) {
+	conditions := make([]string, 0)
+	args := make([]any, 0)
+
+	addInCondition := func(column string, values []string) {
+		if len(values) == 0 {
+			return
+		}
+		conditions = append(conditions, fmt.Sprintf("%s IN (%s)", column, placeholders(len(values))))
+		for _, value := range values {
+			args = append(args, value)
+		}
+	}
+	addInCondition("client_namespace", f.Namespaces)
+	addInCondition("client_name", f.ClientNames)
+	addInCondition("client_kind", f.ClientKinds)
+	addInCondition("status", f.Statuses)
+
+	if suffix, isWildcard := strings.CutPrefix(f.DNSName, "*"); isWildcard {
+		conditions = append(conditions, "dns_name LIKE ? ESCAPE '!'")
+		args = append(args, "%"+escapeLike(suffix))
+	} else if f.DNSName != "" {
+		conditions = append(conditions, "dns_name = ?")
+		args = append(args, f.DNSName)
+	}
+
+	if f.LastSeenFrom != nil {
+		conditions = append(conditions, "last_seen >= ?")
+		args = append(args, f.LastSeenFrom.Format("2006-01-02"))
+	}
+	if f.LastSeenTo != nil {
+		conditions = append(conditions, "last_seen <= ?")
+		args = append(args, f.LastSeenTo.Format("2006-01-02"))
+	}
+
+	if len(conditions) == 0 {
+		return "", args
+	}
+	return "WHERE " + strings.Join(conditions, " AND "), args
+}
+
+// GetExternalIntents retrieves a page of external traffic intents matching the filter from the database. Pages are
+// keyed by (last_seen, id) rather than by offset, so they stay stable while new intents are being stored. A limit of 0
+// returns all matching intents.
+func (s *SQLIntentStore) GetExternalIntents(ctx context.Context, filter ExternalIntentsFilter, limit int, cursor string) (ExternalIntentsPage, error) {
+	// Cleanup expired intents before querying
+	if err := s.CleanupExpiredIntents(ctx); err != nil {
+		logrus.WithError(err).Warn("failed to cleanup expired intents, continuing with query")
+	}
+
+	return s.queryExternalIntents(ctx, filter, limit, cursor)
+}
+
+func (s *SQLIntentStore) queryExternalIntents(ctx context.Context, filter ExternalIntentsFilter, limit int, cursor string) (ExternalIntentsPage, error) {
+	where, args := filter.where()
+	if cursor != "" {
+		after, err := decodeExternalIntentsCursor(cursor)
+		if err != nil {
+			return ExternalIntentsPage{}, errors.Wrap(err)
+		}
+		where = lo.Ternary(where == "", "WHERE ", where+" AND ")
+		where += "(last_seen < ? OR (last_seen = ? AND id < ?))"
+		args = append(args, after.lastSeen, after.lastSeen, after.id)
+	}
+
+	query := fmt.Sprintf(`
+		SELECT id, client_name, client_namespace, client_kind, dns_name, last_seen, status, COALESCE(status_reason, ''), status_updated_at
+		FROM external_traffic_intents
+		%s
+		ORDER BY last_seen DESC, id DESC
+	`, where)
+	if limit > 0 {
+		// Fetch one extra row to know whether there is a next page.
+		query += "LIMIT ?"
+		args = append(args, limit+1)
+	}
+
+	rows, err := s.Db.QueryContext(ctx, s.dialect.rebind(query), args...)
+	if err != nil {
+		logrus.WithError(err).Error("failed to query external intents")
+		return ExternalIntentsPage{}, err
+	}
+	defer rows.Close()
+
+	ids := make([]int64, 0)
+	intents := make([]ExternalIntentRecord, 0)
+	for rows.Next() {
+		var id int64
+		var record ExternalIntentRecord
+		var statusUpdatedAt sql.NullTime
+		if err := rows.Scan(
+			&id,
+			&record.ClientName,
+			&record.ClientNamespace,
+			&record.ClientKind,
+			&record.DNSName,
+			&record.LastSeen,
+			&record.Status,
+			&record.StatusReason,
+			&statusUpdatedAt,
+		); err != nil {
+			logrus.WithError(err).Error("failed to scan external intent row")
+			continue
+		}
+		if statusUpdatedAt.Valid {
+			record.StatusUpdatedAt = &statusUpdatedAt.Time
+		}
+		ids = append(ids, id)
+		intents = append(intents, record)
+	}
+
+	if err := rows.Err(); err != nil {
+		logrus.WithError(err).Error("error iterating external intent rows")
+		return ExternalIntentsPage{}, err
+	}
+
+	page := ExternalIntentsPage{Intents: intents}
+	if limit > 0 && len(intents) > limit {
+		page.Intents = intents[:limit]
+		ids = ids[:limit]
+		last := page.Intents[limit-1]
+		page.NextCursor = externalIntentsCursor{lastSeen: last.LastSeen.Format("2006-01-02"), id: ids[limit-1]}.encode()
+	}
+
+	addresses, err := s.getExternalIntentAddresses(ctx, ids)
+	if err != nil {
+		return ExternalIntentsPage{}, err
+	}
+	for i := range page.Intents {
+		page.Intents[i].Addresses = addresses[ids[i]]
+	}
+
+	return page, nil
+}
+
+// addressesQueryBatchSize bounds the number of intent ids per addresses query, to stay well below the databases'
+// limits on the number of query parameters.
+const addressesQueryBatchSize = 500
+
+// getExternalIntentAddresses returns the stored IPs and ports of the given external intents, keyed by intent id.
+func (s *SQLIntentStore) getExternalIntentAddresses(ctx context.Context, intentIDs []int64) (map[int64][]ExternalIntentAddressRecord, error) {
+	addresses := make(map[int64][]ExternalIntentAddressRecord)
+	for _, batch := range lo.Chunk(intentIDs, addressesQueryBatchSize) {
+		if err := s.getExternalIntentAddressesBatch(ctx, batch, addresses); err != nil {
+			return nil, err
+		}
+	}
+	return addresses, nil
+}
+
+func (s *SQLIntentStore) getExternalIntentAddressesBatch(ctx context.Context, intentIDs []int64, addresses map[int64][]ExternalIntentAddressRecord) error {
+	args := lo.Map(intentIDs, func(id int64, _ int) any { return id })
+	rows, err := s.Db.QueryContext(ctx, s.dialect.rebind(fmt.Sprintf(`
+		SELECT intent_id, ip, port, protocol, first_seen, last_seen
+		FROM external_traffic_intent_ips
+		WHERE intent_id IN (%s)
+		ORDER BY ip, port, protocol
+	`, placeholders(len(intentIDs)))), args...)
+	if err != nil {
+		logrus.WithError(err).Error("failed to query external intent ips")
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var intentID int64
+		var address ExternalIntentAddressRecord
+		if err := rows.Scan(&intentID, &address.IP, &address.Port, &address.Protocol, &address.FirstSeen, &address.LastSeen); err != nil {
+			logrus.WithError(err).Error("failed to scan external intent ip row")
+			continue
+		}
+		addresses[intentID] = append(addresses[intentID], address)
+	}
+
+	if err := rows.Err(); err != nil {
+		logrus.WithError(err).Error("error iterating external intent ip rows")
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,86 @@
+package sqlstore
+
+import (
+	"context"
+	"database/sql"
+	"github.com/otterize/intents-operator/src/shared/errors"
+	"github.com/samber/lo"
+	"github.com/sirupsen/logrus"
+)
+
+// External intents are pending until they are approved or denied, so the mapper can serve as the system of record for
+// which egress destinations were reviewed.
+const (
+	ExternalIntentStatusPending  = "pending"
+	ExternalIntentStatusApproved = "approved"
+	ExternalIntentStatusDenied   = "denied"
+)
+
+var ErrExternalIntentNotFound = errors.NewSentinelError("external intent not found")
+
+// ExternalIntentKey identifies a single external intent.
+type ExternalIntentKey struct {
+	ClientName      string
+	ClientNamespace string
+	ClientKind      string
+	DNSName         string
+}
+
+// SetExternalIntentStatus sets the approval status of an existing external intent, along with the reason it was
+// given, and returns the updated intent.
+func (s *SQLIntentStore) SetExternalIntentStatus(ctx context.Context, key ExternalIntentKey, status string, reason string) (ExternalIntentRecord, error) {
+	if !lo.Contains([]string{ExternalIntentStatusPending, ExternalIntentStatusApproved, ExternalIntentStatusDenied}, status) {
+		return ExternalIntentRecord{}, errors.Errorf("unknown external intent status %q", status)
+	}
+
+	result, err := s.Db.ExecContext(ctx, s.dialect.rebind(`
+		UPDATE external_traffic_intents
+		SET status = ?, status_reason = ?, status_updated_at = ?
+		WHERE client_name = ? AND client_namespace = ? AND client_kind = ? AND dns_name = ?
+	`), status, lo.EmptyableToPtr(reason), s.now().UTC(), key.ClientName, key.ClientNamespace, key.ClientKind, key.DNSName)
+	if err != nil {
+		return ExternalIntentRecord{}, errors.Wrap(err)
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return ExternalIntentRecord{}, errors.Wrap(err)
+	}
+	if rowsAffected == 0 {
+		return ExternalIntentRecord{}, errors.Errorf("%w: %s/%s (%s) -> %s", ErrExternalIntentNotFound, key.ClientNamespace, key.ClientName, key.ClientKind, key.DNSName)
+	}
+
+	// The intent is read back by its exact DNS name, since the external intents filter treats a leading '*' as a
+	// wildcard, which would match other intents for wildcard DNS names.
+	var record ExternalIntentRecord
+	var statusUpdatedAt sql.NullTime
+	err = s.Db.QueryRowContext(ctx, s.dialect.rebind(`
+		SELECT client_name, client_namespace, client_kind, dns_name, last_seen, status, COALESCE(status_reason, ''), status_updated_at
+		FROM external_traffic_intents
+		WHERE client_name = ? AND client_namespace = ? AND client_kind = ? AND dns_name = ?
+	`), key.ClientName, key.ClientNamespace, key.ClientKind, key.DNSName).Scan(
+		&record.ClientName,
+		&record.ClientNamespace,
+		&record.ClientKind,
+		&record.DNSName,
+		&record.LastSeen,
+		&record.Status,
+		&record.StatusReason,
+		&statusUpdatedAt,
+	)
+	if err != nil {
+		return ExternalIntentRecord{}, errors.Wrap(err)
+	}
+	if statusUpdatedAt.Valid {
+		record.StatusUpdatedAt = &statusUpdatedAt.Time
+	}
+
+	logrus.WithFields(logrus.Fields{
+		"client_name":      key.ClientName,
+		"client_namespace": key.ClientNamespace,
+		"client_kind":      key.ClientKind,
+		"DnsName":          key.DNSName,
+		"status":           status,
+		"reason":           reason,
+	}).Info("Updated external intent status")
+	return record, nil
+}
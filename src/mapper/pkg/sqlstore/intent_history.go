@@ -0,0 +1,356 @@
+package sqlstore
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"github.com/otterize/intents-operator/src/shared/errors"
+	"github.com/otterize/network-mapper/src/mapper/pkg/awsintentsholder"
+	"github.com/otterize/network-mapper/src/mapper/pkg/externaltrafficholder"
+	"github.com/otterize/network-mapper/src/mapper/pkg/gcpintentsholder"
+	"github.com/otterize/network-mapper/src/mapper/pkg/graph/model"
+	"github.com/otterize/network-mapper/src/mapper/pkg/incomingtrafficholder"
+	"github.com/otterize/network-mapper/src/mapper/pkg/intentsstore"
+	"github.com/samber/lo"
+	"github.com/sirupsen/logrus"
+	"strings"
+	"time"
+)
+
+// IntentKind is the kind of edge an intent's activity is recorded for.
+type IntentKind string
+
+const (
+	IntentKindInternal IntentKind = "internal"
+	IntentKindExternal IntentKind = "external"
+	IntentKindIncoming IntentKind = "incoming"
+	IntentKindAWS      IntentKind = "aws"
+	IntentKindGCP      IntentKind = "gcp"
+	IntentKindAzure    IntentKind = "azure"
+)
+
+// intentEdge identifies an edge whose activity is recorded. Depending on the kind, the server is a workload, a DNS
+// name, an AWS ARN, a GCP resource or an Azure scope, and the client of incoming traffic is its source IP. Names with
+// no namespace have an empty one.
+type intentEdge struct {
+	kind            IntentKind
+	clientName      string
+	clientNamespace string
+	serverName      string
+	serverNamespace string
+}
+
+func (e intentEdge) hash() string {
+	sum := sha256.Sum256([]byte(strings.Join([]string{string(e.kind), e.clientNamespace, e.clientName, e.serverNamespace, e.serverName}, "\x00")))
+	return hex.EncodeToString(sum[:])
+}
+
+type intentActivity struct {
+	edge   intentEdge
+	seenAt time.Time
+}
+
+// LogIntentsHistoryCallback records the activity of in-cluster intents.
+func (s *SQLIntentStore) LogIntentsHistoryCallback(ctx context.Context, intents []intentsstore.TimestampedIntent) {
+	s.recordIntentActivity(ctx, lo.Map(intents, func(intent intentsstore.TimestampedIntent, _ int) intentActivity {
+		return intentActivity{
+			edge: intentEdge{
+				kind:            IntentKindInternal,
+				clientName:      intent.Intent.Client.Name,
+				clientNamespace: intent.Intent.Client.Namespace,
+				serverName:      intent.Intent.Server.Name,
+				serverNamespace: intent.Intent.Server.Namespace,
+			},
+			seenAt: intent.Timestamp,
+		}
+	}))
+}
+
+// LogExternalTrafficIntentsHistoryCallback records the activity of intents to DNS names outside the cluster.
+func (s *SQLIntentStore) LogExternalTrafficIntentsHistoryCallback(ctx context.Context, intents []externaltrafficholder.TimestampedExternalTrafficIntent) {
+	s.recordIntentActivity(ctx, lo.Map(intents, func(intent externaltrafficholder.TimestampedExternalTrafficIntent, _ int) intentActivity {
+		return intentActivity{
+			edge: intentEdge{
+				kind:            IntentKindExternal,
+				clientName:      intent.Intent.Client.Name,
+				clientNamespace: intent.Intent.Client.Namespace,
+				serverName:      intent.Intent.DNSName,
+			},
+			seenAt: intent.Timestamp,
+		}
+	}))
+}
+
+// LogIncomingTrafficIntentsHistoryCallback records the activity of traffic from outside the cluster, by source IP.
+func (s *SQLIntentStore) LogIncomingTrafficIntentsHistoryCallback(ctx context.Context, intents []incomingtrafficholder.TimestampedIncomingTrafficIntent) {
+	s.recordIntentActivity(ctx, lo.Map(intents, func(intent incomingtrafficholder.TimestampedIncomingTrafficIntent, _ int) intentActivity {
+		return intentActivity{
+			edge: intentEdge{
+				kind:            IntentKindIncoming,
+				clientName:      intent.Intent.IP,
+				serverName:      intent.Intent.Server.Name,
+				serverNamespace: intent.Intent.Server.Namespace,
+			},
+			seenAt: intent.Timestamp,
+		}
+	}))
+}
+
+// LogAWSIntentsHistoryCallback records the activity of intents to AWS resources.
+func (s *SQLIntentStore) LogAWSIntentsHistoryCallback(ctx context.Context, intents []awsintentsholder.AWSIntent) {
+	now := s.now()
+	s.recordIntentActivity(ctx, lo.Map(intents, func(intent awsintentsholder.AWSIntent, _ int) intentActivity {
+		return intentActivity{
+			edge: intentEdge{
+				kind:            IntentKindAWS,
+				clientName:      intent.Client.Name,
+				clientNamespace: intent.Client.Namespace,
+				serverName:      intent.ARN,
+			},
+			seenAt: now,
+		}
+	}))
+}
+
+// LogGCPIntentsHistoryCallback records the activity of intents to GCP resources.
+func (s *SQLIntentStore) LogGCPIntentsHistoryCallback(ctx context.Context, intents []gcpintentsholder.GCPIntent) {
+	now := s.now()
+	s.recordIntentActivity(ctx, lo.Map(intents, func(intent gcpintentsholder.GCPIntent, _ int) intentActivity {
+		return intentActivity{
+			edge: intentEdge{
+				kind:            IntentKindGCP,
+				clientName:      intent.Client.Name,
+				clientNamespace: intent.Client.Namespace,
+				serverName:      intent.Resource,
+			},
+			seenAt: now,
+		}
+	}))
+}
+
+// LogAzureOperationsHistoryCallback records the activity of intents to Azure scopes.
+func (s *SQLIntentStore) LogAzureOperationsHistoryCallback(ctx context.Context, operations []model.AzureOperation) {
+	now := s.now()
+	s.recordIntentActivity(ctx, lo.Map(operations, func(operation model.AzureOperation, _ int) intentActivity {
+		return intentActivity{
+			edge: intentEdge{
+				kind:            IntentKindAzure,
+				clientName:      operation.ClientName,
+				clientNamespace: operation.ClientNamespace,
+				serverName:      operation.Scope,
+			},
+			seenAt: now,
+		}
+	}))
+}
+
+func (s *SQLIntentStore) recordIntentActivity(ctx context.Context, activities []intentActivity) {
+	if len(activities) == 0 {
+		return
+	}
+
+	if err := s.storeIntentActivity(ctx, activities); err != nil {
+		logrus.WithError(err).Error("failed to record intent activity")
+		return
+	}
+	logrus.WithField("count", len(activities)).Debug("Recorded intent activity")
+}
+
+func (s *SQLIntentStore) storeIntentActivity(ctx context.Context, activities []intentActivity) error {
+	tx, err := s.Db.BeginTx(ctx, nil)
+	if err != nil {
+		return errors.Wrap(err)
+	}
+	defer func() {
+		// Rollback after a successful commit is a no-op
+		_ = tx.Rollback()
+	}()
+
+	for _, activity := range activities {
+		seenAt := activity.seenAt.UTC()
+		if seenAt.IsZero() {
+			seenAt = s.now().UTC()
+		}
+		_, err := tx.ExecContext(ctx, s.dialect.upsertIntentActivityQuery(),
+			activity.edge.hash(),
+			string(activity.edge.kind),
+			activity.edge.clientName,
+			activity.edge.clientNamespace,
+			activity.edge.serverName,
+			activity.edge.serverNamespace,
+			seenAt.Format("2006-01-02"),
+			1,
+			seenAt,
+			seenAt,
+		)
+		if err != nil {
+			return errors.Wrap(err)
+		}
+		_, err = tx.ExecContext(ctx, s.dialect.upsertIntentEdgeQuery(),
+			activity.edge.hash(),
+			string(activity.edge.kind),
+			activity.edge.clientName,
+			activity.edge.clientNamespace,
+			activity.edge.serverName,
+			activity.edge.serverNamespace,
+			seenAt,
+			seenAt,
+		)
+		if err != nil {
+			return errors.Wrap(err)
+		}
+	}
+
+	return errors.Wrap(tx.Commit())
+}
+
+// IntentHistoryFilter selects the edges returned by GetIntentHistory. Empty fields match everything. From and To
+// bound the returned daily activity, and edges with no activity between them are left out.
+type IntentHistoryFilter struct {
+	Kinds           []IntentKind
+	ClientName      string
+	ClientNamespace string
+	ServerName      string
+	ServerNamespace *string
+	From            time.Time
+	To              time.Time
+}
+
+// where returns the conditions of the filter on the intent_edges table, aliased as e, and the intent_activity table,
+// aliased as a.
+func (f IntentHistoryFilter) where() (string, []any) {
+	conditions := make([]string, 0)
+	args := make([]any, 0)
+	if len(f.Kinds) > 0 {
+		conditions = append(conditions, fmt.Sprintf("e.kind IN (%s)", placeholders(len(f.Kinds))))
+		args = append(args, lo.ToAnySlice(lo.Map(f.Kinds, func(kind IntentKind, _ int) string { return string(kind) }))...)
+	}
+	if f.ClientName != "" {
+		conditions = append(conditions, "e.client_name = ?")
+		args = append(args, f.ClientName)
+	}
+	if f.ClientNamespace != "" {
+		conditions = append(conditions, "e.client_namespace = ?")
+		args = append(args, f.ClientNamespace)
+	}
+	if f.ServerName != "" {
+		conditions = append(conditions, "e.server_name = ?")
+		args = append(args, f.ServerName)
+	}
+	if f.ServerNamespace != nil {
+		conditions = append(conditions, "e.server_namespace = ?")
+		args = append(args, *f.ServerNamespace)
+	}
+
+	// Days are UTC dates, and a day is in range if any part of it is.
+	from, to := "0001-01-01", "9999-12-31"
+	if !f.From.IsZero() {
+		from = f.From.UTC().Format("2006-01-02")
+	}
+	if !f.To.IsZero() {
+		to = f.To.UTC().Format("2006-01-02")
+	}
+	conditions = append(conditions, "a.activity_date BETWEEN ? AND ?")
+	args = append(args, from, to)
+	return "WHERE " + strings.Join(conditions, " AND "), args
+}
+
+type IntentActivityDay struct {
+	Date  time.Time
+	Count int
+}
+
+// IntentHistoryRecord is the activity of an edge. FirstSeen and LastSeen cover all the recorded activity, regardless
+// of the filter's From and To, and of the history retention.
+type IntentHistoryRecord struct {
+	Kind            IntentKind
+	ClientName      string
+	ClientNamespace string
+	ServerName      string
+	ServerNamespace string
+	FirstSeen       time.Time
+	LastSeen        time.Time
+	Days            []IntentActivityDay
+}
+
+// GetIntentHistory returns the recorded activity of the edges matching filter, ordered by the time they were first
+// seen. Edges with no activity between the filter's From and To are left out.
+func (s *SQLIntentStore) GetIntentHistory(ctx context.Context, filter IntentHistoryFilter) ([]IntentHistoryRecord, error) {
+	where, args := filter.where()
+	rows, err := s.Db.QueryContext(ctx, s.dialect.rebind(fmt.Sprintf(`
+		SELECT e.edge_hash, e.kind, e.client_name, e.client_namespace, e.server_name, e.server_namespace, e.first_seen, e.last_seen, a.activity_date, a.seen_count
+		FROM intent_activity a
+		JOIN intent_edges e ON e.edge_hash = a.edge_hash
+		%s
+		ORDER BY e.first_seen, e.edge_hash, a.activity_date
+	`, where)), args...)
+	if err != nil {
+		return nil, errors.Wrap(err)
+	}
+	defer rows.Close()
+
+	records := make([]*IntentHistoryRecord, 0)
+	recordsByEdge := make(map[string]*IntentHistoryRecord)
+	for rows.Next() {
+		var edgeHash, kind string
+		var record IntentHistoryRecord
+		var day IntentActivityDay
+		if err := rows.Scan(
+			&edgeHash,
+			&kind,
+			&record.ClientName,
+			&record.ClientNamespace,
+			&record.ServerName,
+			&record.ServerNamespace,
+			&record.FirstSeen,
+			&record.LastSeen,
+			&day.Date,
+			&day.Count,
+		); err != nil {
+			return nil, errors.Wrap(err)
+		}
+		record.Kind = IntentKind(kind)
+
+		existing, found := recordsByEdge[edgeHash]
+		if !found {
+			existing = &record
+			recordsByEdge[edgeHash] = existing
+			records = append(records, existing)
+		}
+		existing.Days = append(existing.Days, day)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(err)
+	}
+
+	return lo.Map(records, func(record *IntentHistoryRecord, _ int) IntentHistoryRecord { return *record }), nil
+}
+
+func (s *SQLIntentStore) cleanupExpiredIntentActivity(ctx context.Context) error {
+	if s.config.HistoryRetentionDays <= 0 {
+		logrus.Debug("Intent history retention cleanup skipped: retention days not configured or invalid")
+		return nil
+	}
+
+	cutoffDate := time.Now().UTC().AddDate(0, 0, -s.config.HistoryRetentionDays)
+	result, err := s.Db.ExecContext(ctx, s.dialect.rebind(`
+		DELETE FROM intent_activity
+		WHERE activity_date < ?
+	`), cutoffDate.Format("2006-01-02"))
+	if err != nil {
+		return errors.Wrap(err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		logrus.WithError(err).Warn("failed to get rows affected count")
+	} else if rowsAffected > 0 {
+		logrus.WithFields(logrus.Fields{
+			"rows_deleted":   rowsAffected,
+			"retention_days": s.config.HistoryRetentionDays,
+			"cutoff_date":    cutoffDate.Format("2006-01-02"),
+		}).Info("Cleaned up expired intent activity")
+	}
+	return nil
+}
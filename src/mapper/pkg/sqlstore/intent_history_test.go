@@ -0,0 +1,113 @@
+package sqlstore
+
+import (
+	"context"
+	"github.com/otterize/network-mapper/src/mapper/pkg/awsintentsholder"
+	"github.com/otterize/network-mapper/src/mapper/pkg/externaltrafficholder"
+	"github.com/otterize/network-mapper/src/mapper/pkg/graph/model"
+	"github.com/otterize/network-mapper/src/mapper/pkg/intentsstore"
+	"github.com/samber/lo"
+	"time"
+)
+
+func internalIntent(clientName string, serverName string, timestamp time.Time) intentsstore.TimestampedIntent {
+	return intentsstore.TimestampedIntent{
+		Timestamp: timestamp,
+		Intent: model.Intent{
+			Client: &model.OtterizeServiceIdentity{Name: clientName, Namespace: "production"},
+			Server: &model.OtterizeServiceIdentity{Name: serverName, Namespace: "production"},
+		},
+	}
+}
+
+func (s *SQLIntentStoreSuite) TestIntentHistoryDailyActivity() {
+	ctx := context.Background()
+	day1 := time.Date(2026, 3, 1, 10, 0, 0, 0, time.UTC)
+	day2 := day1.AddDate(0, 0, 1)
+	day4 := day1.AddDate(0, 0, 3)
+
+	s.store.LogIntentsHistoryCallback(ctx, []intentsstore.TimestampedIntent{internalIntent("frontend", "backend", day1)})
+	s.store.LogIntentsHistoryCallback(ctx, []intentsstore.TimestampedIntent{internalIntent("frontend", "backend", day1.Add(time.Hour))})
+	s.store.LogIntentsHistoryCallback(ctx, []intentsstore.TimestampedIntent{
+		internalIntent("frontend", "backend", day2),
+		internalIntent("frontend", "cache", day2),
+	})
+	s.store.LogIntentsHistoryCallback(ctx, []intentsstore.TimestampedIntent{internalIntent("frontend", "backend", day4)})
+
+	history, err := s.store.GetIntentHistory(ctx, IntentHistoryFilter{ClientName: "frontend", ClientNamespace: "production", ServerName: "backend"})
+	s.Require().NoError(err)
+	s.Require().Len(history, 1)
+	s.Require().Equal(IntentKindInternal, history[0].Kind)
+	s.Require().True(day1.Equal(history[0].FirstSeen))
+	s.Require().True(day4.Equal(history[0].LastSeen))
+	s.Require().Equal([]string{"2026-03-01", "2026-03-02", "2026-03-04"}, lo.Map(history[0].Days, func(day IntentActivityDay, _ int) string {
+		return day.Date.Format("2006-01-02")
+	}))
+	s.Require().Equal([]int{2, 1, 1}, lo.Map(history[0].Days, func(day IntentActivityDay, _ int) int { return day.Count }))
+
+	// A range limits the days returned, but not the first and last seen times
+	history, err = s.store.GetIntentHistory(ctx, IntentHistoryFilter{ServerName: "backend", From: day2, To: day2})
+	s.Require().NoError(err)
+	s.Require().Len(history, 1)
+	s.Require().Len(history[0].Days, 1)
+	s.Require().True(day1.Equal(history[0].FirstSeen))
+
+	// Edges with no activity in the range are left out
+	history, err = s.store.GetIntentHistory(ctx, IntentHistoryFilter{From: day4})
+	s.Require().NoError(err)
+	s.Require().Len(history, 1)
+	s.Require().Equal("backend", history[0].ServerName)
+}
+
+func (s *SQLIntentStoreSuite) TestIntentHistoryKinds() {
+	ctx := context.Background()
+	now := time.Now()
+	s.store.LogIntentsHistoryCallback(ctx, []intentsstore.TimestampedIntent{internalIntent("frontend", "backend", now)})
+	s.store.LogExternalTrafficIntentsHistoryCallback(ctx, []externaltrafficholder.TimestampedExternalTrafficIntent{
+		externalTrafficIntent("frontend", "api.example.com", "8.8.8.8", now),
+	})
+	s.store.LogAWSIntentsHistoryCallback(ctx, []awsintentsholder.AWSIntent{{
+		Client: model.OtterizeServiceIdentity{Name: "frontend", Namespace: "production"},
+		ARN:    "arn:aws:s3:::bucket",
+	}})
+	s.store.LogAzureOperationsHistoryCallback(ctx, []model.AzureOperation{{
+		ClientName:      "frontend",
+		ClientNamespace: "production",
+		Scope:           "/subscriptions/sub/resourceGroups/rg",
+	}})
+
+	history, err := s.store.GetIntentHistory(ctx, IntentHistoryFilter{ClientName: "frontend"})
+	s.Require().NoError(err)
+	s.Require().ElementsMatch(
+		[]IntentKind{IntentKindInternal, IntentKindExternal, IntentKindAWS, IntentKindAzure},
+		lo.Map(history, func(record IntentHistoryRecord, _ int) IntentKind { return record.Kind }),
+	)
+
+	history, err = s.store.GetIntentHistory(ctx, IntentHistoryFilter{Kinds: []IntentKind{IntentKindExternal}})
+	s.Require().NoError(err)
+	s.Require().Len(history, 1)
+	s.Require().Equal("api.example.com", history[0].ServerName)
+	s.Require().Empty(history[0].ServerNamespace)
+
+	history, err = s.store.GetIntentHistory(ctx, IntentHistoryFilter{ServerNamespace: lo.ToPtr("")})
+	s.Require().NoError(err)
+	s.Require().Len(history, 3)
+}
+
+func (s *SQLIntentStoreSuite) TestExpiredIntentActivityIsCleanedUp() {
+	ctx := context.Background()
+	s.store.config.HistoryRetentionDays = 30
+	firstSeen := time.Now().AddDate(0, 0, -40).UTC().Truncate(time.Second)
+	s.store.LogIntentsHistoryCallback(ctx, []intentsstore.TimestampedIntent{
+		internalIntent("frontend", "backend", firstSeen),
+		internalIntent("frontend", "backend", time.Now()),
+	})
+
+	s.Require().NoError(s.store.CleanupExpiredIntents(ctx))
+	history, err := s.store.GetIntentHistory(ctx, IntentHistoryFilter{})
+	s.Require().NoError(err)
+	s.Require().Len(history, 1)
+	s.Require().Len(history[0].Days, 1)
+	// The edge is still reported as first seen before the retention cutoff
+	s.Require().True(firstSeen.Equal(history[0].FirstSeen))
+}
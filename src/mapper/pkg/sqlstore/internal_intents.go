@@ -0,0 +1,163 @@
+package sqlstore
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"github.com/otterize/intents-operator/src/shared/errors"
+	"github.com/otterize/network-mapper/src/mapper/pkg/graph/model"
+	"github.com/otterize/network-mapper/src/mapper/pkg/intentsstore"
+	"github.com/samber/lo"
+	"github.com/sirupsen/logrus"
+	"time"
+)
+
+// LogIntentsCallback persists intents reported to the IntentsHolder. Intents already stored for the same
+// client, server, type and protocol are merged with the new ones, so Kafka topics and HTTP resources accumulate across restarts.
+func (s *SQLIntentStore) LogIntentsCallback(ctx context.Context, intents []intentsstore.TimestampedIntent) {
+	if len(intents) == 0 {
+		return
+	}
+
+	if err := s.storeIntents(ctx, intents); err != nil {
+		logrus.WithError(err).Error("failed to persist intents")
+		return
+	}
+	logrus.WithField("count", len(intents)).Debug("Persisted intents")
+}
+
+func (s *SQLIntentStore) storeIntents(ctx context.Context, intents []intentsstore.TimestampedIntent) error {
+	tx, err := s.Db.BeginTx(ctx, nil)
+	if err != nil {
+		return errors.Wrap(err)
+	}
+	defer func() {
+		// Rollback after a successful commit is a no-op
+		_ = tx.Rollback()
+	}()
+
+	for _, intent := range intents {
+		if err := s.storeIntentInTx(ctx, tx, intent); err != nil {
+			return errors.Wrap(err)
+		}
+	}
+
+	return errors.Wrap(tx.Commit())
+}
+
+func (s *SQLIntentStore) storeIntentInTx(ctx context.Context, tx *sql.Tx, intent intentsstore.TimestampedIntent) error {
+	selectSql := `
+            SELECT intent, last_seen
+              FROM internal_intents
+             WHERE client_name = ? AND client_namespace = ? AND server_name = ? AND server_namespace = ? AND intent_type = ? AND protocol = ?
+        `
+
+	clientName, clientNamespace := intent.Intent.Client.Name, intent.Intent.Client.Namespace
+	serverName, serverNamespace := intent.Intent.Server.Name, intent.Intent.Server.Namespace
+	intentType := string(lo.FromPtr(intent.Intent.Type))
+	protocol := string(lo.FromPtr(intent.Intent.Protocol))
+
+	var existingIntentJSON string
+	var existingLastSeen time.Time
+	err := tx.QueryRowContext(ctx, s.dialect.rebind(selectSql), clientName, clientNamespace, serverName, serverNamespace, intentType, protocol).
+		Scan(&existingIntentJSON, &existingLastSeen)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return errors.Wrap(err)
+	}
+
+	if err == nil {
+		existingIntent := intentsstore.TimestampedIntent{Timestamp: existingLastSeen}
+		if err := json.Unmarshal([]byte(existingIntentJSON), &existingIntent.Intent); err != nil {
+			logrus.WithError(err).Warn("failed to unmarshal stored intent, overwriting it")
+		} else {
+			intent = intentsstore.MergeTimestampedIntents(existingIntent, intent)
+		}
+	}
+
+	intentJSON, err := json.Marshal(intent.Intent)
+	if err != nil {
+		return errors.Wrap(err)
+	}
+
+	_, err = tx.ExecContext(ctx, s.dialect.upsertInternalIntentQuery(),
+		clientName,
+		clientNamespace,
+		serverName,
+		serverNamespace,
+		intentType,
+		protocol,
+		string(intentJSON),
+		intent.Timestamp.UTC(),
+	)
+	return errors.Wrap(err)
+}
+
+// LoadIntents returns all persisted intents, to be loaded into the IntentsHolder on startup.
+func (s *SQLIntentStore) LoadIntents(ctx context.Context) ([]intentsstore.TimestampedIntent, error) {
+	rows, err := s.Db.QueryContext(ctx, `
+            SELECT intent, last_seen
+              FROM internal_intents
+        `)
+	if err != nil {
+		return nil, errors.Wrap(err)
+	}
+	defer rows.Close()
+
+	intents := make([]intentsstore.TimestampedIntent, 0)
+	for rows.Next() {
+		var intentJSON string
+		var lastSeen time.Time
+		if err := rows.Scan(&intentJSON, &lastSeen); err != nil {
+			return nil, errors.Wrap(err)
+		}
+
+		var intent model.Intent
+		if err := json.Unmarshal([]byte(intentJSON), &intent); err != nil {
+			logrus.WithError(err).Warn("failed to unmarshal stored intent, skipping")
+			continue
+		}
+		if intent.Client == nil || intent.Server == nil {
+			continue
+		}
+		intents = append(intents, intentsstore.TimestampedIntent{Timestamp: lastSeen, Intent: intent})
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(err)
+	}
+	return intents, nil
+}
+
+// ResetIntents removes all persisted intents, mirroring a reset of the IntentsHolder.
+func (s *SQLIntentStore) ResetIntents(ctx context.Context) error {
+	_, err := s.Db.ExecContext(ctx, `DELETE FROM internal_intents`)
+	return errors.Wrap(err)
+}
+
+func (s *SQLIntentStore) cleanupExpiredInternalIntents(ctx context.Context) error {
+	if s.config.IntentsRetentionDays <= 0 {
+		logrus.Debug("Intents retention cleanup skipped: retention days not configured or invalid")
+		return nil
+	}
+
+	cutoffDate := time.Now().UTC().AddDate(0, 0, -s.config.IntentsRetentionDays)
+	result, err := s.Db.ExecContext(ctx, s.dialect.rebind(`
+		DELETE FROM internal_intents
+		WHERE last_seen < ?
+	`), cutoffDate)
+	if err != nil {
+		return errors.Wrap(err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		logrus.WithError(err).Warn("failed to get rows affected count")
+	} else if rowsAffected > 0 {
+		logrus.WithFields(logrus.Fields{
+			"rows_deleted":   rowsAffected,
+			"retention_days": s.config.IntentsRetentionDays,
+			"cutoff_date":    cutoffDate.Format("2006-01-02"),
+		}).Info("Cleaned up expired intents")
+	}
+	return nil
+}
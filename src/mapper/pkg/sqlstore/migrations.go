@@ -0,0 +1,173 @@
+package sqlstore
+
+import (
+	"context"
+	"embed"
+	"github.com/otterize/intents-operator/src/shared/errors"
+	"github.com/samber/lo"
+	"github.com/sirupsen/logrus"
+	"io/fs"
+	"path"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Migrations are embedded per dialect, as migrations/<dialect>/<version>_<name>.up.sql. Versions must be unique and
+// increasing; a migration that was released must never be edited, add a new one instead.
+//
+// MySQL has no IF NOT EXISTS for indexes and columns, so MySQL migrations check information_schema and run such
+// statements through PREPARE only when they were not applied yet.
+//
+//go:embed migrations
+var migrationsFS embed.FS
+
+var migrationFileNameRegex = regexp.MustCompile(`^(\d+)_(\w+)\.up\.sql$`)
+
+var ErrSchemaVersionTooNew = errors.NewSentinelError("database schema is newer than the schema known to this network mapper version")
+
+type migration struct {
+	version    int
+	name       string
+	statements []string
+}
+
+func loadMigrations(dir string) ([]migration, error) {
+	entries, err := fs.ReadDir(migrationsFS, path.Join("migrations", dir))
+	if err != nil {
+		return nil, errors.Wrap(err)
+	}
+
+	migrations := make([]migration, 0, len(entries))
+	for _, entry := range entries {
+		match := migrationFileNameRegex.FindStringSubmatch(entry.Name())
+		if entry.IsDir() || match == nil {
+			return nil, errors.Errorf("unexpected file in migrations directory: %s", entry.Name())
+		}
+		version, err := strconv.Atoi(match[1])
+		if err != nil {
+			return nil, errors.Wrap(err)
+		}
+		content, err := fs.ReadFile(migrationsFS, path.Join("migrations", dir, entry.Name()))
+		if err != nil {
+			return nil, errors.Wrap(err)
+		}
+		migrations = append(migrations, migration{
+			version:    version,
+			name:       match[2],
+			statements: splitStatements(string(content)),
+		})
+	}
+
+	slices.SortFunc(migrations, func(a, b migration) int {
+		return a.version - b.version
+	})
+	for i := 1; i < len(migrations); i++ {
+		if migrations[i].version == migrations[i-1].version {
+			return nil, errors.Errorf("duplicate migration version %d", migrations[i].version)
+		}
+	}
+	return migrations, nil
+}
+
+// splitStatements splits a migration file into statements, since not all drivers accept multiple statements per Exec.
+// Statements are separated by a ';' at the end of a line, and '--' comment lines are dropped.
+func splitStatements(content string) []string {
+	lines := lo.Filter(strings.Split(content, "\n"), func(line string, _ int) bool {
+		return !strings.HasPrefix(strings.TrimSpace(line), "--")
+	})
+
+	statements := make([]string, 0)
+	for _, statement := range strings.Split(strings.Join(lines, "\n"), ";\n") {
+		statement = strings.TrimSuffix(strings.TrimSpace(statement), ";")
+		if statement != "" {
+			statements = append(statements, statement)
+		}
+	}
+	return statements
+}
+
+// migrate brings the database schema up to date, applying every embedded migration newer than the recorded schema
+// version in order. It refuses to run against a schema newer than the latest embedded migration, which means the
+// database was already migrated by a newer version of the network mapper.
+func (s *SQLIntentStore) migrate(ctx context.Context) error {
+	migrations, err := loadMigrations(s.dialect.migrationsDir())
+	if err != nil {
+		return errors.Wrap(err)
+	}
+
+	_, err = s.Db.ExecContext(ctx, `
+            CREATE TABLE IF NOT EXISTS schema_migrations (
+                version INTEGER NOT NULL PRIMARY KEY,
+                name VARCHAR(255) NOT NULL,
+                applied_at TIMESTAMP NOT NULL
+            )
+        `)
+	if err != nil {
+		return errors.Wrap(err)
+	}
+
+	currentVersion, err := s.SchemaVersion(ctx)
+	if err != nil {
+		return errors.Wrap(err)
+	}
+
+	latestVersion := 0
+	if len(migrations) > 0 {
+		latestVersion = migrations[len(migrations)-1].version
+	}
+	if currentVersion > latestVersion {
+		return errors.Errorf("%w: database is at version %d, latest known version is %d", ErrSchemaVersionTooNew, currentVersion, latestVersion)
+	}
+
+	for _, m := range migrations {
+		if m.version <= currentVersion {
+			continue
+		}
+		if err := s.applyMigration(ctx, m); err != nil {
+			return errors.Errorf("failed applying migration %d_%s: %w", m.version, m.name, err)
+		}
+		logrus.WithFields(logrus.Fields{"version": m.version, "name": m.name}).Info("Applied database migration")
+	}
+	return nil
+}
+
+func (s *SQLIntentStore) applyMigration(ctx context.Context, m migration) error {
+	// MySQL commits DDL statements implicitly, so the transaction only guarantees atomicity on PostgreSQL and SQLite.
+	// Migrations should therefore be safe to re-run if the mapper stops half-way through one.
+	tx, err := s.Db.BeginTx(ctx, nil)
+	if err != nil {
+		return errors.Wrap(err)
+	}
+	defer func() {
+		// Rollback after a successful commit is a no-op
+		_ = tx.Rollback()
+	}()
+
+	for _, statement := range m.statements {
+		if _, err := tx.ExecContext(ctx, statement); err != nil {
+			return errors.Wrap(err)
+		}
+	}
+
+	_, err = tx.ExecContext(ctx, s.dialect.rebind(`
+            INSERT INTO schema_migrations (version, name, applied_at)
+            VALUES (?, ?, ?)
+        `), m.version, m.name, time.Now().UTC())
+	if err != nil {
+		return errors.Wrap(err)
+	}
+	return errors.Wrap(tx.Commit())
+}
+
+// SchemaVersion returns the version of the latest migration applied to the database, or 0 if none was applied.
+func (s *SQLIntentStore) SchemaVersion(ctx context.Context) (int, error) {
+	var version int
+	err := s.Db.QueryRowContext(ctx, `SELECT COALESCE(MAX(version), 0) FROM schema_migrations`).Scan(&version)
+	if err != nil {
+		return 0, errors.Wrap(err)
+	}
+	return version, nil
+}
@@ -0,0 +1,11 @@
+CREATE TABLE IF NOT EXISTS internal_intents (
+    id BIGINT AUTO_INCREMENT PRIMARY KEY,
+    client_name VARCHAR(128) NOT NULL,
+    client_namespace VARCHAR(128) NOT NULL,
+    server_name VARCHAR(128) NOT NULL,
+    server_namespace VARCHAR(128) NOT NULL,
+    intent_type VARCHAR(32) NOT NULL,
+    intent MEDIUMTEXT NOT NULL,
+    last_seen DATETIME NOT NULL,
+    UNIQUE KEY uniq_intent (client_name, client_namespace, server_name, server_namespace, intent_type)
+);
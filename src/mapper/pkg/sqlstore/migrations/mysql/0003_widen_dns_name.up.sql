@@ -0,0 +1,2 @@
+-- DNS names may be up to 253 characters long.
+ALTER TABLE external_traffic_intents MODIFY dns_name VARCHAR(253) NOT NULL;
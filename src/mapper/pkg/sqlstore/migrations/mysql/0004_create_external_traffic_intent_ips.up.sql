@@ -0,0 +1,11 @@
+-- The IPs and ports behind an external traffic intent's DNS name. Port is 0 when only the DNS resolution was seen.
+CREATE TABLE IF NOT EXISTS external_traffic_intent_ips (
+    id BIGINT AUTO_INCREMENT PRIMARY KEY,
+    intent_id BIGINT NOT NULL,
+    ip VARCHAR(45) NOT NULL,
+    port INT NOT NULL,
+    first_seen DATE NOT NULL,
+    last_seen DATE NOT NULL,
+    UNIQUE KEY uniq_intent_ip (intent_id, ip, port),
+    CONSTRAINT fk_external_traffic_intent_ips_intent FOREIGN KEY (intent_id) REFERENCES external_traffic_intents (id) ON DELETE CASCADE
+);
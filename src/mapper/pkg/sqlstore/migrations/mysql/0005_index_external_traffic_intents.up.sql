@@ -0,0 +1,38 @@
+-- Indexes backing the externalIntents query filters and its (last_seen, id) pagination order.
+-- Filtering by client name is covered by the unique key, which starts with client_name.
+SET @statement = IF(
+    (SELECT COUNT(*) FROM information_schema.statistics
+     WHERE table_schema = DATABASE() AND table_name = 'external_traffic_intents' AND index_name = 'idx_external_traffic_intents_last_seen') = 0,
+    'CREATE INDEX idx_external_traffic_intents_last_seen ON external_traffic_intents (last_seen, id)',
+    'DO 0'
+);
+PREPARE statement FROM @statement;
+EXECUTE statement;
+DEALLOCATE PREPARE statement;
+SET @statement = IF(
+    (SELECT COUNT(*) FROM information_schema.statistics
+     WHERE table_schema = DATABASE() AND table_name = 'external_traffic_intents' AND index_name = 'idx_external_traffic_intents_namespace') = 0,
+    'CREATE INDEX idx_external_traffic_intents_namespace ON external_traffic_intents (client_namespace, last_seen)',
+    'DO 0'
+);
+PREPARE statement FROM @statement;
+EXECUTE statement;
+DEALLOCATE PREPARE statement;
+SET @statement = IF(
+    (SELECT COUNT(*) FROM information_schema.statistics
+     WHERE table_schema = DATABASE() AND table_name = 'external_traffic_intents' AND index_name = 'idx_external_traffic_intents_kind') = 0,
+    'CREATE INDEX idx_external_traffic_intents_kind ON external_traffic_intents (client_kind, last_seen)',
+    'DO 0'
+);
+PREPARE statement FROM @statement;
+EXECUTE statement;
+DEALLOCATE PREPARE statement;
+SET @statement = IF(
+    (SELECT COUNT(*) FROM information_schema.statistics
+     WHERE table_schema = DATABASE() AND table_name = 'external_traffic_intents' AND index_name = 'idx_external_traffic_intents_dns_name') = 0,
+    'CREATE INDEX idx_external_traffic_intents_dns_name ON external_traffic_intents (dns_name)',
+    'DO 0'
+);
+PREPARE statement FROM @statement;
+EXECUTE statement;
+DEALLOCATE PREPARE statement;
@@ -0,0 +1,21 @@
+-- Notifications of new external intents, queued in the same transaction the intent is stored in and deleted once
+-- delivered. next_attempt_at is a unix timestamp, so it compares the same way on every backend.
+CREATE TABLE IF NOT EXISTS notification_outbox (
+    id BIGINT AUTO_INCREMENT PRIMARY KEY,
+    notifier VARCHAR(128) NOT NULL,
+    payload TEXT NOT NULL,
+    status VARCHAR(16) NOT NULL,
+    attempts INT NOT NULL,
+    next_attempt_at BIGINT NOT NULL,
+    last_error TEXT,
+    created_at DATETIME NOT NULL
+);
+SET @statement = IF(
+    (SELECT COUNT(*) FROM information_schema.statistics
+     WHERE table_schema = DATABASE() AND table_name = 'notification_outbox' AND index_name = 'idx_notification_outbox_due') = 0,
+    'CREATE INDEX idx_notification_outbox_due ON notification_outbox (status, next_attempt_at)',
+    'DO 0'
+);
+PREPARE statement FROM @statement;
+EXECUTE statement;
+DEALLOCATE PREPARE statement;
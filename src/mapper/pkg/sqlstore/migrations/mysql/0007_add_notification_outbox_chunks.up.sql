@@ -0,0 +1,38 @@
+-- The chunk a queued notification was sent in, set on its first delivery attempt so a failed chunk is retried as is,
+-- with the same correlation ID, position and intents.
+SET @statement = IF(
+    (SELECT COUNT(*) FROM information_schema.columns
+     WHERE table_schema = DATABASE() AND table_name = 'notification_outbox' AND column_name = 'correlation_id') = 0,
+    'ALTER TABLE notification_outbox ADD COLUMN correlation_id VARCHAR(36)',
+    'DO 0'
+);
+PREPARE statement FROM @statement;
+EXECUTE statement;
+DEALLOCATE PREPARE statement;
+SET @statement = IF(
+    (SELECT COUNT(*) FROM information_schema.columns
+     WHERE table_schema = DATABASE() AND table_name = 'notification_outbox' AND column_name = 'chunk_index') = 0,
+    'ALTER TABLE notification_outbox ADD COLUMN chunk_index INT',
+    'DO 0'
+);
+PREPARE statement FROM @statement;
+EXECUTE statement;
+DEALLOCATE PREPARE statement;
+SET @statement = IF(
+    (SELECT COUNT(*) FROM information_schema.columns
+     WHERE table_schema = DATABASE() AND table_name = 'notification_outbox' AND column_name = 'chunk_count') = 0,
+    'ALTER TABLE notification_outbox ADD COLUMN chunk_count INT',
+    'DO 0'
+);
+PREPARE statement FROM @statement;
+EXECUTE statement;
+DEALLOCATE PREPARE statement;
+SET @statement = IF(
+    (SELECT COUNT(*) FROM information_schema.columns
+     WHERE table_schema = DATABASE() AND table_name = 'notification_outbox' AND column_name = 'chunk_namespace') = 0,
+    'ALTER TABLE notification_outbox ADD COLUMN chunk_namespace VARCHAR(253)',
+    'DO 0'
+);
+PREPARE statement FROM @statement;
+EXECUTE statement;
+DEALLOCATE PREPARE statement;
@@ -0,0 +1,47 @@
+-- Approval state of external intents. Intents are pending until they are approved or denied through the GraphQL API.
+SET @statement = IF(
+    (SELECT COUNT(*) FROM information_schema.columns
+     WHERE table_schema = DATABASE() AND table_name = 'external_traffic_intents' AND column_name = 'status') = 0,
+    'ALTER TABLE external_traffic_intents ADD COLUMN status VARCHAR(16) NOT NULL DEFAULT ''pending''',
+    'DO 0'
+);
+PREPARE statement FROM @statement;
+EXECUTE statement;
+DEALLOCATE PREPARE statement;
+SET @statement = IF(
+    (SELECT COUNT(*) FROM information_schema.columns
+     WHERE table_schema = DATABASE() AND table_name = 'external_traffic_intents' AND column_name = 'status_reason') = 0,
+    'ALTER TABLE external_traffic_intents ADD COLUMN status_reason TEXT',
+    'DO 0'
+);
+PREPARE statement FROM @statement;
+EXECUTE statement;
+DEALLOCATE PREPARE statement;
+SET @statement = IF(
+    (SELECT COUNT(*) FROM information_schema.columns
+     WHERE table_schema = DATABASE() AND table_name = 'external_traffic_intents' AND column_name = 'status_updated_at') = 0,
+    'ALTER TABLE external_traffic_intents ADD COLUMN status_updated_at DATETIME',
+    'DO 0'
+);
+PREPARE statement FROM @statement;
+EXECUTE statement;
+DEALLOCATE PREPARE statement;
+SET @statement = IF(
+    (SELECT COUNT(*) FROM information_schema.statistics
+     WHERE table_schema = DATABASE() AND table_name = 'external_traffic_intents' AND index_name = 'idx_external_traffic_intents_status') = 0,
+    'CREATE INDEX idx_external_traffic_intents_status ON external_traffic_intents (status, last_seen)',
+    'DO 0'
+);
+PREPARE statement FROM @statement;
+EXECUTE statement;
+DEALLOCATE PREPARE statement;
+-- The event a queued notification is sent for. Notifications queued until now were all for new intents.
+SET @statement = IF(
+    (SELECT COUNT(*) FROM information_schema.columns
+     WHERE table_schema = DATABASE() AND table_name = 'notification_outbox' AND column_name = 'event') = 0,
+    'ALTER TABLE notification_outbox ADD COLUMN event VARCHAR(32) NOT NULL DEFAULT ''new_intent''',
+    'DO 0'
+);
+PREPARE statement FROM @statement;
+EXECUTE statement;
+DEALLOCATE PREPARE statement;
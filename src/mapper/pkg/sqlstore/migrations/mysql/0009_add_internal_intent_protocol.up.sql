@@ -0,0 +1,19 @@
+-- The transport protocol of internal intents, empty for TCP. Intents seen on both TCP and UDP are stored separately.
+SET @statement = IF(
+    (SELECT COUNT(*) FROM information_schema.columns
+     WHERE table_schema = DATABASE() AND table_name = 'internal_intents' AND column_name = 'protocol') = 0,
+    'ALTER TABLE internal_intents ADD COLUMN protocol VARCHAR(8) NOT NULL DEFAULT ''''',
+    'DO 0'
+);
+PREPARE statement FROM @statement;
+EXECUTE statement;
+DEALLOCATE PREPARE statement;
+SET @statement = IF(
+    (SELECT COUNT(*) FROM information_schema.statistics
+     WHERE table_schema = DATABASE() AND table_name = 'internal_intents' AND index_name = 'uniq_intent' AND column_name = 'protocol') = 0,
+    'ALTER TABLE internal_intents DROP INDEX uniq_intent, ADD UNIQUE KEY uniq_intent (client_name, client_namespace, server_name, server_namespace, intent_type, protocol)',
+    'DO 0'
+);
+PREPARE statement FROM @statement;
+EXECUTE statement;
+DEALLOCATE PREPARE statement;
@@ -0,0 +1,44 @@
+-- Daily activity of every intent edge, for the intentHistory query. edge_hash identifies the edge, as server names
+-- such as AWS ARNs are too long to be part of the unique key. seen_count is the number of upload intervals the edge
+-- was seen in during the day.
+CREATE TABLE IF NOT EXISTS intent_activity (
+    id BIGINT AUTO_INCREMENT PRIMARY KEY,
+    edge_hash CHAR(64) NOT NULL,
+    kind VARCHAR(16) NOT NULL,
+    client_name VARCHAR(128) NOT NULL,
+    client_namespace VARCHAR(128) NOT NULL,
+    server_name VARCHAR(2048) NOT NULL,
+    server_namespace VARCHAR(128) NOT NULL,
+    activity_date DATE NOT NULL,
+    seen_count INT NOT NULL,
+    first_seen DATETIME NOT NULL,
+    last_seen DATETIME NOT NULL,
+    UNIQUE KEY uniq_intent_activity (edge_hash, activity_date)
+);
+SET @statement = IF(
+    (SELECT COUNT(*) FROM information_schema.statistics
+     WHERE table_schema = DATABASE() AND table_name = 'intent_activity' AND index_name = 'idx_intent_activity_client') = 0,
+    'CREATE INDEX idx_intent_activity_client ON intent_activity (client_namespace, client_name)',
+    'DO 0'
+);
+PREPARE statement FROM @statement;
+EXECUTE statement;
+DEALLOCATE PREPARE statement;
+SET @statement = IF(
+    (SELECT COUNT(*) FROM information_schema.statistics
+     WHERE table_schema = DATABASE() AND table_name = 'intent_activity' AND index_name = 'idx_intent_activity_server') = 0,
+    'CREATE INDEX idx_intent_activity_server ON intent_activity (server_namespace)',
+    'DO 0'
+);
+PREPARE statement FROM @statement;
+EXECUTE statement;
+DEALLOCATE PREPARE statement;
+SET @statement = IF(
+    (SELECT COUNT(*) FROM information_schema.statistics
+     WHERE table_schema = DATABASE() AND table_name = 'intent_activity' AND index_name = 'idx_intent_activity_date') = 0,
+    'CREATE INDEX idx_intent_activity_date ON intent_activity (activity_date)',
+    'DO 0'
+);
+PREPARE statement FROM @statement;
+EXECUTE statement;
+DEALLOCATE PREPARE statement;
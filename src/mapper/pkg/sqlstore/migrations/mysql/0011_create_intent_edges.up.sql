@@ -0,0 +1,34 @@
+-- The first and last time every intent edge was seen, for the intentHistory query. Unlike intent_activity, edges are
+-- not removed by the history retention, so their first seen time outlives their daily activity.
+CREATE TABLE IF NOT EXISTS intent_edges (
+    edge_hash CHAR(64) NOT NULL PRIMARY KEY,
+    kind VARCHAR(16) NOT NULL,
+    client_name VARCHAR(128) NOT NULL,
+    client_namespace VARCHAR(128) NOT NULL,
+    server_name VARCHAR(2048) NOT NULL,
+    server_namespace VARCHAR(128) NOT NULL,
+    first_seen DATETIME NOT NULL,
+    last_seen DATETIME NOT NULL
+);
+SET @statement = IF(
+    (SELECT COUNT(*) FROM information_schema.statistics
+     WHERE table_schema = DATABASE() AND table_name = 'intent_edges' AND index_name = 'idx_intent_edges_client') = 0,
+    'CREATE INDEX idx_intent_edges_client ON intent_edges (client_namespace, client_name)',
+    'DO 0'
+);
+PREPARE statement FROM @statement;
+EXECUTE statement;
+DEALLOCATE PREPARE statement;
+SET @statement = IF(
+    (SELECT COUNT(*) FROM information_schema.statistics
+     WHERE table_schema = DATABASE() AND table_name = 'intent_edges' AND index_name = 'idx_intent_edges_server') = 0,
+    'CREATE INDEX idx_intent_edges_server ON intent_edges (server_namespace)',
+    'DO 0'
+);
+PREPARE statement FROM @statement;
+EXECUTE statement;
+DEALLOCATE PREPARE statement;
+INSERT IGNORE INTO intent_edges (edge_hash, kind, client_name, client_namespace, server_name, server_namespace, first_seen, last_seen)
+SELECT edge_hash, MIN(kind), MIN(client_name), MIN(client_namespace), MIN(server_name), MIN(server_namespace), MIN(first_seen), MAX(last_seen)
+FROM intent_activity
+GROUP BY edge_hash;
@@ -0,0 +1,21 @@
+-- The transport protocol of external traffic intent ports, empty when only the DNS resolution was seen. Ports stored
+-- before the protocol was recorded were all seen on TCP.
+SET @statement = IF(
+    (SELECT COUNT(*) FROM information_schema.columns
+     WHERE table_schema = DATABASE() AND table_name = 'external_traffic_intent_ips' AND column_name = 'protocol') = 0,
+    'ALTER TABLE external_traffic_intent_ips ADD COLUMN protocol VARCHAR(8) NOT NULL DEFAULT ''''',
+    'DO 0'
+);
+PREPARE statement FROM @statement;
+EXECUTE statement;
+DEALLOCATE PREPARE statement;
+UPDATE external_traffic_intent_ips SET protocol = 'TCP' WHERE port <> 0 AND protocol = '';
+SET @statement = IF(
+    (SELECT COUNT(*) FROM information_schema.statistics
+     WHERE table_schema = DATABASE() AND table_name = 'external_traffic_intent_ips' AND index_name = 'uniq_intent_ip' AND column_name = 'protocol') = 0,
+    'ALTER TABLE external_traffic_intent_ips DROP INDEX uniq_intent_ip, ADD UNIQUE KEY uniq_intent_ip (intent_id, ip, port, protocol)',
+    'DO 0'
+);
+PREPARE statement FROM @statement;
+EXECUTE statement;
+DEALLOCATE PREPARE statement;
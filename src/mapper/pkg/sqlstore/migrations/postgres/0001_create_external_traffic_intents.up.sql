@@ -0,0 +1,9 @@
+CREATE TABLE IF NOT EXISTS external_traffic_intents (
+    id BIGSERIAL PRIMARY KEY,
+    client_name VARCHAR(128) NOT NULL,
+    client_namespace VARCHAR(128) NOT NULL,
+    client_kind VARCHAR(128) NOT NULL,
+    dns_name VARCHAR(128) NOT NULL,
+    last_seen DATE NOT NULL,
+    CONSTRAINT uniq_external_traffic_intent UNIQUE (client_name, client_namespace, client_kind, dns_name)
+);
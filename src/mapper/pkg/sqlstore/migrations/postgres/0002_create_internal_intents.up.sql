@@ -0,0 +1,11 @@
+CREATE TABLE IF NOT EXISTS internal_intents (
+    id BIGSERIAL PRIMARY KEY,
+    client_name VARCHAR(128) NOT NULL,
+    client_namespace VARCHAR(128) NOT NULL,
+    server_name VARCHAR(128) NOT NULL,
+    server_namespace VARCHAR(128) NOT NULL,
+    intent_type VARCHAR(32) NOT NULL,
+    intent TEXT NOT NULL,
+    last_seen TIMESTAMP NOT NULL,
+    CONSTRAINT uniq_internal_intent UNIQUE (client_name, client_namespace, server_name, server_namespace, intent_type)
+);
@@ -0,0 +1,10 @@
+-- The IPs and ports behind an external traffic intent's DNS name. Port is 0 when only the DNS resolution was seen.
+CREATE TABLE IF NOT EXISTS external_traffic_intent_ips (
+    id BIGSERIAL PRIMARY KEY,
+    intent_id BIGINT NOT NULL REFERENCES external_traffic_intents (id) ON DELETE CASCADE,
+    ip VARCHAR(45) NOT NULL,
+    port INTEGER NOT NULL,
+    first_seen DATE NOT NULL,
+    last_seen DATE NOT NULL,
+    CONSTRAINT uniq_external_traffic_intent_ip UNIQUE (intent_id, ip, port)
+);
@@ -0,0 +1,6 @@
+-- Indexes backing the externalIntents query filters and its (last_seen, id) pagination order.
+-- Filtering by client name is covered by the unique key, which starts with client_name.
+CREATE INDEX IF NOT EXISTS idx_external_traffic_intents_last_seen ON external_traffic_intents (last_seen, id);
+CREATE INDEX IF NOT EXISTS idx_external_traffic_intents_namespace ON external_traffic_intents (client_namespace, last_seen);
+CREATE INDEX IF NOT EXISTS idx_external_traffic_intents_kind ON external_traffic_intents (client_kind, last_seen);
+CREATE INDEX IF NOT EXISTS idx_external_traffic_intents_dns_name ON external_traffic_intents (dns_name);
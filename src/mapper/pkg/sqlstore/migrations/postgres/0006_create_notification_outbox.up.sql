@@ -0,0 +1,13 @@
+-- Notifications of new external intents, queued in the same transaction the intent is stored in and deleted once
+-- delivered. next_attempt_at is a unix timestamp, so it compares the same way on every backend.
+CREATE TABLE IF NOT EXISTS notification_outbox (
+    id BIGSERIAL PRIMARY KEY,
+    notifier VARCHAR(128) NOT NULL,
+    payload TEXT NOT NULL,
+    status VARCHAR(16) NOT NULL,
+    attempts INTEGER NOT NULL,
+    next_attempt_at BIGINT NOT NULL,
+    last_error TEXT,
+    created_at TIMESTAMP NOT NULL
+);
+CREATE INDEX IF NOT EXISTS idx_notification_outbox_due ON notification_outbox (status, next_attempt_at);
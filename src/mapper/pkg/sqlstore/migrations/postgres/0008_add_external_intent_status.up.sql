@@ -0,0 +1,7 @@
+-- Approval state of external intents. Intents are pending until they are approved or denied through the GraphQL API.
+ALTER TABLE external_traffic_intents ADD COLUMN status VARCHAR(16) NOT NULL DEFAULT 'pending';
+ALTER TABLE external_traffic_intents ADD COLUMN status_reason TEXT;
+ALTER TABLE external_traffic_intents ADD COLUMN status_updated_at TIMESTAMP;
+CREATE INDEX IF NOT EXISTS idx_external_traffic_intents_status ON external_traffic_intents (status, last_seen);
+-- The event a queued notification is sent for. Notifications queued until now were all for new intents.
+ALTER TABLE notification_outbox ADD COLUMN event VARCHAR(32) NOT NULL DEFAULT 'new_intent';
@@ -0,0 +1,4 @@
+-- The transport protocol of internal intents, empty for TCP. Intents seen on both TCP and UDP are stored separately.
+ALTER TABLE internal_intents ADD COLUMN protocol VARCHAR(8) NOT NULL DEFAULT '';
+ALTER TABLE internal_intents DROP CONSTRAINT uniq_internal_intent;
+ALTER TABLE internal_intents ADD CONSTRAINT uniq_internal_intent UNIQUE (client_name, client_namespace, server_name, server_namespace, intent_type, protocol);
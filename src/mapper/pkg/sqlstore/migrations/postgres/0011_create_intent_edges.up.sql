@@ -0,0 +1,19 @@
+-- The first and last time every intent edge was seen, for the intentHistory query. Unlike intent_activity, edges are
+-- not removed by the history retention, so their first seen time outlives their daily activity.
+CREATE TABLE IF NOT EXISTS intent_edges (
+    edge_hash CHAR(64) NOT NULL PRIMARY KEY,
+    kind VARCHAR(16) NOT NULL,
+    client_name VARCHAR(128) NOT NULL,
+    client_namespace VARCHAR(128) NOT NULL,
+    server_name VARCHAR(2048) NOT NULL,
+    server_namespace VARCHAR(128) NOT NULL,
+    first_seen TIMESTAMP NOT NULL,
+    last_seen TIMESTAMP NOT NULL
+);
+CREATE INDEX IF NOT EXISTS idx_intent_edges_client ON intent_edges (client_namespace, client_name);
+CREATE INDEX IF NOT EXISTS idx_intent_edges_server ON intent_edges (server_namespace);
+INSERT INTO intent_edges (edge_hash, kind, client_name, client_namespace, server_name, server_namespace, first_seen, last_seen)
+SELECT edge_hash, MIN(kind), MIN(client_name), MIN(client_namespace), MIN(server_name), MIN(server_namespace), MIN(first_seen), MAX(last_seen)
+FROM intent_activity
+GROUP BY edge_hash
+ON CONFLICT (edge_hash) DO NOTHING;
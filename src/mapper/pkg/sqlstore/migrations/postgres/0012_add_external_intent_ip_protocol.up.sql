@@ -0,0 +1,6 @@
+-- The transport protocol of external traffic intent ports, empty when only the DNS resolution was seen. Ports stored
+-- before the protocol was recorded were all seen on TCP.
+ALTER TABLE external_traffic_intent_ips ADD COLUMN protocol VARCHAR(8) NOT NULL DEFAULT '';
+UPDATE external_traffic_intent_ips SET protocol = 'TCP' WHERE port <> 0;
+ALTER TABLE external_traffic_intent_ips DROP CONSTRAINT uniq_external_traffic_intent_ip;
+ALTER TABLE external_traffic_intent_ips ADD CONSTRAINT uniq_external_traffic_intent_ip UNIQUE (intent_id, ip, port, protocol);
@@ -0,0 +1,2 @@
+-- DNS names may be up to 253 characters long.
+-- SQLite does not enforce VARCHAR lengths, so there is nothing to change.
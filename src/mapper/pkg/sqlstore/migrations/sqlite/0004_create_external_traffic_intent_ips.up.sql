@@ -0,0 +1,11 @@
+-- The IPs and ports behind an external traffic intent's DNS name. Port is 0 when only the DNS resolution was seen.
+-- SQLite does not enforce foreign keys unless enabled per connection, so orphaned rows are also removed on cleanup.
+CREATE TABLE IF NOT EXISTS external_traffic_intent_ips (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    intent_id INTEGER NOT NULL REFERENCES external_traffic_intents (id) ON DELETE CASCADE,
+    ip VARCHAR(45) NOT NULL,
+    port INTEGER NOT NULL,
+    first_seen DATE NOT NULL,
+    last_seen DATE NOT NULL,
+    UNIQUE (intent_id, ip, port)
+);
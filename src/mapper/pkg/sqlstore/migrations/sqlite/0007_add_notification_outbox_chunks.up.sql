@@ -0,0 +1,6 @@
+-- The chunk a queued notification was sent in, set on its first delivery attempt so a failed chunk is retried as is,
+-- with the same correlation ID, position and intents.
+ALTER TABLE notification_outbox ADD COLUMN correlation_id VARCHAR(36);
+ALTER TABLE notification_outbox ADD COLUMN chunk_index INTEGER;
+ALTER TABLE notification_outbox ADD COLUMN chunk_count INTEGER;
+ALTER TABLE notification_outbox ADD COLUMN chunk_namespace VARCHAR(253);
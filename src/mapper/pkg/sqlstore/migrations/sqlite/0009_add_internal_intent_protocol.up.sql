@@ -0,0 +1,18 @@
+-- The transport protocol of internal intents, empty for TCP. Intents seen on both TCP and UDP are stored separately.
+-- SQLite can't change a table's unique constraint, so the table is recreated.
+CREATE TABLE internal_intents_with_protocol (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    client_name VARCHAR(128) NOT NULL,
+    client_namespace VARCHAR(128) NOT NULL,
+    server_name VARCHAR(128) NOT NULL,
+    server_namespace VARCHAR(128) NOT NULL,
+    intent_type VARCHAR(32) NOT NULL,
+    protocol VARCHAR(8) NOT NULL DEFAULT '',
+    intent TEXT NOT NULL,
+    last_seen DATETIME NOT NULL,
+    UNIQUE (client_name, client_namespace, server_name, server_namespace, intent_type, protocol)
+);
+INSERT INTO internal_intents_with_protocol (id, client_name, client_namespace, server_name, server_namespace, intent_type, intent, last_seen)
+    SELECT id, client_name, client_namespace, server_name, server_namespace, intent_type, intent, last_seen FROM internal_intents;
+DROP TABLE internal_intents;
+ALTER TABLE internal_intents_with_protocol RENAME TO internal_intents;
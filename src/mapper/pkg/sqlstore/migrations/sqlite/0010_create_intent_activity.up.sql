@@ -0,0 +1,20 @@
+-- Daily activity of every intent edge, for the intentHistory query. edge_hash identifies the edge, as server names
+-- such as AWS ARNs are too long to be part of the unique key. seen_count is the number of upload intervals the edge
+-- was seen in during the day.
+CREATE TABLE IF NOT EXISTS intent_activity (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    edge_hash CHAR(64) NOT NULL,
+    kind VARCHAR(16) NOT NULL,
+    client_name VARCHAR(128) NOT NULL,
+    client_namespace VARCHAR(128) NOT NULL,
+    server_name VARCHAR(2048) NOT NULL,
+    server_namespace VARCHAR(128) NOT NULL,
+    activity_date DATE NOT NULL,
+    seen_count INTEGER NOT NULL,
+    first_seen DATETIME NOT NULL,
+    last_seen DATETIME NOT NULL,
+    UNIQUE (edge_hash, activity_date)
+);
+CREATE INDEX IF NOT EXISTS idx_intent_activity_client ON intent_activity (client_namespace, client_name);
+CREATE INDEX IF NOT EXISTS idx_intent_activity_server ON intent_activity (server_namespace);
+CREATE INDEX IF NOT EXISTS idx_intent_activity_date ON intent_activity (activity_date);
@@ -0,0 +1,17 @@
+-- The transport protocol of external traffic intent ports, empty when only the DNS resolution was seen. Ports stored
+-- before the protocol was recorded were all seen on TCP.
+-- SQLite can't change a table's unique constraint, so the table is recreated.
+CREATE TABLE external_traffic_intent_ips_with_protocol (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    intent_id INTEGER NOT NULL REFERENCES external_traffic_intents (id) ON DELETE CASCADE,
+    ip VARCHAR(45) NOT NULL,
+    port INTEGER NOT NULL,
+    protocol VARCHAR(8) NOT NULL DEFAULT '',
+    first_seen DATE NOT NULL,
+    last_seen DATE NOT NULL,
+    UNIQUE (intent_id, ip, port, protocol)
+);
+INSERT INTO external_traffic_intent_ips_with_protocol (id, intent_id, ip, port, protocol, first_seen, last_seen)
+    SELECT id, intent_id, ip, port, CASE WHEN port <> 0 THEN 'TCP' ELSE '' END, first_seen, last_seen FROM external_traffic_intent_ips;
+DROP TABLE external_traffic_intent_ips;
+ALTER TABLE external_traffic_intent_ips_with_protocol RENAME TO external_traffic_intent_ips;
@@ -0,0 +1,93 @@
+package sqlstore
+
+import (
+	"context"
+	"github.com/otterize/intents-operator/src/shared/errors"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"regexp"
+	"strings"
+	"testing"
+)
+
+type MigrationsSuite struct {
+	suite.Suite
+	store *SQLIntentStore
+}
+
+func (s *MigrationsSuite) SetupTest() {
+	store, err := NewSQLIntentStore(Config{DbDriver: DriverSQLite, DbSQLitePath: ":memory:"})
+	s.Require().NoError(err)
+	s.store = store
+}
+
+func (s *MigrationsSuite) TearDownTest() {
+	s.Require().NoError(s.store.Close())
+}
+
+func (s *MigrationsSuite) latestVersion() int {
+	migrations, err := loadMigrations(sqliteDialect{}.migrationsDir())
+	s.Require().NoError(err)
+	return migrations[len(migrations)-1].version
+}
+
+func (s *MigrationsSuite) TestAllMigrationsAppliedOnStartup() {
+	version, err := s.store.SchemaVersion(context.Background())
+	s.Require().NoError(err)
+	s.Require().Equal(s.latestVersion(), version)
+}
+
+func (s *MigrationsSuite) TestMigrateIsIdempotent() {
+	s.Require().NoError(s.store.migrate(context.Background()))
+
+	var appliedCount int
+	s.Require().NoError(s.store.Db.QueryRow(`SELECT COUNT(*) FROM schema_migrations`).Scan(&appliedCount))
+	s.Require().Equal(s.latestVersion(), appliedCount)
+}
+
+func (s *MigrationsSuite) TestRefusesNewerSchema() {
+	_, err := s.store.Db.Exec(`INSERT INTO schema_migrations (version, name, applied_at) VALUES (?, 'from_the_future', CURRENT_TIMESTAMP)`, s.latestVersion()+1)
+	s.Require().NoError(err)
+
+	err = s.store.migrate(context.Background())
+	s.Require().Error(err)
+	s.Require().True(errors.Is(err, ErrSchemaVersionTooNew))
+}
+
+func TestMigrationsSuite(t *testing.T) {
+	suite.Run(t, new(MigrationsSuite))
+}
+
+func TestMigrationsAreConsistentAcrossDialects(t *testing.T) {
+	var versions [][]int
+	for _, d := range []dialect{mysqlDialect{}, postgresDialect{}, sqliteDialect{}} {
+		migrations, err := loadMigrations(d.migrationsDir())
+		require.NoError(t, err)
+
+		dialectVersions := make([]int, 0, len(migrations))
+		for _, m := range migrations {
+			dialectVersions = append(dialectVersions, m.version)
+		}
+		versions = append(versions, dialectVersions)
+	}
+	require.Equal(t, versions[0], versions[1])
+	require.Equal(t, versions[0], versions[2])
+}
+
+func TestSplitStatements(t *testing.T) {
+	statements := splitStatements("-- comment\nCREATE TABLE a (id INT);\nCREATE INDEX b ON a (id);\n")
+	require.Equal(t, []string{"CREATE TABLE a (id INT)", "CREATE INDEX b ON a (id)"}, statements)
+}
+
+// MySQL commits DDL statements implicitly, so its migrations must only add indexes and columns that don't exist yet, in
+// case the mapper stopped half-way through a migration.
+func TestMySQLMigrationsAreRerunnable(t *testing.T) {
+	unguarded := regexp.MustCompile(`(?i)^(CREATE (UNIQUE )?INDEX|ALTER TABLE \w+ (ADD|DROP))`)
+	migrations, err := loadMigrations(mysqlDialect{}.migrationsDir())
+	require.NoError(t, err)
+	for _, m := range migrations {
+		for _, statement := range m.statements {
+			require.False(t, unguarded.MatchString(strings.TrimSpace(statement)), "migration %d_%s: %s", m.version, m.name, statement)
+		}
+	}
+}
@@ -0,0 +1,423 @@
+package sqlstore
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"github.com/otterize/intents-operator/src/shared/errors"
+	"github.com/otterize/network-mapper/src/mapper/pkg/notifier"
+	"github.com/samber/lo"
+	"github.com/sirupsen/logrus"
+	"time"
+)
+
+const (
+	OutboxStatusPending = "pending"
+	// OutboxStatusDead marks notifications that will not be retried, since they failed permanently or ran out of
+	// attempts. They are kept for inspection, and can be requeued by setting their status back to pending.
+	OutboxStatusDead = "dead"
+)
+
+// outboxFetchSize limits the notifications delivered at once. Entries of chunks that were already sent are fetched
+// with the rest of their chunk even beyond it.
+var outboxFetchSize = 500
+
+type outboxEntry struct {
+	id       int64
+	notifier string
+	event    notifier.Event
+	intent   notifier.ExternalIntent
+	attempts int
+	// chunk is the chunk the entry was assigned to on its first delivery attempt, without its intents.
+	chunk *notifier.Chunk
+}
+
+// outboxChunk is a chunk to send, along with the entries of its intents.
+type outboxChunk struct {
+	chunk   notifier.Chunk
+	entries []outboxEntry
+}
+
+// OutboxEntryRecord represents a queued notification.
+type OutboxEntryRecord struct {
+	Notifier      string
+	Event         notifier.Event
+	Intent        notifier.ExternalIntent
+	Status        string
+	Attempts      int
+	NextAttemptAt time.Time
+	LastError     string
+}
+
+// enqueueNotifications queues a notification of the event for every notifier whose filter matches the intent.
+func (s *SQLIntentStore) enqueueNotifications(ctx context.Context, tx *sql.Tx, event notifier.Event, intent notifier.ExternalIntent) error {
+	notifiers := lo.Filter(s.notifiers, func(n notifier.Notifier, _ int) bool { return n.Matches(intent) })
+	if len(notifiers) == 0 {
+		return nil
+	}
+
+	payload, err := json.Marshal(intent)
+	if err != nil {
+		return errors.Wrap(err)
+	}
+	now := s.now()
+	for _, n := range notifiers {
+		_, err := tx.ExecContext(ctx, s.dialect.rebind(`
+            INSERT INTO notification_outbox (notifier, event, payload, status, attempts, next_attempt_at, created_at)
+            VALUES (?, ?, ?, ?, 0, ?, ?)
+        `), n.Name(), string(event), string(payload), OutboxStatusPending, now.Unix(), now.UTC())
+		if err != nil {
+			return errors.Wrap(err)
+		}
+	}
+	return nil
+}
+
+func (s *SQLIntentStore) signalNotificationOutbox() {
+	select {
+	case s.outboxSignal <- struct{}{}:
+	default:
+	}
+}
+
+// RunNotificationOutbox delivers queued notifications until ctx is done. Notifications are delivered at least once:
+// they are only removed from the outbox after the notifier accepted them, and are retried with exponential backoff
+// otherwise, honoring the receiving side's Retry-After and X-RateLimit-Reset headers.
+func (s *SQLIntentStore) RunNotificationOutbox(ctx context.Context) {
+	logrus.Info("Starting notification outbox")
+	for {
+		if err := s.DeliverDueNotifications(ctx); err != nil {
+			logrus.WithError(err).Error("Failed to deliver queued notifications")
+		}
+
+		select {
+		case <-s.outboxSignal:
+		case <-time.After(s.config.NotificationOutboxInterval):
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// DeliverDueNotifications sends all queued notifications whose next attempt is due, chunked according to each
+// notifier's chunking config.
+func (s *SQLIntentStore) DeliverDueNotifications(ctx context.Context) error {
+	entries, err := s.dueOutboxEntries(ctx)
+	if err != nil {
+		return errors.Wrap(err)
+	}
+
+	entriesByNotifier := lo.GroupBy(entries, func(entry outboxEntry) string { return entry.notifier })
+	for _, name := range lo.Uniq(lo.Map(entries, func(entry outboxEntry, _ int) string { return entry.notifier })) {
+		notifierEntries := entriesByNotifier[name]
+		n, found := lo.Find(s.notifiers, func(n notifier.Notifier) bool { return n.Name() == name })
+		if !found {
+			s.markOutboxEntriesDead(ctx, notifierEntries, "notifier is no longer configured")
+			continue
+		}
+
+		chunks, err := s.outboxChunks(ctx, n, notifierEntries)
+		if err != nil {
+			logrus.WithError(err).WithField("notifier", name).Error("Failed to chunk queued notifications")
+			continue
+		}
+		for i, chunk := range chunks {
+			if err := n.Notify(ctx, chunk.chunk); err != nil {
+				// The notifier's endpoint is failing or asked to back off, so its remaining chunks wait until the
+				// failed chunk is retried rather than being sent now.
+				retryAt := s.handleFailedDelivery(ctx, n.Name(), chunk.entries, err)
+				s.deferOutboxEntries(ctx, lo.FlatMap(chunks[i+1:], func(chunk outboxChunk, _ int) []outboxEntry { return chunk.entries }), retryAt)
+				break
+			}
+			logrus.WithFields(logrus.Fields{
+				"notifier":      name,
+				"event":         chunk.chunk.Event,
+				"count":         len(chunk.entries),
+				"correlationID": chunk.chunk.CorrelationID,
+				"chunk":         fmt.Sprintf("%d/%d", chunk.chunk.Index, chunk.chunk.Count),
+			}).Info("Sent external intents notification")
+			s.deleteOutboxEntries(ctx, chunk.entries)
+		}
+	}
+	return nil
+}
+
+// outboxChunks returns the chunks to send for a notifier's due entries. Entries that were already attempted are sent
+// in the same chunk as before, and the rest are chunked anew per event, persisting their chunk before they are first
+// sent.
+func (s *SQLIntentStore) outboxChunks(ctx context.Context, n notifier.Notifier, entries []outboxEntry) ([]outboxChunk, error) {
+	chunks := make([]outboxChunk, 0)
+	attempted, unattempted := lo.FilterReject(entries, func(entry outboxEntry, _ int) bool { return entry.chunk != nil })
+	chunkKey := func(entry outboxEntry) string {
+		return fmt.Sprintf("%s|%d", entry.chunk.CorrelationID, entry.chunk.Index)
+	}
+	attemptedByChunk := lo.GroupBy(attempted, chunkKey)
+	for _, key := range lo.Uniq(lo.Map(attempted, func(entry outboxEntry, _ int) string { return chunkKey(entry) })) {
+		chunkEntries := attemptedByChunk[key]
+		chunk := *chunkEntries[0].chunk
+		chunk.Intents = lo.Map(chunkEntries, func(entry outboxEntry, _ int) notifier.ExternalIntent { return entry.intent })
+		chunks = append(chunks, outboxChunk{chunk: chunk, entries: chunkEntries})
+	}
+
+	unattemptedByEvent := lo.GroupBy(unattempted, func(entry outboxEntry) notifier.Event { return entry.event })
+	for _, event := range lo.Uniq(lo.Map(unattempted, func(entry outboxEntry, _ int) notifier.Event { return entry.event })) {
+		eventChunks, err := s.newOutboxChunks(ctx, n, event, unattemptedByEvent[event])
+		if err != nil {
+			return nil, errors.Wrap(err)
+		}
+		chunks = append(chunks, eventChunks...)
+	}
+	return chunks, nil
+}
+
+// newOutboxChunks chunks entries of a single event that were not attempted yet.
+func (s *SQLIntentStore) newOutboxChunks(ctx context.Context, n notifier.Notifier, event notifier.Event, unattempted []outboxEntry) ([]outboxChunk, error) {
+	chunks := make([]outboxChunk, 0)
+	newChunks, err := n.Chunks(event, lo.Map(unattempted, func(entry outboxEntry, _ int) notifier.ExternalIntent { return entry.intent }))
+	if err != nil {
+		s.markOutboxEntriesDead(ctx, unattempted, err.Error())
+		return chunks, nil
+	}
+
+	// Chunks may reorder intents, so they are matched back to their entries by value.
+	entriesByIntent := lo.GroupBy(unattempted, func(entry outboxEntry) notifier.ExternalIntent { return entry.intent })
+	for _, chunk := range newChunks {
+		chunkEntries := make([]outboxEntry, 0, len(chunk.Intents))
+		for _, intent := range chunk.Intents {
+			chunkEntries = append(chunkEntries, entriesByIntent[intent][0])
+			entriesByIntent[intent] = entriesByIntent[intent][1:]
+		}
+		if err := s.assignOutboxChunk(ctx, chunk, chunkEntries); err != nil {
+			return nil, errors.Wrap(err)
+		}
+		chunks = append(chunks, outboxChunk{chunk: chunk, entries: chunkEntries})
+	}
+
+	// The notifier's filter changed since these were queued, and they no longer match it.
+	unmatched := lo.Flatten(lo.Values(entriesByIntent))
+	if len(unmatched) > 0 {
+		s.deleteOutboxEntries(ctx, unmatched)
+	}
+	return chunks, nil
+}
+
+func (s *SQLIntentStore) assignOutboxChunk(ctx context.Context, chunk notifier.Chunk, entries []outboxEntry) error {
+	args := []any{chunk.CorrelationID, chunk.Index, chunk.Count, chunk.Namespace}
+	args = append(args, lo.Map(entries, func(entry outboxEntry, _ int) any { return entry.id })...)
+	_, err := s.Db.ExecContext(ctx, s.dialect.rebind(fmt.Sprintf(`
+		UPDATE notification_outbox
+		SET correlation_id = ?, chunk_index = ?, chunk_count = ?, chunk_namespace = ?
+		WHERE id IN (%s)
+	`, placeholders(len(entries)))), args...)
+	return errors.Wrap(err)
+}
+
+// dueOutboxEntries returns up to outboxFetchSize queued notifications whose next attempt is due, along with the rest of
+// the entries of the chunks they were already sent in, so chunks are always retried whole.
+func (s *SQLIntentStore) dueOutboxEntries(ctx context.Context) ([]outboxEntry, error) {
+	entries, err := s.queryOutboxEntries(ctx, `
+		SELECT id, notifier, event, payload, attempts, correlation_id, chunk_index, chunk_count, chunk_namespace
+		FROM notification_outbox
+		WHERE status = ? AND next_attempt_at <= ?
+		ORDER BY id
+		LIMIT ?
+	`, OutboxStatusPending, s.now().Unix(), outboxFetchSize)
+	if err != nil {
+		return nil, errors.Wrap(err)
+	}
+	if len(entries) < outboxFetchSize {
+		return entries, nil
+	}
+
+	correlationIDs := lo.Uniq(lo.FilterMap(entries, func(entry outboxEntry, _ int) (any, bool) {
+		if entry.chunk == nil {
+			return nil, false
+		}
+		return entry.chunk.CorrelationID, true
+	}))
+	if len(correlationIDs) == 0 {
+		return entries, nil
+	}
+	chunkEntries, err := s.queryOutboxEntries(ctx, fmt.Sprintf(`
+		SELECT id, notifier, event, payload, attempts, correlation_id, chunk_index, chunk_count, chunk_namespace
+		FROM notification_outbox
+		WHERE status = ? AND correlation_id IN (%s)
+		ORDER BY id
+	`, placeholders(len(correlationIDs))), append([]any{OutboxStatusPending}, correlationIDs...)...)
+	if err != nil {
+		return nil, errors.Wrap(err)
+	}
+
+	type chunkKey struct {
+		correlationID string
+		index         int
+	}
+	fetchedIDs := make(map[int64]struct{})
+	fetchedChunks := make(map[chunkKey]struct{})
+	for _, entry := range entries {
+		fetchedIDs[entry.id] = struct{}{}
+		if entry.chunk != nil {
+			fetchedChunks[chunkKey{entry.chunk.CorrelationID, entry.chunk.Index}] = struct{}{}
+		}
+	}
+	for _, entry := range chunkEntries {
+		_, fetched := fetchedIDs[entry.id]
+		_, partial := fetchedChunks[chunkKey{entry.chunk.CorrelationID, entry.chunk.Index}]
+		if !fetched && partial {
+			entries = append(entries, entry)
+		}
+	}
+	return entries, nil
+}
+
+func (s *SQLIntentStore) queryOutboxEntries(ctx context.Context, query string, args ...any) ([]outboxEntry, error) {
+	rows, err := s.Db.QueryContext(ctx, s.dialect.rebind(query), args...)
+	if err != nil {
+		return nil, errors.Wrap(err)
+	}
+	defer rows.Close()
+
+	entries := make([]outboxEntry, 0)
+	for rows.Next() {
+		var entry outboxEntry
+		var payload string
+		var correlationID, chunkNamespace sql.NullString
+		var chunkIndex, chunkCount sql.NullInt64
+		if err := rows.Scan(&entry.id, &entry.notifier, &entry.event, &payload, &entry.attempts, &correlationID, &chunkIndex, &chunkCount, &chunkNamespace); err != nil {
+			return nil, errors.Wrap(err)
+		}
+		if err := json.Unmarshal([]byte(payload), &entry.intent); err != nil {
+			return nil, errors.Wrap(err)
+		}
+		if correlationID.Valid {
+			entry.chunk = &notifier.Chunk{
+				Event:         entry.event,
+				CorrelationID: correlationID.String,
+				Index:         int(chunkIndex.Int64),
+				Count:         int(chunkCount.Int64),
+				Namespace:     chunkNamespace.String,
+			}
+		}
+		entries = append(entries, entry)
+	}
+	return entries, errors.Wrap(rows.Err())
+}
+
+// retryDelay returns the delay before the given attempt, doubling from the initial backoff up to the max backoff.
+func (s *SQLIntentStore) retryDelay(attempt int) time.Duration {
+	delay := s.config.NotificationInitialBackoff
+	for i := 1; i < attempt && delay < s.config.NotificationMaxBackoff; i++ {
+		delay *= 2
+	}
+	return min(delay, s.config.NotificationMaxBackoff)
+}
+
+// handleFailedDelivery reschedules or dead-letters the entries of a chunk that failed, and returns when the notifier
+// may be sent to again.
+func (s *SQLIntentStore) handleFailedDelivery(ctx context.Context, notifierName string, entries []outboxEntry, deliveryErr error) time.Time {
+	now := s.now()
+	retryable := true
+	var retryAt time.Time
+	var statusErr *notifier.StatusError
+	if errors.As(deliveryErr, &statusErr) {
+		retryable = statusErr.Retryable()
+		retryAt, _ = statusErr.RetryAt(now)
+	}
+
+	logger := logrus.WithError(deliveryErr).WithFields(logrus.Fields{"notifier": notifierName, "count": len(entries)})
+	resumeAt := now.Add(s.retryDelay(1))
+	for _, entry := range entries {
+		attempts := entry.attempts + 1
+		nextAttemptAt := now.Add(s.retryDelay(attempts))
+		if retryAt.After(nextAttemptAt) {
+			nextAttemptAt = retryAt
+		}
+		if nextAttemptAt.After(resumeAt) {
+			resumeAt = nextAttemptAt
+		}
+
+		if !retryable || attempts >= s.config.NotificationMaxAttempts {
+			s.updateOutboxEntry(ctx, entry.id, OutboxStatusDead, attempts, now, deliveryErr)
+			logger.WithField("attempts", attempts).Error("Giving up on external intents notification, moved to dead letter")
+			continue
+		}
+		s.updateOutboxEntry(ctx, entry.id, OutboxStatusPending, attempts, nextAttemptAt, deliveryErr)
+		logger.WithFields(logrus.Fields{"attempts": attempts, "nextAttemptAt": nextAttemptAt}).Warn("Failed sending external intents notification, will retry")
+	}
+	return resumeAt
+}
+
+// deferOutboxEntries postpones the next attempt of entries that were not sent to retryAt, without counting an attempt.
+func (s *SQLIntentStore) deferOutboxEntries(ctx context.Context, entries []outboxEntry, retryAt time.Time) {
+	if len(entries) == 0 {
+		return
+	}
+	args := []any{retryAt.Unix()}
+	args = append(args, lo.Map(entries, func(entry outboxEntry, _ int) any { return entry.id })...)
+	_, err := s.Db.ExecContext(ctx, s.dialect.rebind(fmt.Sprintf(`
+		UPDATE notification_outbox SET next_attempt_at = ? WHERE id IN (%s)
+	`, placeholders(len(entries)))), args...)
+	if err != nil {
+		logrus.WithError(err).Error("failed to postpone queued notifications")
+	}
+}
+
+func (s *SQLIntentStore) updateOutboxEntry(ctx context.Context, id int64, status string, attempts int, nextAttemptAt time.Time, lastErr error) {
+	_, err := s.Db.ExecContext(ctx, s.dialect.rebind(`
+		UPDATE notification_outbox
+		SET status = ?, attempts = ?, next_attempt_at = ?, last_error = ?
+		WHERE id = ?
+	`), status, attempts, nextAttemptAt.Unix(), lastErr.Error(), id)
+	if err != nil {
+		logrus.WithError(err).WithField("id", id).Error("failed to update notification outbox entry")
+	}
+}
+
+func (s *SQLIntentStore) markOutboxEntriesDead(ctx context.Context, entries []outboxEntry, reason string) {
+	for _, entry := range entries {
+		s.updateOutboxEntry(ctx, entry.id, OutboxStatusDead, entry.attempts, s.now(), errors.New(reason))
+	}
+	logrus.WithFields(logrus.Fields{"notifier": entries[0].notifier, "count": len(entries)}).Errorf("Moved notifications to dead letter: %s", reason)
+}
+
+func (s *SQLIntentStore) deleteOutboxEntries(ctx context.Context, entries []outboxEntry) {
+	args := lo.Map(entries, func(entry outboxEntry, _ int) any { return entry.id })
+	_, err := s.Db.ExecContext(ctx, s.dialect.rebind(fmt.Sprintf(`
+		DELETE FROM notification_outbox WHERE id IN (%s)
+	`, placeholders(len(entries)))), args...)
+	if err != nil {
+		// The notifications will be sent again, which is allowed since delivery is at-least-once.
+		logrus.WithError(err).Error("failed to delete delivered notifications from the outbox")
+	}
+}
+
+// GetOutboxEntries returns the queued notifications with the given status.
+func (s *SQLIntentStore) GetOutboxEntries(ctx context.Context, status string) ([]OutboxEntryRecord, error) {
+	rows, err := s.Db.QueryContext(ctx, s.dialect.rebind(`
+		SELECT notifier, event, payload, status, attempts, next_attempt_at, COALESCE(last_error, '')
+		FROM notification_outbox
+		WHERE status = ?
+		ORDER BY id
+	`), status)
+	if err != nil {
+		return nil, errors.Wrap(err)
+	}
+	defer rows.Close()
+
+	records := make([]OutboxEntryRecord, 0)
+	for rows.Next() {
+		var record OutboxEntryRecord
+		var payload string
+		var nextAttemptAt int64
+		if err := rows.Scan(&record.Notifier, &record.Event, &payload, &record.Status, &record.Attempts, &nextAttemptAt, &record.LastError); err != nil {
+			return nil, errors.Wrap(err)
+		}
+		if err := json.Unmarshal([]byte(payload), &record.Intent); err != nil {
+			return nil, errors.Wrap(err)
+		}
+		record.NextAttemptAt = time.Unix(nextAttemptAt, 0)
+		records = append(records, record)
+	}
+	return records, errors.Wrap(rows.Err())
+}
@@ -0,0 +1,304 @@
+package sqlstore
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"github.com/otterize/network-mapper/src/mapper/pkg/externaltrafficholder"
+	"github.com/otterize/network-mapper/src/mapper/pkg/notifier"
+	"github.com/samber/lo"
+	"net/http"
+	"time"
+)
+
+type fakeNotifier struct {
+	name       string
+	filter     notifier.Filter
+	maxIntents int
+	err        error
+	// failIndex, when set, only fails the chunk at that index with err.
+	failIndex int
+	received  []notifier.Chunk
+}
+
+func (n *fakeNotifier) Name() string {
+	return n.name
+}
+
+func (n *fakeNotifier) Matches(intent notifier.ExternalIntent) bool {
+	return n.filter.Matches(intent)
+}
+
+func (n *fakeNotifier) Chunks(event notifier.Event, intents []notifier.ExternalIntent) ([]notifier.Chunk, error) {
+	matching := lo.Filter(intents, func(intent notifier.ExternalIntent, _ int) bool { return n.Matches(intent) })
+	if len(matching) == 0 {
+		return nil, nil
+	}
+	size := len(matching)
+	if n.maxIntents > 0 {
+		size = n.maxIntents
+	}
+	correlationID := uuid.NewString()
+	chunks := lo.Map(lo.Chunk(matching, size), func(chunkIntents []notifier.ExternalIntent, i int) notifier.Chunk {
+		return notifier.Chunk{Event: event, CorrelationID: correlationID, Index: i + 1, Intents: chunkIntents}
+	})
+	for i := range chunks {
+		chunks[i].Count = len(chunks)
+	}
+	return chunks, nil
+}
+
+func (n *fakeNotifier) Notify(_ context.Context, chunk notifier.Chunk) error {
+	n.received = append(n.received, chunk)
+	if n.failIndex != 0 && chunk.Index != n.failIndex {
+		return nil
+	}
+	return n.err
+}
+
+func (s *SQLIntentStoreSuite) setupOutbox(n *fakeNotifier) *time.Time {
+	now := time.Unix(1700000000, 0)
+	s.store.now = func() time.Time { return now }
+	s.store.config.NotificationMaxAttempts = 3
+	s.store.config.NotificationInitialBackoff = time.Minute
+	s.store.config.NotificationMaxBackoff = time.Hour
+	s.store.RegisterNotifier(n)
+	return &now
+}
+
+func (s *SQLIntentStoreSuite) logNewIntents(dnsNames ...string) {
+	intents := make([]externaltrafficholder.TimestampedExternalTrafficIntent, 0, len(dnsNames))
+	for _, dnsName := range dnsNames {
+		intents = append(intents, externalTrafficIntent("frontend", dnsName, "8.8.8.8", time.Now()))
+	}
+	s.store.LogExternalTrafficIntentsCallback(context.Background(), intents)
+}
+
+func (s *SQLIntentStoreSuite) TestNotificationsAreDeliveredAndRemoved() {
+	ctx := context.Background()
+	matching := &fakeNotifier{name: "all"}
+	filtered := &fakeNotifier{name: "other-namespace", filter: notifier.Filter{Namespaces: []string{"staging"}}}
+	s.setupOutbox(matching)
+	s.store.RegisterNotifier(filtered)
+
+	s.logNewIntents("api.example.com", "storage.googleapis.com")
+	// Intents that were already seen are not notified again.
+	s.logNewIntents("api.example.com")
+
+	s.Require().NoError(s.store.DeliverDueNotifications(ctx))
+	s.Require().Len(matching.received, 1)
+	intents := matching.received[0].Intents
+	s.Require().Equal([]string{"api.example.com", "storage.googleapis.com"}, []string{intents[0].DNSName, intents[1].DNSName})
+	s.Require().Equal("Deployment", intents[0].ClientKind)
+	s.Require().Empty(filtered.received)
+
+	pending, err := s.store.GetOutboxEntries(ctx, OutboxStatusPending)
+	s.Require().NoError(err)
+	s.Require().Empty(pending)
+}
+
+func (s *SQLIntentStoreSuite) TestFailedNotificationsAreRetriedWithBackoff() {
+	ctx := context.Background()
+	n := &fakeNotifier{name: "flaky", err: &notifier.StatusError{StatusCode: http.StatusBadGateway}}
+	now := s.setupOutbox(n)
+	s.logNewIntents("api.example.com")
+
+	s.Require().NoError(s.store.DeliverDueNotifications(ctx))
+	pending, err := s.store.GetOutboxEntries(ctx, OutboxStatusPending)
+	s.Require().NoError(err)
+	s.Require().Len(pending, 1)
+	s.Require().Equal(1, pending[0].Attempts)
+	s.Require().Equal(now.Add(time.Minute), pending[0].NextAttemptAt)
+	s.Require().Contains(pending[0].LastError, "502")
+
+	// Not retried before the backoff elapses.
+	s.Require().NoError(s.store.DeliverDueNotifications(ctx))
+	s.Require().Len(n.received, 1)
+
+	// The second retry waits twice as long.
+	*now = now.Add(time.Minute)
+	s.Require().NoError(s.store.DeliverDueNotifications(ctx))
+	s.Require().Len(n.received, 2)
+	pending, err = s.store.GetOutboxEntries(ctx, OutboxStatusPending)
+	s.Require().NoError(err)
+	s.Require().Equal(now.Add(2*time.Minute), pending[0].NextAttemptAt)
+
+	n.err = nil
+	*now = now.Add(2 * time.Minute)
+	s.Require().NoError(s.store.DeliverDueNotifications(ctx))
+	s.Require().Len(n.received, 3)
+	pending, err = s.store.GetOutboxEntries(ctx, OutboxStatusPending)
+	s.Require().NoError(err)
+	s.Require().Empty(pending)
+}
+
+func (s *SQLIntentStoreSuite) TestRetryAfterIsHonored() {
+	ctx := context.Background()
+	n := &fakeNotifier{name: "rate-limited", err: &notifier.StatusError{
+		StatusCode: http.StatusTooManyRequests,
+		Header:     http.Header{"Retry-After": []string{"600"}},
+	}}
+	now := s.setupOutbox(n)
+	s.logNewIntents("api.example.com")
+
+	s.Require().NoError(s.store.DeliverDueNotifications(ctx))
+	pending, err := s.store.GetOutboxEntries(ctx, OutboxStatusPending)
+	s.Require().NoError(err)
+	s.Require().Len(pending, 1)
+	s.Require().Equal(now.Add(10*time.Minute), pending[0].NextAttemptAt)
+}
+
+func (s *SQLIntentStoreSuite) TestPermanentFailuresAreDeadLettered() {
+	ctx := context.Background()
+	n := &fakeNotifier{name: "misconfigured", err: &notifier.StatusError{StatusCode: http.StatusNotFound}}
+	s.setupOutbox(n)
+	s.logNewIntents("api.example.com")
+
+	s.Require().NoError(s.store.DeliverDueNotifications(ctx))
+	dead, err := s.store.GetOutboxEntries(ctx, OutboxStatusDead)
+	s.Require().NoError(err)
+	s.Require().Len(dead, 1)
+	s.Require().Equal("api.example.com", dead[0].Intent.DNSName)
+	s.Require().Equal(1, dead[0].Attempts)
+}
+
+func (s *SQLIntentStoreSuite) TestNotificationsAreDeadLetteredAfterMaxAttempts() {
+	ctx := context.Background()
+	n := &fakeNotifier{name: "down", err: &notifier.StatusError{StatusCode: http.StatusServiceUnavailable}}
+	now := s.setupOutbox(n)
+	s.logNewIntents("api.example.com")
+
+	for i := 0; i < 3; i++ {
+		s.Require().NoError(s.store.DeliverDueNotifications(ctx))
+		*now = now.Add(time.Hour)
+	}
+	s.Require().Len(n.received, 3)
+	dead, err := s.store.GetOutboxEntries(ctx, OutboxStatusDead)
+	s.Require().NoError(err)
+	s.Require().Len(dead, 1)
+	s.Require().Equal(3, dead[0].Attempts)
+
+	s.Require().NoError(s.store.DeliverDueNotifications(ctx))
+	s.Require().Len(n.received, 3)
+}
+
+func (s *SQLIntentStoreSuite) TestFailedChunksAreRetriedAsIs() {
+	ctx := context.Background()
+	n := &fakeNotifier{name: "chunked", maxIntents: 2}
+	now := s.setupOutbox(n)
+	s.logNewIntents("a.example.com", "b.example.com", "c.example.com")
+
+	// The first chunk fails, and the second is not sent until the first is retried.
+	n.err = &notifier.StatusError{StatusCode: http.StatusBadGateway}
+	n.failIndex = 1
+	s.Require().NoError(s.store.DeliverDueNotifications(ctx))
+	s.Require().Len(n.received, 1)
+	failed := n.received[0]
+	s.Require().Equal(1, failed.Index)
+	s.Require().Equal(2, failed.Count)
+	s.Require().Len(failed.Intents, 2)
+
+	pending, err := s.store.GetOutboxEntries(ctx, OutboxStatusPending)
+	s.Require().NoError(err)
+	s.Require().Len(pending, 3)
+	for _, entry := range pending {
+		s.Require().Equal(now.Add(time.Minute), entry.NextAttemptAt)
+	}
+
+	// The failed chunk is retried unchanged, with the same correlation ID and position.
+	n.err = nil
+	*now = now.Add(time.Minute)
+	s.Require().NoError(s.store.DeliverDueNotifications(ctx))
+	s.Require().Len(n.received, 3)
+	s.Require().Equal(failed, n.received[1])
+	s.Require().Equal(2, n.received[2].Index)
+	s.Require().Equal(failed.CorrelationID, n.received[2].CorrelationID)
+
+	pending, err = s.store.GetOutboxEntries(ctx, OutboxStatusPending)
+	s.Require().NoError(err)
+	s.Require().Empty(pending)
+}
+
+func (s *SQLIntentStoreSuite) TestRateLimitedNotifierIsNotSentRemainingChunks() {
+	ctx := context.Background()
+	n := &fakeNotifier{name: "rate-limited", maxIntents: 1, failIndex: 1, err: &notifier.StatusError{
+		StatusCode: http.StatusTooManyRequests,
+		Header:     http.Header{"Retry-After": []string{"600"}},
+	}}
+	now := s.setupOutbox(n)
+	s.logNewIntents("a.example.com", "b.example.com", "c.example.com")
+
+	s.Require().NoError(s.store.DeliverDueNotifications(ctx))
+	s.Require().Len(n.received, 1)
+	pending, err := s.store.GetOutboxEntries(ctx, OutboxStatusPending)
+	s.Require().NoError(err)
+	s.Require().Len(pending, 3)
+	for _, entry := range pending {
+		s.Require().Equal(now.Add(10*time.Minute), entry.NextAttemptAt)
+	}
+	// Only the chunk that was sent counts an attempt.
+	s.Require().Equal([]int{1, 0, 0}, lo.Map(pending, func(entry OutboxEntryRecord, _ int) int { return entry.Attempts }))
+
+	*now = now.Add(5 * time.Minute)
+	s.Require().NoError(s.store.DeliverDueNotifications(ctx))
+	s.Require().Len(n.received, 1)
+
+	n.err = nil
+	*now = now.Add(5 * time.Minute)
+	s.Require().NoError(s.store.DeliverDueNotifications(ctx))
+	s.Require().Len(n.received, 4)
+}
+
+func (s *SQLIntentStoreSuite) TestChunksAreFetchedWhole() {
+	ctx := context.Background()
+	n := &fakeNotifier{name: "chunked", err: &notifier.StatusError{StatusCode: http.StatusBadGateway}}
+	now := s.setupOutbox(n)
+	s.logNewIntents("a.example.com", "b.example.com", "c.example.com")
+	s.Require().NoError(s.store.DeliverDueNotifications(ctx))
+	s.Require().Len(n.received, 1)
+	s.Require().Len(n.received[0].Intents, 3)
+
+	// The failed chunk is retried whole even when it is larger than the fetch size.
+	fetchSize := outboxFetchSize
+	outboxFetchSize = 2
+	defer func() { outboxFetchSize = fetchSize }()
+	n.err = nil
+	*now = now.Add(time.Minute)
+	s.Require().NoError(s.store.DeliverDueNotifications(ctx))
+	s.Require().Len(n.received, 2)
+	s.Require().Equal(n.received[0], n.received[1])
+
+	pending, err := s.store.GetOutboxEntries(ctx, OutboxStatusPending)
+	s.Require().NoError(err)
+	s.Require().Empty(pending)
+}
+
+func (s *SQLIntentStoreSuite) TestDeniedIntentSeenAgainIsNotified() {
+	ctx := context.Background()
+	n := &fakeNotifier{name: "all"}
+	s.setupOutbox(n)
+	s.logNewIntents("api.example.com", "tracker.example.com")
+	s.Require().NoError(s.store.DeliverDueNotifications(ctx))
+	s.Require().Len(n.received, 1)
+	s.Require().Equal(notifier.EventNewIntent, n.received[0].Event)
+
+	_, err := s.store.SetExternalIntentStatus(ctx, ExternalIntentKey{
+		ClientName:      "frontend",
+		ClientNamespace: "production",
+		ClientKind:      "Deployment",
+		DNSName:         "tracker.example.com",
+	}, ExternalIntentStatusDenied, "")
+	s.Require().NoError(err)
+
+	// Existing intents are only stored once a day, so seeing them again the same day does not alert.
+	s.logNewIntents("api.example.com", "tracker.example.com")
+	s.Require().NoError(s.store.DeliverDueNotifications(ctx))
+	s.Require().Len(n.received, 1)
+
+	// As on the following day.
+	s.store.localIntentCacheMap = make(map[string]map[string]struct{})
+	s.logNewIntents("api.example.com", "tracker.example.com")
+	s.Require().NoError(s.store.DeliverDueNotifications(ctx))
+	s.Require().Len(n.received, 2)
+	s.Require().Equal(notifier.EventDeniedIntentSeen, n.received[1].Event)
+	s.Require().Equal([]string{"tracker.example.com"}, lo.Map(n.received[1].Intents, func(intent notifier.ExternalIntent, _ int) string { return intent.DNSName }))
+}
@@ -0,0 +1,100 @@
+package sqlstore
+
+import (
+	"context"
+	"database/sql"
+	"github.com/otterize/network-mapper/src/mapper/pkg/awsintentsholder"
+	"github.com/otterize/network-mapper/src/mapper/pkg/externaltrafficholder"
+	"github.com/otterize/network-mapper/src/mapper/pkg/gcpintentsholder"
+	"github.com/otterize/network-mapper/src/mapper/pkg/graph/model"
+	"github.com/otterize/network-mapper/src/mapper/pkg/incomingtrafficholder"
+	"github.com/otterize/network-mapper/src/mapper/pkg/intentsstore"
+	"github.com/otterize/network-mapper/src/mapper/pkg/notifier"
+	"github.com/sirupsen/logrus"
+	"time"
+)
+
+// IntentStore persists intents discovered by the mapper, so they outlive the mapper's in-memory holders.
+type IntentStore interface {
+	LogExternalTrafficIntentsCallback(ctx context.Context, intents []externaltrafficholder.TimestampedExternalTrafficIntent)
+	GetExternalIntents(ctx context.Context, filter ExternalIntentsFilter, limit int, cursor string) (ExternalIntentsPage, error)
+	CleanupExpiredIntents(ctx context.Context) error
+	SetExternalIntentStatus(ctx context.Context, key ExternalIntentKey, status string, reason string) (ExternalIntentRecord, error)
+	LogIntentsCallback(ctx context.Context, intents []intentsstore.TimestampedIntent)
+	LoadIntents(ctx context.Context) ([]intentsstore.TimestampedIntent, error)
+	ResetIntents(ctx context.Context) error
+	LogIntentsHistoryCallback(ctx context.Context, intents []intentsstore.TimestampedIntent)
+	LogExternalTrafficIntentsHistoryCallback(ctx context.Context, intents []externaltrafficholder.TimestampedExternalTrafficIntent)
+	LogIncomingTrafficIntentsHistoryCallback(ctx context.Context, intents []incomingtrafficholder.TimestampedIncomingTrafficIntent)
+	LogAWSIntentsHistoryCallback(ctx context.Context, intents []awsintentsholder.AWSIntent)
+	LogGCPIntentsHistoryCallback(ctx context.Context, intents []gcpintentsholder.GCPIntent)
+	LogAzureOperationsHistoryCallback(ctx context.Context, operations []model.AzureOperation)
+	GetIntentHistory(ctx context.Context, filter IntentHistoryFilter) ([]IntentHistoryRecord, error)
+}
+
+// SQLIntentStore is an IntentStore backed by MySQL, PostgreSQL or SQLite, according to Config.DbDriver.
+type SQLIntentStore struct {
+	Db                  *sql.DB
+	config              Config
+	dialect             dialect
+	localIntentCacheMap map[string]map[string]struct{}
+	// localIPCacheMap holds the intent IPs and ports already stored per day, keyed like localIntentCacheMap.
+	localIPCacheMap map[string]map[string]struct{}
+	notifiers       []notifier.Notifier
+	outboxSignal    chan struct{}
+	now             func() time.Time
+}
+
+var _ IntentStore = (*SQLIntentStore)(nil)
+
+func NewSQLIntentStore(config Config) (*SQLIntentStore, error) {
+	dialect, err := dialectForDriver(config.DbDriver)
+	if err != nil {
+		return nil, err
+	}
+
+	db, err := sql.Open(dialect.driverName(), dialect.dsn(config))
+	if err != nil {
+		logrus.WithError(err).Error("failed to open database connection")
+		return nil, err
+	}
+	if _, isSQLite := dialect.(sqliteDialect); isSQLite {
+		// SQLite only supports a single writer, and every connection to an in-memory database is a separate database.
+		db.SetMaxOpenConns(1)
+	}
+
+	if err := db.Ping(); err != nil {
+		logrus.WithError(err).Error("failed to ping database")
+		return nil, err
+	}
+
+	store := &SQLIntentStore{
+		Db:                  db,
+		config:              config,
+		dialect:             dialect,
+		localIntentCacheMap: make(map[string]map[string]struct{}),
+		localIPCacheMap:     make(map[string]map[string]struct{}),
+		outboxSignal:        make(chan struct{}, 1),
+		now:                 time.Now,
+	}
+
+	err = store.migrate(context.Background())
+	if err != nil {
+		logrus.WithError(err).Error("failed to migrate database schema")
+		return nil, err
+	}
+	if err := store.LoadCacheFromDb(); err != nil {
+		logrus.WithError(err).Error("failed to load cache from db")
+	}
+	return store, nil
+}
+
+// RegisterNotifier adds a notifier to be notified of newly discovered external intents.
+func (s *SQLIntentStore) RegisterNotifier(n notifier.Notifier) {
+	s.notifiers = append(s.notifiers, n)
+}
+
+// Close closes the underlying database connection pool.
+func (s *SQLIntentStore) Close() error {
+	return s.Db.Close()
+}
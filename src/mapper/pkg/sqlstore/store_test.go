@@ -0,0 +1,358 @@
+package sqlstore
+
+import (
+	"context"
+	"fmt"
+	"github.com/otterize/intents-operator/src/shared/errors"
+	"github.com/otterize/network-mapper/src/mapper/pkg/externaltrafficholder"
+	"github.com/otterize/network-mapper/src/mapper/pkg/graph/model"
+	"github.com/otterize/network-mapper/src/mapper/pkg/intentsstore"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"testing"
+	"time"
+)
+
+type SQLIntentStoreSuite struct {
+	suite.Suite
+	store *SQLIntentStore
+}
+
+func (s *SQLIntentStoreSuite) SetupTest() {
+	store, err := NewSQLIntentStore(Config{
+		DbDriver:             DriverSQLite,
+		DbSQLitePath:         ":memory:",
+		RetentionDays:        90,
+		IntentsRetentionDays: 90,
+	})
+	s.Require().NoError(err)
+	s.store = store
+}
+
+func (s *SQLIntentStoreSuite) TearDownTest() {
+	s.Require().NoError(s.store.Close())
+}
+
+func externalTrafficIntent(clientName string, dnsName string, ip string, timestamp time.Time) externaltrafficholder.TimestampedExternalTrafficIntent {
+	return externaltrafficholder.TimestampedExternalTrafficIntent{
+		Timestamp: timestamp,
+		Intent: externaltrafficholder.ExternalTrafficIntent{
+			Client: model.OtterizeServiceIdentity{
+				Name:         clientName,
+				Namespace:    "production",
+				PodOwnerKind: &model.GroupVersionKind{Kind: "Deployment"},
+			},
+			LastSeen: timestamp,
+			DNSName:  dnsName,
+			IPs:      map[externaltrafficholder.IP]struct{}{externaltrafficholder.IP(ip): {}},
+		},
+	}
+}
+
+func (s *SQLIntentStoreSuite) TestExternalIntentsRoundTrip() {
+	ctx := context.Background()
+	now := time.Now()
+	s.store.LogExternalTrafficIntentsCallback(ctx, []externaltrafficholder.TimestampedExternalTrafficIntent{
+		externalTrafficIntent("frontend", "api.example.com", "8.8.8.8", now),
+		externalTrafficIntent("frontend", "internal.example.com", "10.0.0.1", now),
+	})
+
+	page, err := s.store.GetExternalIntents(ctx, ExternalIntentsFilter{}, 0, "")
+	s.Require().NoError(err)
+	records := page.Intents
+	s.Require().Len(records, 1)
+	s.Require().Equal("frontend", records[0].ClientName)
+	s.Require().Equal("production", records[0].ClientNamespace)
+	s.Require().Equal("Deployment", records[0].ClientKind)
+	s.Require().Equal("api.example.com", records[0].DNSName)
+	s.Require().Equal(now.Format("2006-01-02"), records[0].LastSeen.Format("2006-01-02"))
+}
+
+func (s *SQLIntentStoreSuite) TestExpiredExternalIntentsAreCleanedUp() {
+	ctx := context.Background()
+	s.store.LogExternalTrafficIntentsCallback(ctx, []externaltrafficholder.TimestampedExternalTrafficIntent{
+		externalTrafficIntent("frontend", "old.example.com", "8.8.8.8", time.Now().AddDate(0, 0, -100)),
+		externalTrafficIntent("frontend", "new.example.com", "8.8.8.8", time.Now()),
+	})
+
+	page, err := s.store.GetExternalIntents(ctx, ExternalIntentsFilter{}, 0, "")
+	s.Require().NoError(err)
+	records := page.Intents
+	s.Require().Len(records, 1)
+	s.Require().Equal("new.example.com", records[0].DNSName)
+}
+
+func (s *SQLIntentStoreSuite) TestExternalIntentIPsAndPortsAreStored() {
+	ctx := context.Background()
+	yesterday := time.Now().AddDate(0, 0, -1)
+	now := time.Now()
+
+	dnsOnly := externalTrafficIntent("frontend", "api.example.com", "8.8.8.8", yesterday)
+	withPort := externalTrafficIntent("frontend", "api.example.com", "8.8.4.4", now)
+	withPort.Intent.Ports = map[externaltrafficholder.IP]map[externaltrafficholder.Port]struct{}{"8.8.4.4": {
+		{Number: 443, Protocol: model.IntentProtocolTCP}: {},
+		{Number: 443, Protocol: model.IntentProtocolUDP}: {},
+	}}
+	s.store.LogExternalTrafficIntentsCallback(ctx, []externaltrafficholder.TimestampedExternalTrafficIntent{dnsOnly})
+	s.store.LogExternalTrafficIntentsCallback(ctx, []externaltrafficholder.TimestampedExternalTrafficIntent{withPort})
+	// Seeing the same IP again should only extend last_seen, not add a row.
+	s.store.LogExternalTrafficIntentsCallback(ctx, []externaltrafficholder.TimestampedExternalTrafficIntent{
+		externalTrafficIntent("frontend", "api.example.com", "8.8.8.8", now),
+	})
+
+	page, err := s.store.GetExternalIntents(ctx, ExternalIntentsFilter{}, 0, "")
+	s.Require().NoError(err)
+	records := page.Intents
+	s.Require().Len(records, 1)
+	s.Require().Len(records[0].Addresses, 3)
+
+	s.Require().Equal("8.8.4.4", records[0].Addresses[0].IP)
+	s.Require().Equal(443, records[0].Addresses[0].Port)
+	s.Require().Equal(string(model.IntentProtocolTCP), records[0].Addresses[0].Protocol)
+	s.Require().Equal(443, records[0].Addresses[1].Port)
+	s.Require().Equal(string(model.IntentProtocolUDP), records[0].Addresses[1].Protocol)
+
+	s.Require().Equal("8.8.8.8", records[0].Addresses[2].IP)
+	s.Require().Equal(0, records[0].Addresses[2].Port)
+	s.Require().Empty(records[0].Addresses[2].Protocol)
+	s.Require().Equal(yesterday.Format("2006-01-02"), records[0].Addresses[2].FirstSeen.Format("2006-01-02"))
+	s.Require().Equal(now.Format("2006-01-02"), records[0].Addresses[2].LastSeen.Format("2006-01-02"))
+}
+
+func (s *SQLIntentStoreSuite) TestExpiredExternalIntentIPsAreCleanedUp() {
+	ctx := context.Background()
+	s.store.LogExternalTrafficIntentsCallback(ctx, []externaltrafficholder.TimestampedExternalTrafficIntent{
+		externalTrafficIntent("frontend", "old.example.com", "8.8.8.8", time.Now().AddDate(0, 0, -100)),
+	})
+	s.Require().NoError(s.store.CleanupExpiredIntents(ctx))
+
+	var count int
+	s.Require().NoError(s.store.Db.QueryRow(`SELECT COUNT(*) FROM external_traffic_intent_ips`).Scan(&count))
+	s.Require().Zero(count)
+}
+
+func (s *SQLIntentStoreSuite) TestExternalIntentsFilters() {
+	ctx := context.Background()
+	now := time.Now()
+	lastWeek := now.AddDate(0, 0, -7)
+	s.store.LogExternalTrafficIntentsCallback(ctx, []externaltrafficholder.TimestampedExternalTrafficIntent{
+		externalTrafficIntent("frontend", "api.example.com", "8.8.8.8", now),
+		externalTrafficIntent("frontend", "cdn.example.com", "8.8.8.8", lastWeek),
+		externalTrafficIntent("backend", "example.com", "8.8.8.8", now),
+		externalTrafficIntent("backend", "api_example.com", "8.8.8.8", now),
+	})
+
+	dnsNames := func(filter ExternalIntentsFilter) []string {
+		page, err := s.store.GetExternalIntents(ctx, filter, 0, "")
+		s.Require().NoError(err)
+		return lo.Map(page.Intents, func(record ExternalIntentRecord, _ int) string { return record.DNSName })
+	}
+
+	s.Require().Len(dnsNames(ExternalIntentsFilter{}), 4)
+	s.Require().ElementsMatch([]string{"api.example.com", "cdn.example.com"}, dnsNames(ExternalIntentsFilter{ClientNames: []string{"frontend"}}))
+	s.Require().Empty(dnsNames(ExternalIntentsFilter{Namespaces: []string{"staging"}}))
+	s.Require().Len(dnsNames(ExternalIntentsFilter{Namespaces: []string{"production"}, ClientKinds: []string{"Deployment"}}), 4)
+	// Wildcards match subdomains only, and '_' is not treated as a LIKE wildcard.
+	s.Require().ElementsMatch([]string{"api.example.com", "cdn.example.com"}, dnsNames(ExternalIntentsFilter{DNSName: "*.example.com"}))
+	s.Require().Equal([]string{"example.com"}, dnsNames(ExternalIntentsFilter{DNSName: "example.com"}))
+	s.Require().Equal([]string{"cdn.example.com"}, dnsNames(ExternalIntentsFilter{LastSeenTo: lo.ToPtr(now.AddDate(0, 0, -1))}))
+	s.Require().Len(dnsNames(ExternalIntentsFilter{LastSeenFrom: lo.ToPtr(now)}), 3)
+}
+
+func (s *SQLIntentStoreSuite) TestExternalIntentsPagination() {
+	ctx := context.Background()
+	now := time.Now()
+	intents := make([]externaltrafficholder.TimestampedExternalTrafficIntent, 0)
+	for i := 0; i < 5; i++ {
+		intents = append(intents, externalTrafficIntent("frontend", fmt.Sprintf("api-%d.example.com", i), "8.8.8.8", now.AddDate(0, 0, -i%2)))
+	}
+	s.store.LogExternalTrafficIntentsCallback(ctx, intents)
+
+	seen := make([]string, 0)
+	cursor := ""
+	for pages := 0; ; pages++ {
+		s.Require().Less(pages, 3)
+		page, err := s.store.GetExternalIntents(ctx, ExternalIntentsFilter{}, 2, cursor)
+		s.Require().NoError(err)
+		s.Require().LessOrEqual(len(page.Intents), 2)
+		for _, record := range page.Intents {
+			seen = append(seen, record.DNSName)
+		}
+		if page.NextCursor == "" {
+			break
+		}
+		cursor = page.NextCursor
+	}
+	s.Require().ElementsMatch(lo.Map(intents, func(intent externaltrafficholder.TimestampedExternalTrafficIntent, _ int) string {
+		return intent.Intent.DNSName
+	}), seen)
+	// Most recently seen first.
+	s.Require().Equal([]string{"api-4.example.com", "api-2.example.com", "api-0.example.com"}, seen[:3])
+
+	_, err := s.store.GetExternalIntents(ctx, ExternalIntentsFilter{}, 2, "not-a-cursor")
+	s.Require().True(errors.Is(err, ErrInvalidCursor))
+}
+
+func (s *SQLIntentStoreSuite) TestExternalIntentStatus() {
+	ctx := context.Background()
+	now := time.Now()
+	s.store.LogExternalTrafficIntentsCallback(ctx, []externaltrafficholder.TimestampedExternalTrafficIntent{
+		externalTrafficIntent("frontend", "api.example.com", "8.8.8.8", now),
+		externalTrafficIntent("frontend", "cdn.example.com", "8.8.8.8", now),
+		externalTrafficIntent("frontend", "tracker.example.com", "8.8.8.8", now),
+	})
+	key := func(dnsName string) ExternalIntentKey {
+		return ExternalIntentKey{ClientName: "frontend", ClientNamespace: "production", ClientKind: "Deployment", DNSName: dnsName}
+	}
+
+	approved, err := s.store.SetExternalIntentStatus(ctx, key("api.example.com"), ExternalIntentStatusApproved, "")
+	s.Require().NoError(err)
+	s.Require().Equal(ExternalIntentStatusApproved, approved.Status)
+	s.Require().Empty(approved.StatusReason)
+	s.Require().NotNil(approved.StatusUpdatedAt)
+
+	denied, err := s.store.SetExternalIntentStatus(ctx, key("tracker.example.com"), ExternalIntentStatusDenied, "ad tracking")
+	s.Require().NoError(err)
+	s.Require().Equal(ExternalIntentStatusDenied, denied.Status)
+	s.Require().Equal("ad tracking", denied.StatusReason)
+
+	// Wildcard DNS names are matched exactly, rather than as wildcards.
+	_, err = s.store.SetExternalIntentStatus(ctx, key("*.example.com"), ExternalIntentStatusApproved, "")
+	s.Require().True(errors.Is(err, ErrExternalIntentNotFound))
+	s.store.LogExternalTrafficIntentsCallback(ctx, []externaltrafficholder.TimestampedExternalTrafficIntent{
+		externalTrafficIntent("frontend", "*.example.com", "8.8.8.8", now),
+	})
+	wildcard, err := s.store.SetExternalIntentStatus(ctx, key("*.example.com"), ExternalIntentStatusApproved, "")
+	s.Require().NoError(err)
+	s.Require().Equal("*.example.com", wildcard.DNSName)
+	s.Require().Equal(ExternalIntentStatusApproved, wildcard.Status)
+
+	statuses := func(filter ExternalIntentsFilter) map[string]string {
+		page, err := s.store.GetExternalIntents(ctx, filter, 0, "")
+		s.Require().NoError(err)
+		return lo.SliceToMap(page.Intents, func(record ExternalIntentRecord) (string, string) { return record.DNSName, record.Status })
+	}
+	s.Require().Equal(map[string]string{
+		"api.example.com":     ExternalIntentStatusApproved,
+		"cdn.example.com":     ExternalIntentStatusPending,
+		"tracker.example.com": ExternalIntentStatusDenied,
+		"*.example.com":       ExternalIntentStatusApproved,
+	}, statuses(ExternalIntentsFilter{}))
+	s.Require().Equal(map[string]string{"cdn.example.com": ExternalIntentStatusPending}, statuses(ExternalIntentsFilter{Statuses: []string{ExternalIntentStatusPending}}))
+
+	_, err = s.store.SetExternalIntentStatus(ctx, key("unknown.example.com"), ExternalIntentStatusApproved, "")
+	s.Require().True(errors.Is(err, ErrExternalIntentNotFound))
+	_, err = s.store.SetExternalIntentStatus(ctx, key("api.example.com"), "maybe", "")
+	s.Require().Error(err)
+}
+
+func TestHasExternalIP(t *testing.T) {
+	for ip, expected := range map[string]bool{
+		"8.8.8.8":              true,
+		"10.0.0.1":             false,
+		"2001:4860:4860::8888": true,
+		"fd00:10:244::5":       false,
+		"fe80::1":              false,
+		"::1":                  false,
+	} {
+		require.Equal(t, expected, HasExternalIP(map[externaltrafficholder.IP]struct{}{externaltrafficholder.IP(ip): {}}), ip)
+	}
+}
+
+func (s *SQLIntentStoreSuite) TestInternalIntentsRoundTrip() {
+	ctx := context.Background()
+	timestamp := time.Date(2024, 4, 1, 12, 0, 0, 0, time.UTC)
+	intent := model.Intent{
+		Client: &model.OtterizeServiceIdentity{Name: "client", Namespace: "ns"},
+		Server: &model.OtterizeServiceIdentity{Name: "server", Namespace: "other-ns"},
+	}
+	s.store.LogIntentsCallback(ctx, []intentsstore.TimestampedIntent{{Timestamp: timestamp, Intent: intent}})
+
+	intents, err := s.store.LoadIntents(ctx)
+	s.Require().NoError(err)
+	s.Require().Len(intents, 1)
+	s.Require().True(timestamp.Equal(intents[0].Timestamp))
+	s.Require().Equal(intent.Client.Name, intents[0].Intent.Client.Name)
+	s.Require().Equal(intent.Client.Namespace, intents[0].Intent.Client.Namespace)
+	s.Require().Equal(intent.Server.Name, intents[0].Intent.Server.Name)
+	s.Require().Equal(intent.Server.Namespace, intents[0].Intent.Server.Namespace)
+
+	// A later report of the same intent only moves its timestamp forward
+	s.store.LogIntentsCallback(ctx, []intentsstore.TimestampedIntent{{Timestamp: timestamp.Add(time.Hour), Intent: intent}})
+	intents, err = s.store.LoadIntents(ctx)
+	s.Require().NoError(err)
+	s.Require().Len(intents, 1)
+	s.Require().True(timestamp.Add(time.Hour).Equal(intents[0].Timestamp))
+}
+
+func httpIntent(path string, method model.HTTPMethod) model.Intent {
+	return model.Intent{
+		Client: &model.OtterizeServiceIdentity{Name: "client", Namespace: "ns"},
+		Server: &model.OtterizeServiceIdentity{Name: "server", Namespace: "ns"},
+		Type:   lo.ToPtr(model.IntentTypeHTTP),
+		HTTPResources: []model.HTTPResource{
+			{Path: path, Methods: []model.HTTPMethod{method}},
+		},
+	}
+}
+
+func (s *SQLIntentStoreSuite) TestInternalIntentsAreMergedAndLoaded() {
+	ctx := context.Background()
+	timestamp := time.Date(2024, 4, 1, 12, 0, 0, 0, time.UTC)
+	s.store.LogIntentsCallback(ctx, []intentsstore.TimestampedIntent{
+		{Timestamp: timestamp, Intent: httpIntent("/orders", model.HTTPMethodGet)},
+	})
+	s.store.LogIntentsCallback(ctx, []intentsstore.TimestampedIntent{
+		{Timestamp: timestamp.Add(time.Hour), Intent: httpIntent("/payments", model.HTTPMethodPost)},
+	})
+
+	intents, err := s.store.LoadIntents(ctx)
+	s.Require().NoError(err)
+	s.Require().Len(intents, 1)
+	s.Require().True(timestamp.Add(time.Hour).Equal(intents[0].Timestamp))
+	s.Require().Equal(model.IntentTypeHTTP, lo.FromPtr(intents[0].Intent.Type))
+	s.Require().ElementsMatch([]string{"/orders", "/payments"}, lo.Map(intents[0].Intent.HTTPResources, func(resource model.HTTPResource, _ int) string {
+		return resource.Path
+	}))
+
+	s.Require().NoError(s.store.ResetIntents(ctx))
+	intents, err = s.store.LoadIntents(ctx)
+	s.Require().NoError(err)
+	s.Require().Empty(intents)
+}
+
+func (s *SQLIntentStoreSuite) TestInternalIntentsAreStoredPerProtocol() {
+	ctx := context.Background()
+	timestamp := time.Date(2024, 4, 1, 12, 0, 0, 0, time.UTC)
+	tcpIntent := model.Intent{
+		Client: &model.OtterizeServiceIdentity{Name: "client", Namespace: "ns"},
+		Server: &model.OtterizeServiceIdentity{Name: "server", Namespace: "ns"},
+	}
+	udpIntent := tcpIntent
+	udpIntent.Protocol = lo.ToPtr(model.IntentProtocolUDP)
+	s.store.LogIntentsCallback(ctx, []intentsstore.TimestampedIntent{
+		{Timestamp: timestamp, Intent: tcpIntent},
+		{Timestamp: timestamp, Intent: udpIntent},
+	})
+
+	intents, err := s.store.LoadIntents(ctx)
+	s.Require().NoError(err)
+	s.Require().ElementsMatch([]*model.IntentProtocol{nil, lo.ToPtr(model.IntentProtocolUDP)}, lo.Map(intents, func(intent intentsstore.TimestampedIntent, _ int) *model.IntentProtocol {
+		return intent.Intent.Protocol
+	}))
+}
+
+func TestSQLIntentStoreSuite(t *testing.T) {
+	suite.Run(t, new(SQLIntentStoreSuite))
+}
+
+func TestPostgresRebind(t *testing.T) {
+	query := postgresDialect{}.rebind("SELECT 1 FROM t WHERE a = ? AND b = ?")
+	require.Equal(t, "SELECT 1 FROM t WHERE a = $1 AND b = $2", query)
+}
+
+func TestUnsupportedDriver(t *testing.T) {
+	_, err := dialectForDriver("oracle")
+	require.Error(t, err)
+}
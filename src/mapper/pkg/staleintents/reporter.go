@@ -0,0 +1,279 @@
+package staleintents
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	otterizev2alpha1 "github.com/otterize/intents-operator/src/operator/api/v2alpha1"
+	"github.com/otterize/intents-operator/src/shared/errors"
+	"github.com/otterize/network-mapper/src/mapper/pkg/config"
+	"github.com/otterize/network-mapper/src/mapper/pkg/externaltrafficholder"
+	"github.com/otterize/network-mapper/src/mapper/pkg/graph/model"
+	"github.com/otterize/network-mapper/src/mapper/pkg/intentsstore"
+	"github.com/otterize/network-mapper/src/mapper/pkg/prometheus"
+	"github.com/otterize/network-mapper/src/mapper/pkg/sqlstore"
+	"github.com/samber/lo"
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/viper"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"slices"
+	"strings"
+	"time"
+)
+
+var (
+	ErrInvalidDays = errors.NewSentinelError("days must be positive")
+	ErrNotEnabled  = errors.NewSentinelError("the stale intents report requires the ClientIntents CRD to be installed")
+)
+
+// Reporter finds the targets declared in ClientIntents resources that their clients were not seen accessing recently.
+// Traffic to services and Kafka servers is looked up in the intents holder, which includes persisted intents when
+// they are enabled, and traffic to internet domains in the external traffic holder and the store.
+type Reporter struct {
+	k8sClient             client.Client
+	intentsHolder         *intentsstore.IntentsHolder
+	externalTrafficHolder *externaltrafficholder.ExternalTrafficIntentsHolder
+	store                 sqlstore.IntentStore
+	now                   func() time.Time
+}
+
+// NewReporter creates a Reporter. store may be nil when the database is not enabled.
+func NewReporter(
+	k8sClient client.Client,
+	intentsHolder *intentsstore.IntentsHolder,
+	externalTrafficHolder *externaltrafficholder.ExternalTrafficIntentsHolder,
+	store sqlstore.IntentStore,
+) *Reporter {
+	return &Reporter{
+		k8sClient:             k8sClient,
+		intentsHolder:         intentsHolder,
+		externalTrafficHolder: externalTrafficHolder,
+		store:                 store,
+		now:                   time.Now,
+	}
+}
+
+// serverKey identifies traffic from a client to a workload, or to a Kubernetes service when isService is set.
+type serverKey struct {
+	clientName      string
+	clientNamespace string
+	serverName      string
+	serverNamespace string
+	isService       bool
+}
+
+// observedTraffic holds when each client was last seen accessing in-cluster servers.
+type observedTraffic struct {
+	servers map[serverKey]time.Time
+	// kafkaTopics holds the last seen time of the intent each topic was seen in. Topics of the same intent share it.
+	kafkaTopics map[serverKey]map[string]time.Time
+}
+
+func newObservedTraffic(intents []intentsstore.TimestampedIntent) observedTraffic {
+	observed := observedTraffic{
+		servers:     make(map[serverKey]time.Time),
+		kafkaTopics: make(map[serverKey]map[string]time.Time),
+	}
+	for _, intent := range intents {
+		if intent.Intent.Client == nil || intent.Intent.Server == nil {
+			continue
+		}
+		key := serverKey{
+			clientName:      intent.Intent.Client.Name,
+			clientNamespace: intent.Intent.Client.Namespace,
+			serverName:      intent.Intent.Server.Name,
+			serverNamespace: intent.Intent.Server.Namespace,
+		}
+		observed.servers[key] = latest(observed.servers[key], intent.Timestamp)
+		if intent.Intent.Server.KubernetesService != nil {
+			serviceKey := key
+			serviceKey.serverName = *intent.Intent.Server.KubernetesService
+			serviceKey.isService = true
+			observed.servers[serviceKey] = latest(observed.servers[serviceKey], intent.Timestamp)
+		}
+		if len(intent.Intent.KafkaTopics) > 0 {
+			if _, ok := observed.kafkaTopics[key]; !ok {
+				observed.kafkaTopics[key] = make(map[string]time.Time)
+			}
+			for _, topic := range intent.Intent.KafkaTopics {
+				observed.kafkaTopics[key][topic.Name] = latest(observed.kafkaTopics[key][topic.Name], intent.Timestamp)
+			}
+		}
+	}
+	return observed
+}
+
+// kafkaTopicLastSeen returns when a topic matching the declared topic was last seen. A declared topic ending with '*'
+// matches all topics with its prefix.
+func (o observedTraffic) kafkaTopicLastSeen(key serverKey, declaredTopic string) time.Time {
+	var lastSeen time.Time
+	for topic, seen := range o.kafkaTopics[key] {
+		if prefix, isPattern := strings.CutSuffix(declaredTopic, "*"); topic == declaredTopic || (isPattern && strings.HasPrefix(topic, prefix)) {
+			lastSeen = latest(lastSeen, seen)
+		}
+	}
+	return lastSeen
+}
+
+func latest(a time.Time, b time.Time) time.Time {
+	if b.After(a) {
+		return b
+	}
+	return a
+}
+
+// Report returns the targets that were not seen for the past days, declared in the ClientIntents resources of
+// namespaces, or of all namespaces if it is empty. A target is only stale once its ClientIntents is older than days as
+// well. Internet targets are matched by domain, and SQL and cloud targets are not reported. The result is ordered by
+// namespace and ClientIntents name, and by declaration order within a ClientIntents.
+func (r *Reporter) Report(ctx context.Context, days int, namespaces []string) ([]model.StaleIntent, error) {
+	if days <= 0 {
+		return nil, errors.Wrap(ErrInvalidDays)
+	}
+	var intentsList otterizev2alpha1.ClientIntentsList
+	if err := r.k8sClient.List(ctx, &intentsList); err != nil {
+		return nil, errors.Wrap(err)
+	}
+	clientIntentsList := lo.Filter(intentsList.Items, func(clientIntents otterizev2alpha1.ClientIntents, _ int) bool {
+		return clientIntents.Spec != nil && (len(namespaces) == 0 || lo.Contains(namespaces, clientIntents.Namespace))
+	})
+	slices.SortFunc(clientIntentsList, func(a, b otterizev2alpha1.ClientIntents) int {
+		return cmp.Or(cmp.Compare(a.Namespace, b.Namespace), cmp.Compare(a.Name, b.Name))
+	})
+
+	intents, err := r.intentsHolder.GetIntents(nil, nil, nil, false, nil)
+	if err != nil {
+		return nil, errors.Wrap(err)
+	}
+	observed := newObservedTraffic(intents)
+
+	threshold := r.now().AddDate(0, 0, -days)
+	staleIntents := make([]model.StaleIntent, 0)
+	for _, clientIntents := range clientIntentsList {
+		declaredAt := clientIntents.CreationTimestamp.Time
+		newStaleIntent := func(targetType model.StaleIntentTargetType, target string, lastSeen time.Time) (model.StaleIntent, bool) {
+			if latest(declaredAt, lastSeen).After(threshold) {
+				return model.StaleIntent{}, false
+			}
+			staleIntent := model.StaleIntent{
+				ClientIntentsName: clientIntents.Name,
+				Namespace:         clientIntents.Namespace,
+				Client:            clientIntents.GetWorkloadName(),
+				ClientKind:        clientIntents.GetClientKind(),
+				TargetType:        targetType,
+				Target:            target,
+				DeclaredAt:        declaredAt,
+			}
+			if !lastSeen.IsZero() {
+				staleIntent.LastSeen = lo.ToPtr(lastSeen)
+			}
+			return staleIntent, true
+		}
+
+		for _, target := range clientIntents.GetTargetList() {
+			switch {
+			case target.Internet != nil:
+				for _, domain := range target.Internet.Domains {
+					lastSeen, err := r.domainLastSeen(ctx, clientIntents, domain)
+					if err != nil {
+						return nil, errors.Wrap(err)
+					}
+					if staleIntent, ok := newStaleIntent(model.StaleIntentTargetTypeInternet, domain, lastSeen); ok {
+						staleIntents = append(staleIntents, staleIntent)
+					}
+				}
+			case target.IsTargetInCluster():
+				key := serverKey{
+					clientName:      clientIntents.GetWorkloadName(),
+					clientNamespace: clientIntents.Namespace,
+					serverName:      target.GetTargetServerName(),
+					serverNamespace: target.GetTargetServerNamespace(clientIntents.Namespace),
+					isService:       target.IsTargetServerKubernetesService(),
+				}
+				targetName := fmt.Sprintf("%s.%s", key.serverName, key.serverNamespace)
+				if target.Kafka == nil || len(target.Kafka.Topics) == 0 {
+					targetType := lo.Ternary(target.Kafka != nil, model.StaleIntentTargetTypeKafka, model.StaleIntentTargetTypeService)
+					if staleIntent, ok := newStaleIntent(targetType, targetName, observed.servers[key]); ok {
+						staleIntents = append(staleIntents, staleIntent)
+					}
+					continue
+				}
+				for _, topic := range target.Kafka.Topics {
+					if staleIntent, ok := newStaleIntent(model.StaleIntentTargetTypeKafka, targetName, observed.kafkaTopicLastSeen(key, topic.Name)); ok {
+						staleIntent.KafkaTopic = lo.ToPtr(topic.Name)
+						staleIntents = append(staleIntents, staleIntent)
+					}
+				}
+			}
+		}
+	}
+	return staleIntents, nil
+}
+
+// domainLastSeen returns when the client of clientIntents was last seen accessing the domain, or any of its subdomains
+// if it is a wildcard. The store only records the date traffic was last seen on.
+func (r *Reporter) domainLastSeen(ctx context.Context, clientIntents otterizev2alpha1.ClientIntents, domain string) (time.Time, error) {
+	var lastSeen time.Time
+	for _, intent := range r.externalTrafficHolder.GetIntents() {
+		if intent.Client.Name == clientIntents.GetWorkloadName() && intent.Client.Namespace == clientIntents.Namespace && domainMatches(domain, intent.DNSName) {
+			lastSeen = latest(lastSeen, intent.LastSeen)
+		}
+	}
+	if r.store == nil {
+		return lastSeen, nil
+	}
+	// External intents are ordered by last seen, so the first one is the most recent.
+	page, err := r.store.GetExternalIntents(ctx, sqlstore.ExternalIntentsFilter{
+		Namespaces:  []string{clientIntents.Namespace},
+		ClientNames: []string{clientIntents.GetWorkloadName()},
+		DNSName:     domain,
+	}, 1, "")
+	if err != nil {
+		return time.Time{}, errors.Wrap(err)
+	}
+	if len(page.Intents) > 0 {
+		lastSeen = latest(lastSeen, page.Intents[0].LastSeen)
+	}
+	return lastSeen, nil
+}
+
+func domainMatches(declared string, dnsName string) bool {
+	if suffix, isWildcard := strings.CutPrefix(declared, "*"); isWildcard {
+		return strings.HasSuffix(dnsName, suffix)
+	}
+	return declared == dnsName
+}
+
+// RunForever refreshes the stale intent gauges every interval, using the configured threshold.
+func (r *Reporter) RunForever(ctx context.Context) error {
+	ticker := time.NewTicker(viper.GetDuration(config.StaleIntentsReportIntervalKey))
+	defer ticker.Stop()
+	for {
+		r.updateMetrics(ctx)
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+func (r *Reporter) updateMetrics(ctx context.Context) {
+	staleIntents, err := r.Report(ctx, viper.GetInt(config.StaleIntentsDaysKey), nil)
+	if err != nil {
+		logrus.WithError(err).Warn("Failed to report stale intents")
+		return
+	}
+	prometheus.SetStaleIntentTargets(lo.Map(staleIntents, func(staleIntent model.StaleIntent, _ int) prometheus.StaleIntentTarget {
+		return prometheus.StaleIntentTarget{
+			Namespace:         staleIntent.Namespace,
+			ClientIntentsName: staleIntent.ClientIntentsName,
+			Client:            staleIntent.Client,
+			TargetType:        strings.ToLower(staleIntent.TargetType.String()),
+			Target:            staleIntent.Target,
+			KafkaTopic:        lo.FromPtr(staleIntent.KafkaTopic),
+			LastSeen:          lo.FromPtr(staleIntent.LastSeen),
+		}
+	}))
+}
@@ -0,0 +1,175 @@
+package staleintents
+
+import (
+	"context"
+	otterizev2alpha1 "github.com/otterize/intents-operator/src/operator/api/v2alpha1"
+	"github.com/otterize/intents-operator/src/shared/errors"
+	"github.com/otterize/network-mapper/src/mapper/pkg/externaltrafficholder"
+	"github.com/otterize/network-mapper/src/mapper/pkg/graph/model"
+	"github.com/otterize/network-mapper/src/mapper/pkg/intentsstore"
+	"github.com/otterize/network-mapper/src/mapper/pkg/sqlstore"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/suite"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"testing"
+	"time"
+)
+
+// now is close to the actual time, as the store drops external intents older than its retention.
+var now = time.Now().UTC().Truncate(time.Hour)
+
+type ReporterSuite struct {
+	suite.Suite
+	intentsHolder         *intentsstore.IntentsHolder
+	externalTrafficHolder *externaltrafficholder.ExternalTrafficIntentsHolder
+	store                 *sqlstore.SQLIntentStore
+}
+
+func (s *ReporterSuite) SetupTest() {
+	store, err := sqlstore.NewSQLIntentStore(sqlstore.Config{
+		DbDriver:             sqlstore.DriverSQLite,
+		DbSQLitePath:         ":memory:",
+		RetentionDays:        90,
+		IntentsRetentionDays: 90,
+	})
+	s.Require().NoError(err)
+	s.store = store
+	s.intentsHolder = intentsstore.NewIntentsHolder()
+	s.externalTrafficHolder = externaltrafficholder.NewExternalTrafficIntentsHolder()
+}
+
+func (s *ReporterSuite) TearDownTest() {
+	s.Require().NoError(s.store.Close())
+}
+
+func (s *ReporterSuite) newReporter(clientIntents ...otterizev2alpha1.ClientIntents) *Reporter {
+	scheme := runtime.NewScheme()
+	s.Require().NoError(otterizev2alpha1.AddToScheme(scheme))
+	k8sClient := fake.NewClientBuilder().WithScheme(scheme).WithLists(&otterizev2alpha1.ClientIntentsList{Items: clientIntents}).Build()
+	reporter := NewReporter(k8sClient, s.intentsHolder, s.externalTrafficHolder, s.store)
+	reporter.now = func() time.Time { return now }
+	return reporter
+}
+
+func newClientIntents(name string, createdDaysAgo int, targets ...otterizev2alpha1.Target) otterizev2alpha1.ClientIntents {
+	return otterizev2alpha1.ClientIntents{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              name,
+			Namespace:         "shop",
+			CreationTimestamp: metav1.NewTime(now.AddDate(0, 0, -createdDaysAgo)),
+		},
+		Spec: &otterizev2alpha1.IntentsSpec{
+			Workload: otterizev2alpha1.Workload{Name: "checkout", Kind: "Deployment"},
+			Targets:  targets,
+		},
+	}
+}
+
+func (s *ReporterSuite) addIntent(daysAgo int, server model.OtterizeServiceIdentity, kafkaTopics ...string) {
+	intent := model.Intent{
+		Client: &model.OtterizeServiceIdentity{Name: "checkout", Namespace: "shop"},
+		Server: &server,
+	}
+	if len(kafkaTopics) > 0 {
+		intent.Type = lo.ToPtr(model.IntentTypeKafka)
+		intent.KafkaTopics = lo.Map(kafkaTopics, func(topic string, _ int) model.KafkaConfig { return model.KafkaConfig{Name: topic} })
+	}
+	s.intentsHolder.AddIntent(now.AddDate(0, 0, -daysAgo), intent, nil)
+}
+
+func (s *ReporterSuite) TestServiceTargets() {
+	s.addIntent(2, model.OtterizeServiceIdentity{Name: "cart", Namespace: "shop"})
+	s.addIntent(40, model.OtterizeServiceIdentity{Name: "payments-7d9f", Namespace: "billing", KubernetesService: lo.ToPtr("payments")})
+	reporter := s.newReporter(newClientIntents("checkout", 100,
+		otterizev2alpha1.Target{Kubernetes: &otterizev2alpha1.KubernetesTarget{Name: "cart"}},
+		otterizev2alpha1.Target{Service: &otterizev2alpha1.ServiceTarget{Name: "payments.billing"}},
+		otterizev2alpha1.Target{Kubernetes: &otterizev2alpha1.KubernetesTarget{Name: "inventory"}},
+	))
+
+	staleIntents, err := reporter.Report(context.Background(), 30, nil)
+	s.Require().NoError(err)
+	s.Require().Len(staleIntents, 2)
+	s.Require().Equal("payments.billing", staleIntents[0].Target)
+	s.Require().Equal(model.StaleIntentTargetTypeService, staleIntents[0].TargetType)
+	s.Require().Equal("checkout", staleIntents[0].Client)
+	s.Require().Equal("Deployment", staleIntents[0].ClientKind)
+	s.Require().True(now.AddDate(0, 0, -40).Equal(lo.FromPtr(staleIntents[0].LastSeen)))
+	s.Require().Equal("inventory.shop", staleIntents[1].Target)
+	s.Require().Nil(staleIntents[1].LastSeen)
+
+	staleIntents, err = reporter.Report(context.Background(), 60, nil)
+	s.Require().NoError(err)
+	s.Require().Len(staleIntents, 1)
+	s.Require().Equal("inventory.shop", staleIntents[0].Target)
+}
+
+func (s *ReporterSuite) TestRecentlyDeclaredTargetsAreNotStale() {
+	reporter := s.newReporter(newClientIntents("checkout", 10,
+		otterizev2alpha1.Target{Kubernetes: &otterizev2alpha1.KubernetesTarget{Name: "inventory"}},
+	))
+
+	staleIntents, err := reporter.Report(context.Background(), 30, nil)
+	s.Require().NoError(err)
+	s.Require().Empty(staleIntents)
+
+	staleIntents, err = reporter.Report(context.Background(), 7, []string{"other"})
+	s.Require().NoError(err)
+	s.Require().Empty(staleIntents)
+
+	_, err = reporter.Report(context.Background(), 0, nil)
+	s.Require().True(errors.Is(err, ErrInvalidDays))
+}
+
+func (s *ReporterSuite) TestKafkaTopics() {
+	kafka := model.OtterizeServiceIdentity{Name: "kafka", Namespace: "kafka"}
+	s.addIntent(1, kafka, "orders", "legacy-orders")
+	reporter := s.newReporter(newClientIntents("checkout", 100,
+		otterizev2alpha1.Target{Kafka: &otterizev2alpha1.KafkaTarget{Name: "kafka.kafka", Topics: []otterizev2alpha1.KafkaTopic{
+			{Name: "orders"},
+			{Name: "legacy-*"},
+			{Name: "refunds"},
+		}}},
+	))
+
+	staleIntents, err := reporter.Report(context.Background(), 30, nil)
+	s.Require().NoError(err)
+	s.Require().Len(staleIntents, 1)
+	s.Require().Equal(model.StaleIntentTargetTypeKafka, staleIntents[0].TargetType)
+	s.Require().Equal("kafka.kafka", staleIntents[0].Target)
+	s.Require().Equal("refunds", lo.FromPtr(staleIntents[0].KafkaTopic))
+	s.Require().Nil(staleIntents[0].LastSeen)
+}
+
+func (s *ReporterSuite) TestInternetDomains() {
+	client := model.OtterizeServiceIdentity{Name: "checkout", Namespace: "shop"}
+	s.store.LogExternalTrafficIntentsCallback(context.Background(), []externaltrafficholder.TimestampedExternalTrafficIntent{{
+		Timestamp: now.AddDate(0, 0, -3),
+		Intent: externaltrafficholder.ExternalTrafficIntent{
+			Client:   client,
+			LastSeen: now.AddDate(0, 0, -3),
+			DNSName:  "api.stripe.com",
+			IPs:      map[externaltrafficholder.IP]struct{}{"8.8.8.8": {}},
+		},
+	}})
+	s.externalTrafficHolder.AddIntent(externaltrafficholder.ExternalTrafficIntent{
+		Client:   client,
+		LastSeen: now,
+		DNSName:  "eu.api.example.com",
+		IPs:      map[externaltrafficholder.IP]struct{}{"8.8.4.4": {}},
+	})
+	reporter := s.newReporter(newClientIntents("checkout", 100,
+		otterizev2alpha1.Target{Internet: &otterizev2alpha1.Internet{Domains: []string{"api.stripe.com", "*.example.com", "api.paypal.com"}}},
+	))
+
+	staleIntents, err := reporter.Report(context.Background(), 30, nil)
+	s.Require().NoError(err)
+	s.Require().Len(staleIntents, 1)
+	s.Require().Equal("api.paypal.com", staleIntents[0].Target)
+	s.Require().Equal(model.StaleIntentTargetTypeInternet, staleIntents[0].TargetType)
+}
+
+func TestReporterSuite(t *testing.T) {
+	suite.Run(t, new(ReporterSuite))
+}
@@ -4,16 +4,18 @@ import (
 	"context"
 	"github.com/Khan/genqlient/graphql"
 	"github.com/otterize/intents-operator/src/shared/errors"
+	"github.com/otterize/network-mapper/src/mappergrpc/ingestpb"
 	"github.com/sirupsen/logrus"
-	"net/http"
 	"strings"
 )
 
 type Client struct {
 	client graphql.Client
+	// ingest is set when reports are sent over gRPC, see WithGRPCIngest.
+	ingest ingestpb.IngestClient
 }
 
-func New(address string) *Client {
+func New(address string, opts ...Option) *Client {
 	// some usages of this lib pass /query, some don't
 	if !strings.HasSuffix(address, "/query") {
 		address = address + "/query"
@@ -21,49 +23,117 @@ func New(address string) *Client {
 
 	logrus.Infof("Connecting to network-mapper at %s", address)
 
-	return &Client{
-		client: graphql.NewClient(address, http.DefaultClient),
+	options := &clientOptions{}
+	for _, opt := range opts {
+		opt(options)
 	}
+	if options.err != nil {
+		logrus.WithError(options.err).Error("Failed to set up network-mapper client certificate, connecting without it")
+	}
+
+	c := &Client{
+		client: graphql.NewClient(address, options.newHTTPClient()),
+	}
+	if options.grpcAddress != "" {
+		ingest, err := options.newIngestClient()
+		if err != nil {
+			logrus.WithError(err).Errorf("Failed to set up gRPC connection to network-mapper at %s, reporting over GraphQL", options.grpcAddress)
+			return c
+		}
+		logrus.Infof("Reporting to network-mapper over gRPC at %s", options.grpcAddress)
+		c.ingest = ingest
+	}
+	return c
 }
 
 func (c *Client) ReportAWSOperation(ctx context.Context, operation []AWSOperation) error {
-	_, err := reportAWSOperation(ctx, c.client, operation)
-	return errors.Wrap(err)
+	return reportWithRetryAfter(ctx, func() error {
+		if c.ingest != nil {
+			return streamReport(ctx, c.ingest.ReportAWSOperation, operation, awsOperationsToProto)
+		}
+		_, err := reportAWSOperation(ctx, c.client, operation)
+		return errors.Wrap(err)
+	})
 }
 
 func (c *Client) ReportGCPOperation(ctx context.Context, operation []GCPOperation) error {
-	_, err := reportGCPOperation(ctx, c.client, operation)
-	return errors.Wrap(err)
+	return reportWithRetryAfter(ctx, func() error {
+		if c.ingest != nil {
+			return streamReport(ctx, c.ingest.ReportGCPOperation, operation, gcpOperationsToProto)
+		}
+		_, err := reportGCPOperation(ctx, c.client, operation)
+		return errors.Wrap(err)
+	})
 }
 
 func (c *Client) ReportAzureOperation(ctx context.Context, operation []AzureOperation) error {
-	_, err := reportAzureOperation(ctx, c.client, operation)
-	return errors.Wrap(err)
+	return reportWithRetryAfter(ctx, func() error {
+		if c.ingest != nil {
+			return streamReport(ctx, c.ingest.ReportAzureOperation, operation, azureOperationsToProto)
+		}
+		_, err := reportAzureOperation(ctx, c.client, operation)
+		return errors.Wrap(err)
+	})
 }
 
 func (c *Client) ReportKafkaMapperResults(ctx context.Context, results KafkaMapperResults) error {
-	_, err := reportKafkaMapperResults(ctx, c.client, results)
-	return errors.Wrap(err)
+	return reportWithRetryAfter(ctx, func() error {
+		if c.ingest != nil {
+			return streamReport(ctx, c.ingest.ReportKafkaMapperResults, results.Results, kafkaMapperResultsToProto)
+		}
+		_, err := reportKafkaMapperResults(ctx, c.client, results)
+		return errors.Wrap(err)
+	})
 }
 
 func (c *Client) ReportCaptureResults(ctx context.Context, results CaptureResults) error {
-	_, err := reportCaptureResults(ctx, c.client, results)
-	return errors.Wrap(err)
+	return reportWithRetryAfter(ctx, func() error {
+		if c.ingest != nil {
+			return streamReport(ctx, c.ingest.ReportCaptureResults, results.Results, captureResultsToProto)
+		}
+		_, err := reportCaptureResults(ctx, c.client, results)
+		return errors.Wrap(err)
+	})
 }
 
 func (c *Client) ReportTCPCaptureResults(ctx context.Context, results CaptureTCPResults) error {
-	_, err := reportTCPCaptureResults(ctx, c.client, results)
-	return err
+	return reportWithRetryAfter(ctx, func() error {
+		if c.ingest != nil {
+			return streamReport(ctx, c.ingest.ReportTCPCaptureResults, results.Results, captureResultsToProto)
+		}
+		_, err := reportTCPCaptureResults(ctx, c.client, results)
+		return errors.Wrap(err)
+	})
+}
+
+func (c *Client) ReportUDPCaptureResults(ctx context.Context, results CaptureUDPResults) error {
+	return reportWithRetryAfter(ctx, func() error {
+		if c.ingest != nil {
+			return streamReport(ctx, c.ingest.ReportUDPCaptureResults, results.Results, captureResultsToProto)
+		}
+		_, err := reportUDPCaptureResults(ctx, c.client, results)
+		return errors.Wrap(err)
+	})
 }
 
 func (c *Client) ReportSocketScanResults(ctx context.Context, results SocketScanResults) error {
-	_, err := reportSocketScanResults(ctx, c.client, results)
-	return errors.Wrap(err)
+	return reportWithRetryAfter(ctx, func() error {
+		if c.ingest != nil {
+			return streamReport(ctx, c.ingest.ReportSocketScanResults, results.Results, captureResultsToProto)
+		}
+		_, err := reportSocketScanResults(ctx, c.client, results)
+		return errors.Wrap(err)
+	})
 }
 
 func (c *Client) ReportTrafficLevels(ctx context.Context, results TrafficLevelResults) error {
-	_, err := reportTrafficLevelResults(ctx, c.client, results)
-	return errors.Wrap(err)
+	return reportWithRetryAfter(ctx, func() error {
+		if c.ingest != nil {
+			return streamReport(ctx, c.ingest.ReportTrafficLevelResults, results.Results, trafficLevelResultsToProto)
+		}
+		_, err := reportTrafficLevelResults(ctx, c.client, results)
+		return errors.Wrap(err)
+	})
 }
 
 func (c *Client) Health(ctx context.Context) error {
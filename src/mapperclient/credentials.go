@@ -0,0 +1,151 @@
+package mapperclient
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"github.com/otterize/intents-operator/src/shared/errors"
+	"net/http"
+	"os"
+	"strings"
+)
+
+type clientOptions struct {
+	grpcAddress string
+	// token returns the bearer token sent with each call, if set.
+	token     func() (string, error)
+	tlsConfig *tls.Config
+	// err is set if the client certificate option failed, and is logged by New.
+	err error
+}
+
+type Option func(*clientOptions)
+
+// WithBearerToken authenticates calls with a static token, for mappers that accept the bearer scheme. An empty token
+// is ignored.
+func WithBearerToken(token string) Option {
+	return func(o *clientOptions) {
+		if token == "" {
+			return
+		}
+		o.token = func() (string, error) {
+			return token, nil
+		}
+	}
+}
+
+// WithServiceAccountToken authenticates calls with the Kubernetes service account token at path, for mappers that
+// accept the tokenreview scheme. The token is read for each call, as the kubelet rotates projected tokens. An empty path
+// is ignored.
+func WithServiceAccountToken(path string) Option {
+	return func(o *clientOptions) {
+		if path == "" {
+			return
+		}
+		o.token = func() (string, error) {
+			token, err := os.ReadFile(path)
+			if err != nil {
+				return "", errors.Wrap(err)
+			}
+			return strings.TrimSpace(string(token)), nil
+		}
+	}
+}
+
+// WithClientCertificate authenticates calls with a client certificate, for mappers that accept the mtls scheme. The
+// mapper's certificate is verified against caFile, or the system roots if caFile is empty. The client certificate is
+// loaded for each connection, so rotated certificates are picked up. An empty certFile is ignored.
+func WithClientCertificate(certFile string, keyFile string, caFile string) Option {
+	return func(o *clientOptions) {
+		if certFile == "" {
+			return
+		}
+		tlsConfig := &tls.Config{
+			MinVersion: tls.VersionTLS12,
+			GetClientCertificate: func(*tls.CertificateRequestInfo) (*tls.Certificate, error) {
+				cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+				if err != nil {
+					return nil, errors.Wrap(err)
+				}
+				return &cert, nil
+			},
+		}
+		if caFile != "" {
+			caPEM, err := os.ReadFile(caFile)
+			if err != nil {
+				o.err = errors.Wrap(err)
+				return
+			}
+			rootCAs := x509.NewCertPool()
+			if !rootCAs.AppendCertsFromPEM(caPEM) {
+				o.err = errors.Errorf("no certificates found in CA file %s", caFile)
+				return
+			}
+			tlsConfig.RootCAs = rootCAs
+		}
+		o.tlsConfig = tlsConfig
+	}
+}
+
+// NewHTTPClient returns an HTTP client that sends the credentials configured by opts, for calls to the mapper that
+// Client doesn't make.
+func NewHTTPClient(opts ...Option) (*http.Client, error) {
+	options := &clientOptions{}
+	for _, opt := range opts {
+		opt(options)
+	}
+	if options.err != nil {
+		return nil, errors.Wrap(options.err)
+	}
+	return options.newHTTPClient(), nil
+}
+
+// newHTTPClient returns the client GraphQL calls are made with, sending the configured credentials.
+func (o *clientOptions) newHTTPClient() *http.Client {
+	if o.token == nil && o.tlsConfig == nil {
+		return http.DefaultClient
+	}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	if o.tlsConfig != nil {
+		transport.TLSClientConfig = o.tlsConfig
+	}
+	if o.token == nil {
+		return &http.Client{Transport: transport}
+	}
+	return &http.Client{Transport: &bearerTokenTransport{token: o.token, next: transport}}
+}
+
+type bearerTokenTransport struct {
+	token func() (string, error)
+	next  http.RoundTripper
+}
+
+func (t *bearerTokenTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	token, err := t.token()
+	if err != nil {
+		return nil, errors.Wrap(err)
+	}
+	// RoundTrippers must not modify the request they are given
+	req = req.Clone(req.Context())
+	req.Header.Set("Authorization", "Bearer "+token)
+	return t.next.RoundTrip(req)
+}
+
+// perRPCToken sends the configured bearer token with each gRPC call.
+type perRPCToken struct {
+	token func() (string, error)
+}
+
+func (t perRPCToken) GetRequestMetadata(_ context.Context, _ ...string) (map[string]string, error) {
+	token, err := t.token()
+	if err != nil {
+		return nil, errors.Wrap(err)
+	}
+	return map[string]string{"authorization": "Bearer " + token}, nil
+}
+
+// RequireTransportSecurity is false as tokens are sent to the mapper's plain gRPC ingest port, over the cluster network
+// in plain text, as they are over GraphQL.
+func (t perRPCToken) RequireTransportSecurity() bool {
+	return false
+}
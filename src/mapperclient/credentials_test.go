@@ -0,0 +1,55 @@
+package mapperclient
+
+import (
+	"context"
+	"github.com/stretchr/testify/suite"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+type CredentialsTestSuite struct {
+	suite.Suite
+	authorization string
+	serverURL     string
+}
+
+func (s *CredentialsTestSuite) SetupTest() {
+	s.authorization = ""
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.authorization = r.Header.Get("Authorization")
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"data": {"reportCaptureResults": true}}`))
+	}))
+	s.T().Cleanup(server.Close)
+	s.serverURL = server.URL
+}
+
+func (s *CredentialsTestSuite) TestNoCredentials() {
+	s.Require().NoError(New(s.serverURL).ReportCaptureResults(context.Background(), CaptureResults{}))
+	s.Require().Empty(s.authorization)
+}
+
+func (s *CredentialsTestSuite) TestBearerToken() {
+	s.Require().NoError(New(s.serverURL, WithBearerToken("token")).ReportCaptureResults(context.Background(), CaptureResults{}))
+	s.Require().Equal("Bearer token", s.authorization)
+}
+
+func (s *CredentialsTestSuite) TestServiceAccountTokenIsReadForEachCall() {
+	tokenFile := filepath.Join(s.T().TempDir(), "token")
+	s.Require().NoError(os.WriteFile(tokenFile, []byte("first\n"), 0600))
+	client := New(s.serverURL, WithServiceAccountToken(tokenFile))
+
+	s.Require().NoError(client.ReportCaptureResults(context.Background(), CaptureResults{}))
+	s.Require().Equal("Bearer first", s.authorization)
+
+	s.Require().NoError(os.WriteFile(tokenFile, []byte("rotated\n"), 0600))
+	s.Require().NoError(client.ReportCaptureResults(context.Background(), CaptureResults{}))
+	s.Require().Equal("Bearer rotated", s.authorization)
+}
+
+func TestCredentialsTestSuite(t *testing.T) {
+	suite.Run(t, new(CredentialsTestSuite))
+}
@@ -70,6 +70,13 @@ type CaptureTCPResults struct {
 // GetResults returns CaptureTCPResults.Results, and is useful for accessing the field via an interface.
 func (v *CaptureTCPResults) GetResults() []RecordedDestinationsForSrc { return v.Results }
 
+type CaptureUDPResults struct {
+	Results []RecordedDestinationsForSrc `json:"results"`
+}
+
+// GetResults returns CaptureUDPResults.Results, and is useful for accessing the field via an interface.
+func (v *CaptureUDPResults) GetResults() []RecordedDestinationsForSrc { return v.Results }
+
 type Destination struct {
 	Destination     string                  `json:"destination"`
 	DestinationIP   nilable.Nilable[string] `json:"destinationIP"`
@@ -281,6 +288,14 @@ type __reportTrafficLevelResultsInput struct {
 // GetResults returns __reportTrafficLevelResultsInput.Results, and is useful for accessing the field via an interface.
 func (v *__reportTrafficLevelResultsInput) GetResults() TrafficLevelResults { return v.Results }
 
+// __reportUDPCaptureResultsInput is used internally by genqlient
+type __reportUDPCaptureResultsInput struct {
+	Results CaptureUDPResults `json:"results"`
+}
+
+// GetResults returns __reportUDPCaptureResultsInput.Results, and is useful for accessing the field via an interface.
+func (v *__reportUDPCaptureResultsInput) GetResults() CaptureUDPResults { return v.Results }
+
 // reportAWSOperationResponse is returned by reportAWSOperation on success.
 type reportAWSOperationResponse struct {
 	ReportAWSOperation bool `json:"reportAWSOperation"`
@@ -353,6 +368,16 @@ func (v *reportTrafficLevelResultsResponse) GetReportTrafficLevelResults() bool
 	return v.ReportTrafficLevelResults
 }
 
+// reportUDPCaptureResultsResponse is returned by reportUDPCaptureResults on success.
+type reportUDPCaptureResultsResponse struct {
+	ReportUDPCaptureResults bool `json:"reportUDPCaptureResults"`
+}
+
+// GetReportUDPCaptureResults returns reportUDPCaptureResultsResponse.ReportUDPCaptureResults, and is useful for accessing the field via an interface.
+func (v *reportUDPCaptureResultsResponse) GetReportUDPCaptureResults() bool {
+	return v.ReportUDPCaptureResults
+}
+
 // The query or mutation executed by Health.
 const Health_Operation = `
 query Health {
@@ -645,3 +670,36 @@ func reportTrafficLevelResults(
 
 	return &data_, err_
 }
+
+// The query or mutation executed by reportUDPCaptureResults.
+const reportUDPCaptureResults_Operation = `
+mutation reportUDPCaptureResults ($results: CaptureUDPResults!) {
+	reportUDPCaptureResults(results: $results)
+}
+`
+
+func reportUDPCaptureResults(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	results CaptureUDPResults,
+) (*reportUDPCaptureResultsResponse, error) {
+	req_ := &graphql.Request{
+		OpName: "reportUDPCaptureResults",
+		Query:  reportUDPCaptureResults_Operation,
+		Variables: &__reportUDPCaptureResultsInput{
+			Results: results,
+		},
+	}
+	var err_ error
+
+	var data_ reportUDPCaptureResultsResponse
+	resp_ := &graphql.Response{Data: &data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return &data_, err_
+}
@@ -0,0 +1,168 @@
+package mapperclient
+
+import (
+	"context"
+	"github.com/otterize/intents-operator/src/shared/errors"
+	"github.com/otterize/network-mapper/src/mappergrpc/ingestpb"
+	"github.com/otterize/nilable"
+	"github.com/samber/lo"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"io"
+)
+
+// grpcReportChunkSize is the number of results sent in each message of a report stream, keeping messages well below
+// gRPC's default 4MB limit however large the report is.
+const grpcReportChunkSize = 100
+
+// WithGRPCIngest makes the client send reports to the mapper's gRPC ingest API at address, rather than as GraphQL
+// mutations, sparing the mapper the cost of decoding them from JSON. Other calls are still made over GraphQL. An empty
+// address keeps reporting over GraphQL.
+func WithGRPCIngest(address string) Option {
+	return func(o *clientOptions) {
+		o.grpcAddress = address
+	}
+}
+
+// newIngestClient connects to the gRPC ingest API, with the same credentials GraphQL calls are made with. The
+// connection is made over TLS if a client certificate is configured, so address should then be the mapper's gRPC TLS
+// port.
+func (o *clientOptions) newIngestClient() (ingestpb.IngestClient, error) {
+	dialOptions := []grpc.DialOption{grpc.WithTransportCredentials(insecure.NewCredentials())}
+	if o.tlsConfig != nil {
+		dialOptions = []grpc.DialOption{grpc.WithTransportCredentials(credentials.NewTLS(o.tlsConfig))}
+	}
+	if o.token != nil {
+		dialOptions = append(dialOptions, grpc.WithPerRPCCredentials(perRPCToken{token: o.token}))
+	}
+	conn, err := grpc.NewClient(o.grpcAddress, dialOptions...)
+	if err != nil {
+		return nil, errors.Wrap(err)
+	}
+	return ingestpb.NewIngestClient(conn), nil
+}
+
+type openReportStreamFunc[Msg any] func(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[Msg, ingestpb.ReportResponse], error)
+
+// streamReport sends a report over a new stream, split into messages of up to grpcReportChunkSize items, and returns
+// once the mapper accepted or rejected it.
+func streamReport[Item any, Msg any](ctx context.Context, open openReportStreamFunc[Msg], items []Item, toMessage func([]Item) *Msg) error {
+	stream, err := open(ctx)
+	if err != nil {
+		return errors.Wrap(err)
+	}
+	for _, chunk := range lo.Chunk(items, grpcReportChunkSize) {
+		if err := stream.Send(toMessage(chunk)); err != nil {
+			if errors.Is(err, io.EOF) {
+				// The mapper ended the stream, the reason is returned by CloseAndRecv
+				break
+			}
+			return errors.Wrap(err)
+		}
+	}
+	_, err = stream.CloseAndRecv()
+	return errors.Wrap(err)
+}
+
+func captureResultsToProto(results []RecordedDestinationsForSrc) *ingestpb.CaptureResults {
+	return &ingestpb.CaptureResults{Results: lo.Map(results, func(result RecordedDestinationsForSrc, _ int) *ingestpb.RecordedDestinationsForSrc {
+		return &ingestpb.RecordedDestinationsForSrc{
+			SrcIp:       result.SrcIp,
+			SrcHostname: result.SrcHostname,
+			Destinations: lo.Map(result.Destinations, func(destination Destination, _ int) *ingestpb.Destination {
+				return &ingestpb.Destination{
+					Destination:     destination.Destination,
+					DestinationIp:   nilableToPtr(destination.DestinationIP),
+					DestinationPort: nilableIntToInt64Ptr(destination.DestinationPort),
+					Ttl:             nilableIntToInt64Ptr(destination.TTL),
+					LastSeen:        timestamppb.New(destination.LastSeen),
+					SrcPorts: lo.Map(destination.SrcPorts, func(port int, _ int) int64 {
+						return int64(port)
+					}),
+				}
+			}),
+		}
+	})}
+}
+
+func kafkaMapperResultsToProto(results []KafkaMapperResult) *ingestpb.KafkaMapperResults {
+	return &ingestpb.KafkaMapperResults{Results: lo.Map(results, func(result KafkaMapperResult, _ int) *ingestpb.KafkaMapperResult {
+		return &ingestpb.KafkaMapperResult{
+			SrcIp:           result.SrcIp,
+			ServerPodName:   result.ServerPodName,
+			ServerNamespace: result.ServerNamespace,
+			Topic:           result.Topic,
+			Operation:       result.Operation,
+			LastSeen:        timestamppb.New(result.LastSeen),
+		}
+	})}
+}
+
+func awsOperationsToProto(operations []AWSOperation) *ingestpb.AWSOperations {
+	return &ingestpb.AWSOperations{Operations: lo.Map(operations, func(operation AWSOperation, _ int) *ingestpb.AWSOperation {
+		return &ingestpb.AWSOperation{
+			Resource: operation.Resource,
+			Actions:  operation.Actions,
+			SrcIp:    nilableToPtr(operation.SrcIp),
+			IamRole:  nilableToPtr(operation.IamRole),
+			Client:   namespacedNameToProto(operation.Client),
+		}
+	})}
+}
+
+func gcpOperationsToProto(operations []GCPOperation) *ingestpb.GCPOperations {
+	return &ingestpb.GCPOperations{Operations: lo.Map(operations, func(operation GCPOperation, _ int) *ingestpb.GCPOperation {
+		return &ingestpb.GCPOperation{
+			Resource:    operation.Resource,
+			Permissions: operation.Permissions,
+			SrcIp:       nilableToPtr(operation.SrcIp),
+			Client:      namespacedNameToProto(operation.Client),
+		}
+	})}
+}
+
+func azureOperationsToProto(operations []AzureOperation) *ingestpb.AzureOperations {
+	return &ingestpb.AzureOperations{Operations: lo.Map(operations, func(operation AzureOperation, _ int) *ingestpb.AzureOperation {
+		return &ingestpb.AzureOperation{
+			Scope:           operation.Scope,
+			Actions:         operation.Actions,
+			DataActions:     operation.DataActions,
+			ClientName:      operation.ClientName,
+			ClientNamespace: operation.ClientNamespace,
+		}
+	})}
+}
+
+func trafficLevelResultsToProto(results []TrafficLevelResult) *ingestpb.TrafficLevelResults {
+	return &ingestpb.TrafficLevelResults{Results: lo.Map(results, func(result TrafficLevelResult, _ int) *ingestpb.TrafficLevelResult {
+		return &ingestpb.TrafficLevelResult{
+			SrcIp:     result.SrcIP,
+			DstIp:     result.DstIP,
+			BytesSent: int64(result.BytesSent),
+			Flows:     int64(result.Flows),
+		}
+	})}
+}
+
+func namespacedNameToProto(name nilable.Nilable[NamespacedName]) *ingestpb.NamespacedName {
+	if !name.Set {
+		return nil
+	}
+	return &ingestpb.NamespacedName{Name: name.Item.Name, Namespace: name.Item.Namespace}
+}
+
+func nilableToPtr[T any](value nilable.Nilable[T]) *T {
+	if !value.Set {
+		return nil
+	}
+	return &value.Item
+}
+
+func nilableIntToInt64Ptr(value nilable.Nilable[int]) *int64 {
+	if !value.Set {
+		return nil
+	}
+	return lo.ToPtr(int64(value.Item))
+}
@@ -0,0 +1,109 @@
+package mapperclient
+
+import (
+	"context"
+	"fmt"
+	"github.com/otterize/intents-operator/src/shared/errors"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"strconv"
+	"time"
+)
+
+// retryAfterErrorCode is the extensions code of the error the mapper returns when it can't queue reported results.
+const retryAfterErrorCode = "RETRY_AFTER"
+
+// maxRetryAfterAttempts bounds how many times a report is sent while the mapper asks to retry later, so a mapper that
+// stays overloaded fails the report and leaves the results to the caller.
+const maxRetryAfterAttempts = 3
+
+// RetryAfterError is returned when the mapper did not accept the reported results because its queue for them was full.
+// The results can be reported again after RetryAfter.
+type RetryAfterError struct {
+	RetryAfter    time.Duration
+	QueueDepth    int
+	QueueCapacity int
+}
+
+func (e *RetryAfterError) Error() string {
+	return fmt.Sprintf("mapper report queue is full (%d/%d), retry after %s", e.QueueDepth, e.QueueCapacity, e.RetryAfter)
+}
+
+// asRetryAfterError returns the retry-after error the mapper returned, whether the report was sent as a GraphQL mutation
+// or over gRPC.
+func asRetryAfterError(err error) (*RetryAfterError, bool) {
+	var gqlErrors gqlerror.List
+	if errors.As(err, &gqlErrors) {
+		return retryAfterFromGQLErrors(gqlErrors)
+	}
+	var grpcErr interface{ GRPCStatus() *status.Status }
+	if errors.As(err, &grpcErr) {
+		return retryAfterFromGRPCStatus(grpcErr.GRPCStatus())
+	}
+	return nil, false
+}
+
+func retryAfterFromGQLErrors(gqlErrors gqlerror.List) (*RetryAfterError, bool) {
+	for _, gqlErr := range gqlErrors {
+		if gqlErr.Extensions["code"] != retryAfterErrorCode {
+			continue
+		}
+		// Numbers in the extensions are decoded from JSON as float64
+		retryAfterMs, _ := gqlErr.Extensions["retryAfterMs"].(float64)
+		queueDepth, _ := gqlErr.Extensions["queueDepth"].(float64)
+		queueCapacity, _ := gqlErr.Extensions["queueCapacity"].(float64)
+		return &RetryAfterError{
+			RetryAfter:    time.Duration(retryAfterMs) * time.Millisecond,
+			QueueDepth:    int(queueDepth),
+			QueueCapacity: int(queueCapacity),
+		}, true
+	}
+	return nil, false
+}
+
+func retryAfterFromGRPCStatus(st *status.Status) (*RetryAfterError, bool) {
+	if st.Code() != codes.ResourceExhausted {
+		return nil, false
+	}
+	retryAfterErr := &RetryAfterError{}
+	isRetryAfter := false
+	for _, detail := range st.Details() {
+		switch detail := detail.(type) {
+		case *errdetails.RetryInfo:
+			retryAfterErr.RetryAfter = detail.GetRetryDelay().AsDuration()
+		case *errdetails.ErrorInfo:
+			if detail.Reason != retryAfterErrorCode {
+				continue
+			}
+			isRetryAfter = true
+			retryAfterErr.QueueDepth, _ = strconv.Atoi(detail.Metadata["queueDepth"])
+			retryAfterErr.QueueCapacity, _ = strconv.Atoi(detail.Metadata["queueCapacity"])
+		}
+	}
+	return retryAfterErr, isRetryAfter
+}
+
+// reportWithRetryAfter sends a report, and while the mapper asks to retry later, waits as asked and sends it again, up
+// to maxRetryAfterAttempts times. If the mapper still can't accept the report, a *RetryAfterError is returned.
+func reportWithRetryAfter(ctx context.Context, report func() error) error {
+	for attempt := 1; ; attempt++ {
+		err := report()
+		retryAfterErr, isRetryAfter := asRetryAfterError(err)
+		if !isRetryAfter {
+			return errors.Wrap(err)
+		}
+		if attempt >= maxRetryAfterAttempts {
+			return errors.Wrap(retryAfterErr)
+		}
+
+		timer := time.NewTimer(retryAfterErr.RetryAfter)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return errors.Wrap(retryAfterErr)
+		case <-timer.C:
+		}
+	}
+}
@@ -0,0 +1,64 @@
+package mapperclient
+
+import (
+	"context"
+	"github.com/otterize/intents-operator/src/shared/errors"
+	"github.com/stretchr/testify/suite"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+const retryAfterResponse = `{"errors": [{"message": "report queue is full (200/200), retry after 1ms", "path": ["reportCaptureResults"],
+	"extensions": {"code": "RETRY_AFTER", "retryAfterMs": 1, "queueDepth": 200, "queueCapacity": 200}}], "data": null}`
+
+type RetryAfterTestSuite struct {
+	suite.Suite
+	requests          atomic.Int32
+	retryAfterReplies int32
+	client            *Client
+}
+
+func (s *RetryAfterTestSuite) SetupTest() {
+	s.requests.Store(0)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if s.requests.Add(1) <= s.retryAfterReplies {
+			_, _ = w.Write([]byte(retryAfterResponse))
+			return
+		}
+		_, _ = w.Write([]byte(`{"data": {"reportCaptureResults": true}}`))
+	}))
+	s.T().Cleanup(server.Close)
+	s.client = New(server.URL)
+}
+
+func (s *RetryAfterTestSuite) TestReportIsRetried() {
+	s.retryAfterReplies = 2
+	s.Require().NoError(s.client.ReportCaptureResults(context.Background(), CaptureResults{}))
+	s.Require().Equal(int32(3), s.requests.Load())
+}
+
+func (s *RetryAfterTestSuite) TestRetryAfterErrorIsReturnedAfterMaxAttempts() {
+	s.retryAfterReplies = maxRetryAfterAttempts
+	err := s.client.ReportCaptureResults(context.Background(), CaptureResults{})
+
+	var retryAfterErr *RetryAfterError
+	s.Require().True(errors.As(err, &retryAfterErr))
+	s.Require().Equal(RetryAfterError{RetryAfter: time.Millisecond, QueueDepth: 200, QueueCapacity: 200}, *retryAfterErr)
+	s.Require().Equal(int32(maxRetryAfterAttempts), s.requests.Load())
+}
+
+func (s *RetryAfterTestSuite) TestOtherErrorsAreNotRetried() {
+	s.client = New("http://127.0.0.1:1")
+	err := s.client.ReportCaptureResults(context.Background(), CaptureResults{})
+	s.Require().Error(err)
+	var retryAfterErr *RetryAfterError
+	s.Require().False(errors.As(err, &retryAfterErr))
+}
+
+func TestRetryAfterTestSuite(t *testing.T) {
+	suite.Run(t, new(RetryAfterTestSuite))
+}
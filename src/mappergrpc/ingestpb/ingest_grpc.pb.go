@@ -0,0 +1,405 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v5.29.3
+// source: ingest.proto
+
+package ingestpb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	Ingest_ReportCaptureResults_FullMethodName         = "/otterize.networkmapper.ingest.v1.Ingest/ReportCaptureResults"
+	Ingest_ReportTCPCaptureResults_FullMethodName      = "/otterize.networkmapper.ingest.v1.Ingest/ReportTCPCaptureResults"
+	Ingest_ReportUDPCaptureResults_FullMethodName      = "/otterize.networkmapper.ingest.v1.Ingest/ReportUDPCaptureResults"
+	Ingest_ReportSocketScanResults_FullMethodName      = "/otterize.networkmapper.ingest.v1.Ingest/ReportSocketScanResults"
+	Ingest_ReportKafkaMapperResults_FullMethodName     = "/otterize.networkmapper.ingest.v1.Ingest/ReportKafkaMapperResults"
+	Ingest_ReportIstioConnectionResults_FullMethodName = "/otterize.networkmapper.ingest.v1.Ingest/ReportIstioConnectionResults"
+	Ingest_ReportAWSOperation_FullMethodName           = "/otterize.networkmapper.ingest.v1.Ingest/ReportAWSOperation"
+	Ingest_ReportAzureOperation_FullMethodName         = "/otterize.networkmapper.ingest.v1.Ingest/ReportAzureOperation"
+	Ingest_ReportGCPOperation_FullMethodName           = "/otterize.networkmapper.ingest.v1.Ingest/ReportGCPOperation"
+	Ingest_ReportTrafficLevelResults_FullMethodName    = "/otterize.networkmapper.ingest.v1.Ingest/ReportTrafficLevelResults"
+)
+
+// IngestClient is the client API for Ingest service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// Ingest receives the same results as the GraphQL report mutations of the same names, encoded as protobuf rather than
+// JSON. Each call is a client stream carrying a single report, which may be split across any number of messages. The
+// report is accepted as a whole once the stream is closed. If the mapper's queue for the results is full, the report is
+// rejected with RESOURCE_EXHAUSTED, with a google.rpc.RetryInfo detail telling when to report it again, and a
+// google.rpc.ErrorInfo detail whose metadata holds queueDepth and queueCapacity.
+type IngestClient interface {
+	ReportCaptureResults(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[CaptureResults, ReportResponse], error)
+	ReportTCPCaptureResults(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[CaptureResults, ReportResponse], error)
+	ReportUDPCaptureResults(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[CaptureResults, ReportResponse], error)
+	ReportSocketScanResults(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[CaptureResults, ReportResponse], error)
+	ReportKafkaMapperResults(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[KafkaMapperResults, ReportResponse], error)
+	ReportIstioConnectionResults(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[IstioConnectionResults, ReportResponse], error)
+	ReportAWSOperation(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[AWSOperations, ReportResponse], error)
+	ReportAzureOperation(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[AzureOperations, ReportResponse], error)
+	ReportGCPOperation(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[GCPOperations, ReportResponse], error)
+	ReportTrafficLevelResults(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[TrafficLevelResults, ReportResponse], error)
+}
+
+type ingestClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewIngestClient(cc grpc.ClientConnInterface) IngestClient {
+	return &ingestClient{cc}
+}
+
+func (c *ingestClient) ReportCaptureResults(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[CaptureResults, ReportResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &Ingest_ServiceDesc.Streams[0], Ingest_ReportCaptureResults_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[CaptureResults, ReportResponse]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Ingest_ReportCaptureResultsClient = grpc.ClientStreamingClient[CaptureResults, ReportResponse]
+
+func (c *ingestClient) ReportTCPCaptureResults(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[CaptureResults, ReportResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &Ingest_ServiceDesc.Streams[1], Ingest_ReportTCPCaptureResults_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[CaptureResults, ReportResponse]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Ingest_ReportTCPCaptureResultsClient = grpc.ClientStreamingClient[CaptureResults, ReportResponse]
+
+func (c *ingestClient) ReportUDPCaptureResults(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[CaptureResults, ReportResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &Ingest_ServiceDesc.Streams[2], Ingest_ReportUDPCaptureResults_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[CaptureResults, ReportResponse]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Ingest_ReportUDPCaptureResultsClient = grpc.ClientStreamingClient[CaptureResults, ReportResponse]
+
+func (c *ingestClient) ReportSocketScanResults(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[CaptureResults, ReportResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &Ingest_ServiceDesc.Streams[3], Ingest_ReportSocketScanResults_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[CaptureResults, ReportResponse]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Ingest_ReportSocketScanResultsClient = grpc.ClientStreamingClient[CaptureResults, ReportResponse]
+
+func (c *ingestClient) ReportKafkaMapperResults(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[KafkaMapperResults, ReportResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &Ingest_ServiceDesc.Streams[4], Ingest_ReportKafkaMapperResults_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[KafkaMapperResults, ReportResponse]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Ingest_ReportKafkaMapperResultsClient = grpc.ClientStreamingClient[KafkaMapperResults, ReportResponse]
+
+func (c *ingestClient) ReportIstioConnectionResults(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[IstioConnectionResults, ReportResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &Ingest_ServiceDesc.Streams[5], Ingest_ReportIstioConnectionResults_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[IstioConnectionResults, ReportResponse]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Ingest_ReportIstioConnectionResultsClient = grpc.ClientStreamingClient[IstioConnectionResults, ReportResponse]
+
+func (c *ingestClient) ReportAWSOperation(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[AWSOperations, ReportResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &Ingest_ServiceDesc.Streams[6], Ingest_ReportAWSOperation_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[AWSOperations, ReportResponse]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Ingest_ReportAWSOperationClient = grpc.ClientStreamingClient[AWSOperations, ReportResponse]
+
+func (c *ingestClient) ReportAzureOperation(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[AzureOperations, ReportResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &Ingest_ServiceDesc.Streams[7], Ingest_ReportAzureOperation_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[AzureOperations, ReportResponse]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Ingest_ReportAzureOperationClient = grpc.ClientStreamingClient[AzureOperations, ReportResponse]
+
+func (c *ingestClient) ReportGCPOperation(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[GCPOperations, ReportResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &Ingest_ServiceDesc.Streams[8], Ingest_ReportGCPOperation_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[GCPOperations, ReportResponse]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Ingest_ReportGCPOperationClient = grpc.ClientStreamingClient[GCPOperations, ReportResponse]
+
+func (c *ingestClient) ReportTrafficLevelResults(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[TrafficLevelResults, ReportResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &Ingest_ServiceDesc.Streams[9], Ingest_ReportTrafficLevelResults_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[TrafficLevelResults, ReportResponse]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Ingest_ReportTrafficLevelResultsClient = grpc.ClientStreamingClient[TrafficLevelResults, ReportResponse]
+
+// IngestServer is the server API for Ingest service.
+// All implementations must embed UnimplementedIngestServer
+// for forward compatibility.
+//
+// Ingest receives the same results as the GraphQL report mutations of the same names, encoded as protobuf rather than
+// JSON. Each call is a client stream carrying a single report, which may be split across any number of messages. The
+// report is accepted as a whole once the stream is closed. If the mapper's queue for the results is full, the report is
+// rejected with RESOURCE_EXHAUSTED, with a google.rpc.RetryInfo detail telling when to report it again, and a
+// google.rpc.ErrorInfo detail whose metadata holds queueDepth and queueCapacity.
+type IngestServer interface {
+	ReportCaptureResults(grpc.ClientStreamingServer[CaptureResults, ReportResponse]) error
+	ReportTCPCaptureResults(grpc.ClientStreamingServer[CaptureResults, ReportResponse]) error
+	ReportUDPCaptureResults(grpc.ClientStreamingServer[CaptureResults, ReportResponse]) error
+	ReportSocketScanResults(grpc.ClientStreamingServer[CaptureResults, ReportResponse]) error
+	ReportKafkaMapperResults(grpc.ClientStreamingServer[KafkaMapperResults, ReportResponse]) error
+	ReportIstioConnectionResults(grpc.ClientStreamingServer[IstioConnectionResults, ReportResponse]) error
+	ReportAWSOperation(grpc.ClientStreamingServer[AWSOperations, ReportResponse]) error
+	ReportAzureOperation(grpc.ClientStreamingServer[AzureOperations, ReportResponse]) error
+	ReportGCPOperation(grpc.ClientStreamingServer[GCPOperations, ReportResponse]) error
+	ReportTrafficLevelResults(grpc.ClientStreamingServer[TrafficLevelResults, ReportResponse]) error
+	mustEmbedUnimplementedIngestServer()
+}
+
+// UnimplementedIngestServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedIngestServer struct{}
+
+func (UnimplementedIngestServer) ReportCaptureResults(grpc.ClientStreamingServer[CaptureResults, ReportResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method ReportCaptureResults not implemented")
+}
+func (UnimplementedIngestServer) ReportTCPCaptureResults(grpc.ClientStreamingServer[CaptureResults, ReportResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method ReportTCPCaptureResults not implemented")
+}
+func (UnimplementedIngestServer) ReportUDPCaptureResults(grpc.ClientStreamingServer[CaptureResults, ReportResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method ReportUDPCaptureResults not implemented")
+}
+func (UnimplementedIngestServer) ReportSocketScanResults(grpc.ClientStreamingServer[CaptureResults, ReportResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method ReportSocketScanResults not implemented")
+}
+func (UnimplementedIngestServer) ReportKafkaMapperResults(grpc.ClientStreamingServer[KafkaMapperResults, ReportResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method ReportKafkaMapperResults not implemented")
+}
+func (UnimplementedIngestServer) ReportIstioConnectionResults(grpc.ClientStreamingServer[IstioConnectionResults, ReportResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method ReportIstioConnectionResults not implemented")
+}
+func (UnimplementedIngestServer) ReportAWSOperation(grpc.ClientStreamingServer[AWSOperations, ReportResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method ReportAWSOperation not implemented")
+}
+func (UnimplementedIngestServer) ReportAzureOperation(grpc.ClientStreamingServer[AzureOperations, ReportResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method ReportAzureOperation not implemented")
+}
+func (UnimplementedIngestServer) ReportGCPOperation(grpc.ClientStreamingServer[GCPOperations, ReportResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method ReportGCPOperation not implemented")
+}
+func (UnimplementedIngestServer) ReportTrafficLevelResults(grpc.ClientStreamingServer[TrafficLevelResults, ReportResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method ReportTrafficLevelResults not implemented")
+}
+func (UnimplementedIngestServer) mustEmbedUnimplementedIngestServer() {}
+func (UnimplementedIngestServer) testEmbeddedByValue()                {}
+
+// UnsafeIngestServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to IngestServer will
+// result in compilation errors.
+type UnsafeIngestServer interface {
+	mustEmbedUnimplementedIngestServer()
+}
+
+func RegisterIngestServer(s grpc.ServiceRegistrar, srv IngestServer) {
+	// If the following call pancis, it indicates UnimplementedIngestServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&Ingest_ServiceDesc, srv)
+}
+
+func _Ingest_ReportCaptureResults_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(IngestServer).ReportCaptureResults(&grpc.GenericServerStream[CaptureResults, ReportResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Ingest_ReportCaptureResultsServer = grpc.ClientStreamingServer[CaptureResults, ReportResponse]
+
+func _Ingest_ReportTCPCaptureResults_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(IngestServer).ReportTCPCaptureResults(&grpc.GenericServerStream[CaptureResults, ReportResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Ingest_ReportTCPCaptureResultsServer = grpc.ClientStreamingServer[CaptureResults, ReportResponse]
+
+func _Ingest_ReportUDPCaptureResults_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(IngestServer).ReportUDPCaptureResults(&grpc.GenericServerStream[CaptureResults, ReportResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Ingest_ReportUDPCaptureResultsServer = grpc.ClientStreamingServer[CaptureResults, ReportResponse]
+
+func _Ingest_ReportSocketScanResults_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(IngestServer).ReportSocketScanResults(&grpc.GenericServerStream[CaptureResults, ReportResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Ingest_ReportSocketScanResultsServer = grpc.ClientStreamingServer[CaptureResults, ReportResponse]
+
+func _Ingest_ReportKafkaMapperResults_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(IngestServer).ReportKafkaMapperResults(&grpc.GenericServerStream[KafkaMapperResults, ReportResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Ingest_ReportKafkaMapperResultsServer = grpc.ClientStreamingServer[KafkaMapperResults, ReportResponse]
+
+func _Ingest_ReportIstioConnectionResults_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(IngestServer).ReportIstioConnectionResults(&grpc.GenericServerStream[IstioConnectionResults, ReportResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Ingest_ReportIstioConnectionResultsServer = grpc.ClientStreamingServer[IstioConnectionResults, ReportResponse]
+
+func _Ingest_ReportAWSOperation_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(IngestServer).ReportAWSOperation(&grpc.GenericServerStream[AWSOperations, ReportResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Ingest_ReportAWSOperationServer = grpc.ClientStreamingServer[AWSOperations, ReportResponse]
+
+func _Ingest_ReportAzureOperation_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(IngestServer).ReportAzureOperation(&grpc.GenericServerStream[AzureOperations, ReportResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Ingest_ReportAzureOperationServer = grpc.ClientStreamingServer[AzureOperations, ReportResponse]
+
+func _Ingest_ReportGCPOperation_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(IngestServer).ReportGCPOperation(&grpc.GenericServerStream[GCPOperations, ReportResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Ingest_ReportGCPOperationServer = grpc.ClientStreamingServer[GCPOperations, ReportResponse]
+
+func _Ingest_ReportTrafficLevelResults_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(IngestServer).ReportTrafficLevelResults(&grpc.GenericServerStream[TrafficLevelResults, ReportResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Ingest_ReportTrafficLevelResultsServer = grpc.ClientStreamingServer[TrafficLevelResults, ReportResponse]
+
+// Ingest_ServiceDesc is the grpc.ServiceDesc for Ingest service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Ingest_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "otterize.networkmapper.ingest.v1.Ingest",
+	HandlerType: (*IngestServer)(nil),
+	Methods:     []grpc.MethodDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ReportCaptureResults",
+			Handler:       _Ingest_ReportCaptureResults_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "ReportTCPCaptureResults",
+			Handler:       _Ingest_ReportTCPCaptureResults_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "ReportUDPCaptureResults",
+			Handler:       _Ingest_ReportUDPCaptureResults_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "ReportSocketScanResults",
+			Handler:       _Ingest_ReportSocketScanResults_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "ReportKafkaMapperResults",
+			Handler:       _Ingest_ReportKafkaMapperResults_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "ReportIstioConnectionResults",
+			Handler:       _Ingest_ReportIstioConnectionResults_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "ReportAWSOperation",
+			Handler:       _Ingest_ReportAWSOperation_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "ReportAzureOperation",
+			Handler:       _Ingest_ReportAzureOperation_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "ReportGCPOperation",
+			Handler:       _Ingest_ReportGCPOperation_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "ReportTrafficLevelResults",
+			Handler:       _Ingest_ReportTrafficLevelResults_Handler,
+			ClientStreams: true,
+		},
+	},
+	Metadata: "ingest.proto",
+}
@@ -13,6 +13,8 @@ Shared config keys between all reporter components - DNS Sniffer, Kafka watcher,
 const (
 	MapperApiUrlKey              = "mapper-api-url"
 	MapperApiUrlDefault          = "http://mapper:9090/query"
+	MapperGRPCAddressKey         = "mapper-grpc-address"
+	MapperGRPCAddressDefault     = "" // Reports are sent as GraphQL mutations unless set
 	DebugKey                     = "debug"
 	DebugDefault                 = false
 	PrometheusMetricsPortKey     = "metrics-port"
@@ -23,11 +25,20 @@ const (
 	TelemetryErrorsAPIKeyDefault = "d86195588a41fa03aa6711993bb1c765"
 	EnableTCPKey                 = "enable-tcp"
 	EnableTCPSnifferDefault      = true
+	EnableUDPKey                 = "enable-udp"
+	EnableUDPSnifferDefault      = false
 	EnableSocketScannerKey       = "enable-socket-scanner"
 	EnableSocketScannerDefault   = true
 	EnableDNSKey                 = "enable-dns"
 	EnableDNSSnifferDefault      = true
 
+	// Credentials for mappers that require ingest auth, each sent only if set
+	MapperBearerTokenKey    = "mapper-bearer-token"
+	MapperTokenFileKey      = "mapper-token-file"
+	MapperClientCertFileKey = "mapper-client-cert-file"
+	MapperClientKeyFileKey  = "mapper-client-key-file"
+	MapperCAFileKey         = "mapper-ca-file"
+
 	EnvPodKey       = "pod"
 	EnvNamespaceKey = "namespace"
 
@@ -42,11 +53,13 @@ func GetEnvVarForKey(key string) string {
 
 func init() {
 	viper.SetDefault(MapperApiUrlKey, MapperApiUrlDefault)
+	viper.SetDefault(MapperGRPCAddressKey, MapperGRPCAddressDefault)
 	viper.SetDefault(DebugKey, DebugDefault)
 	viper.SetDefault(PrometheusMetricsPortKey, PrometheusMetricsPortDefault)
 	viper.SetDefault(HealthProbesPortKey, HealthProbesPortDefault)
 	viper.SetDefault(TelemetryErrorsAPIKeyKey, TelemetryErrorsAPIKeyDefault)
 	viper.SetDefault(EnableTCPKey, EnableTCPSnifferDefault)
+	viper.SetDefault(EnableUDPKey, EnableUDPSnifferDefault)
 	viper.SetDefault(EnableSocketScannerKey, EnableSocketScannerDefault)
 	viper.SetDefault(EnableDNSKey, EnableDNSSnifferDefault)
 	viper.SetEnvPrefix(envPrefix)
@@ -2,6 +2,7 @@ package main
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"github.com/bombsimon/logrusr/v3"
 	"github.com/labstack/echo/v4"
@@ -16,6 +17,7 @@ import (
 	"github.com/otterize/network-mapper/src/shared/version"
 	"golang.org/x/sync/errgroup"
 	"net/http"
+	"os"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/manager/signals"
 	"time"
@@ -25,10 +27,56 @@ import (
 	"github.com/otterize/network-mapper/src/sniffer/pkg/config"
 	"github.com/otterize/network-mapper/src/sniffer/pkg/sniffer"
 	"github.com/sirupsen/logrus"
+	"github.com/spf13/pflag"
 	"github.com/spf13/viper"
 )
 
+func parseFlags() {
+	pflag.String(config.PcapFileKey, config.PcapFileDefault, "Replay the packets recorded in a pcap or pcapng file, or in all such files in a directory, instead of capturing live")
+	pflag.String(config.PcapReplayOutputKey, config.PcapReplayOutputDefault, "Where to report replayed captures: 'mapper', or 'json' to print them to stdout")
+	pflag.Parse()
+	if err := viper.BindPFlags(pflag.CommandLine); err != nil {
+		logrus.WithError(err).Panic("Failed to bind flags")
+	}
+}
+
+// runReplay replays recorded captures and reports them, then exits. It doesn't need to run in a cluster, unless the
+// results are reported to a mapper running in one.
+func runReplay(ctx context.Context, path string) error {
+	results, err := sniffer.Replay(path)
+	if err != nil {
+		return errors.Wrap(err)
+	}
+
+	switch output := viper.GetString(config.PcapReplayOutputKey); output {
+	case config.PcapReplayOutputJSON:
+		encoder := json.NewEncoder(os.Stdout)
+		encoder.SetIndent("", "  ")
+		return errors.Wrap(encoder.Encode(results))
+	case config.PcapReplayOutputMapper:
+		timeoutCtx, cancel := context.WithTimeout(ctx, viper.GetDuration(config.CallsTimeoutKey))
+		defer cancel()
+		return sniffer.ReportReplayResults(timeoutCtx, mapperclient.New(viper.GetString(sharedconfig.MapperApiUrlKey), mapperClientOptions()...), results)
+	default:
+		return errors.Errorf("unknown pcap replay output '%s'", output)
+	}
+}
+
+func mapperClientOptions() []mapperclient.Option {
+	return []mapperclient.Option{
+		mapperclient.WithGRPCIngest(viper.GetString(sharedconfig.MapperGRPCAddressKey)),
+		mapperclient.WithBearerToken(viper.GetString(sharedconfig.MapperBearerTokenKey)),
+		mapperclient.WithServiceAccountToken(viper.GetString(sharedconfig.MapperTokenFileKey)),
+		mapperclient.WithClientCertificate(
+			viper.GetString(sharedconfig.MapperClientCertFileKey),
+			viper.GetString(sharedconfig.MapperClientKeyFileKey),
+			viper.GetString(sharedconfig.MapperCAFileKey),
+		),
+	}
+}
+
 func main() {
+	parseFlags()
 	logrus.SetLevel(logrus.InfoLevel)
 	if viper.GetBool(sharedconfig.DebugKey) {
 		logrus.SetLevel(logrus.DebugLevel)
@@ -36,6 +84,14 @@ func main() {
 	logrus.SetFormatter(&logrus.JSONFormatter{
 		TimestampFormat: time.RFC3339,
 	})
+
+	if pcapFile := viper.GetString(config.PcapFileKey); pcapFile != "" {
+		if err := runReplay(signals.SetupSignalHandler(), pcapFile); err != nil {
+			logrus.WithError(err).Fatal("Failed to replay pcap files")
+		}
+		return
+	}
+
 	errgrp, errGroupCtx := errgroup.WithContext(signals.SetupSignalHandler())
 	clusterUID := clusterutils.GetOrCreateClusterUID(errGroupCtx)
 	componentinfo.SetGlobalContextId(telemetrysender.Anonymize(clusterUID))
@@ -45,7 +101,7 @@ func main() {
 
 	ctrl.SetLogger(logrusr.New(logrus.StandardLogger()))
 
-	mapperClient := mapperclient.New(viper.GetString(sharedconfig.MapperApiUrlKey))
+	mapperClient := mapperclient.New(viper.GetString(sharedconfig.MapperApiUrlKey), mapperClientOptions()...)
 	healthProbesPort := viper.GetInt(sharedconfig.HealthProbesPortKey)
 
 	healthServer := echo.New()
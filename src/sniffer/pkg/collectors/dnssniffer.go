@@ -5,6 +5,7 @@ import (
 	"github.com/google/gopacket"
 	"github.com/google/gopacket/layers"
 	"github.com/google/gopacket/pcap"
+	"github.com/google/gopacket/reassembly"
 	"github.com/otterize/intents-operator/src/shared/errors"
 	sharedconfig "github.com/otterize/network-mapper/src/shared/config"
 	"github.com/otterize/network-mapper/src/sniffer/pkg/config"
@@ -27,12 +28,23 @@ type pendingCapture struct {
 	ttl              nilable.Nilable[int]
 }
 
+// dnsFilter captures DNS over both UDP and TCP - responses that don't fit in a UDP datagram are sent over TCP.
+const dnsFilter = "udp port 53 or tcp port 53"
+
+const (
+	// Bound the memory held by DNS over TCP streams with out of order or missing segments.
+	dnsTCPMaxBufferedPagesTotal         = 4096
+	dnsTCPMaxBufferedPagesPerConnection = 16
+)
+
 type DNSSniffer struct {
 	NetworkCollector
-	resolver       ipresolver.IPResolver
-	pending        []pendingCapture
-	lastRefresh    time.Time
-	isRunningOnAWS bool
+	resolver            ipresolver.IPResolver
+	pending             []pendingCapture
+	lastRefresh         time.Time
+	isRunningOnAWS      bool
+	tcpAssembler        *reassembly.Assembler
+	lastTCPStreamsFlush time.Time
 }
 
 func NewDNSSniffer(resolver ipresolver.IPResolver, isRunningOnAWS bool) *DNSSniffer {
@@ -44,6 +56,9 @@ func NewDNSSniffer(resolver ipresolver.IPResolver, isRunningOnAWS bool) *DNSSnif
 		isRunningOnAWS:   isRunningOnAWS,
 	}
 	s.resetData()
+	s.tcpAssembler = reassembly.NewAssembler(reassembly.NewStreamPool(&dnsTCPStreamFactory{onResponse: s.handleDNSResponse}))
+	s.tcpAssembler.MaxBufferedPagesTotal = dnsTCPMaxBufferedPagesTotal
+	s.tcpAssembler.MaxBufferedPagesPerConnection = dnsTCPMaxBufferedPagesPerConnection
 	return &s
 }
 
@@ -83,7 +98,7 @@ func (s *DNSSniffer) CreatePacketChannelForInterface(iface net.Interface) (resul
 			err = errors.Wrap(openLiveErr)
 			return
 		}
-		bpfErr := handle.SetBPFFilter("udp port 53")
+		bpfErr := handle.SetBPFFilter(dnsFilter)
 		if bpfErr != nil {
 			err = errors.Wrap(bpfErr)
 			return
@@ -108,7 +123,7 @@ func (s *DNSSniffer) CreateDNSPacketStream() (chan gopacket.Packet, error) {
 	if err != nil {
 		return nil, errors.Wrap(err)
 	}
-	err = handle.SetBPFFilter("udp port 53")
+	err = handle.SetBPFFilter(dnsFilter)
 	if err != nil {
 		return nil, errors.Wrap(err)
 	}
@@ -117,50 +132,92 @@ func (s *DNSSniffer) CreateDNSPacketStream() (chan gopacket.Packet, error) {
 	return packetSource.Packets(), nil
 }
 
+// CreateDNSPacketStreamFromFile reads the DNS packets recorded in a pcap or pcapng file. The channel is closed once
+// all packets are read.
+func (s *DNSSniffer) CreateDNSPacketStreamFromFile(path string) (chan gopacket.Packet, error) {
+	return createPacketStreamFromFile(path, dnsFilter)
+}
+
 func (s *DNSSniffer) HandlePacket(packet gopacket.Packet) {
 	if !viper.GetBool(sharedconfig.EnableDNSKey) {
 		return
 	}
 
 	captureTime := detectCaptureTime(packet)
-	ipLayer := packet.Layer(layers.LayerTypeIPv4)
+	// TCP payloads must go through reassembly first - gopacket decodes anything on port 53 as DNS, which only works
+	// for UDP, where each datagram holds a single message with no length prefix.
+	if tcp, isTCP := packet.TransportLayer().(*layers.TCP); isTCP {
+		s.assembleTCPPacket(packet, tcp, captureTime)
+		return
+	}
+
+	_, dstIP, isIP := packetIPs(packet)
 	dnsLayer := packet.Layer(layers.LayerTypeDNS)
-	if dnsLayer != nil && ipLayer != nil {
-		ip, _ := ipLayer.(*layers.IPv4)
-		dns, _ := dnsLayer.(*layers.DNS)
-		if dns.OpCode == layers.DNSOpCodeQuery && dns.ResponseCode == layers.DNSResponseCodeNoErr {
-			cnameToA := getCNameTranslation(dns)
-
-			for _, answer := range dns.Answers {
-				// This is the DNS Answer, so the Dst IP is the pod IP
-				if answer.Type != layers.DNSTypeA && answer.Type != layers.DNSTypeAAAA {
-					continue
-				}
-				hostName := string(answer.Name)
-				if nameFromCNAME, ok := cnameToA[hostName]; ok {
-					logrus.Debugf("Found CNAME record for %s: %s", hostName, nameFromCNAME)
-					hostName = nameFromCNAME
-				}
+	if dnsLayer != nil && isIP {
+		// This is the DNS Answer, so the Dst IP is the pod IP
+		s.handleDNSResponse(dstIP.String(), dnsLayer.(*layers.DNS), captureTime)
+	}
+}
 
-				if !s.isRunningOnAWS {
-					s.addCapturedRequest(ip.DstIP.String(), "", hostName, answer.IP.String(), captureTime, nilable.From(int(answer.TTL)), nil, nil)
-					continue
-				}
-				hostname, ok := s.resolver.ResolveIP(ip.DstIP.String())
-				if !ok {
-					logrus.Debugf("Can't resolve IP addr %s, skipping", ip.DstIP.String())
-				} else {
-					// Resolver cache could be outdated, verify same resolving result after next poll
-					s.pending = append(s.pending, pendingCapture{
-						srcIp:            ip.DstIP.String(),
-						srcHostname:      hostname,
-						destHostnameOrIP: hostName,
-						destIPFromDNS:    answer.IP.String(),
-						time:             captureTime,
-						ttl:              nilable.From(int(answer.TTL)),
-					})
-				}
-			}
+func (s *DNSSniffer) assembleTCPPacket(packet gopacket.Packet, tcp *layers.TCP, captureTime time.Time) {
+	if packet.NetworkLayer() == nil {
+		return
+	}
+	captureInfo := packet.Metadata().CaptureInfo
+	captureInfo.Timestamp = captureTime
+	assemblerContext := dnsTCPAssemblerContext(captureInfo)
+	s.tcpAssembler.AssembleWithContext(packet.NetworkLayer().NetworkFlow(), tcp, &assemblerContext)
+
+	// Streams that were not closed with a FIN or RST, e.g. since the capture missed it, are dropped once idle.
+	// Timeouts are measured in capture time, so they work the same for live and offline captures.
+	timeout := viper.GetDuration(config.DNSTCPStreamTimeoutKey)
+	if captureTime.Sub(s.lastTCPStreamsFlush) >= timeout {
+		s.tcpAssembler.FlushCloseOlderThan(captureTime.Add(-timeout))
+		s.lastTCPStreamsFlush = captureTime
+	}
+}
+
+// FlushTCPStreams handles whatever can still be parsed from the DNS over TCP streams in progress and closes them, e.g.
+// once an offline capture ends.
+func (s *DNSSniffer) FlushTCPStreams() {
+	s.tcpAssembler.FlushAll()
+}
+
+// handleDNSResponse records the A and AAAA answers of a DNS response sent to podIP, whether it was captured over UDP
+// or reassembled from a TCP stream.
+func (s *DNSSniffer) handleDNSResponse(podIP string, dns *layers.DNS, captureTime time.Time) {
+	if dns.OpCode != layers.DNSOpCodeQuery || dns.ResponseCode != layers.DNSResponseCodeNoErr {
+		return
+	}
+	cnameToA := getCNameTranslation(dns)
+
+	for _, answer := range dns.Answers {
+		if answer.Type != layers.DNSTypeA && answer.Type != layers.DNSTypeAAAA {
+			continue
+		}
+		hostName := string(answer.Name)
+		if nameFromCNAME, ok := cnameToA[hostName]; ok {
+			logrus.Debugf("Found CNAME record for %s: %s", hostName, nameFromCNAME)
+			hostName = nameFromCNAME
+		}
+
+		if !s.isRunningOnAWS {
+			s.addCapturedRequest(podIP, "", hostName, answer.IP.String(), captureTime, nilable.From(int(answer.TTL)), nil, nil)
+			continue
+		}
+		hostname, ok := s.resolver.ResolveIP(podIP)
+		if !ok {
+			logrus.Debugf("Can't resolve IP addr %s, skipping", podIP)
+		} else {
+			// Resolver cache could be outdated, verify same resolving result after next poll
+			s.pending = append(s.pending, pendingCapture{
+				srcIp:            podIP,
+				srcHostname:      hostname,
+				destHostnameOrIP: hostName,
+				destIPFromDNS:    answer.IP.String(),
+				time:             captureTime,
+				ttl:              nilable.From(int(answer.TTL)),
+			})
 		}
 	}
 }
@@ -215,6 +272,18 @@ func (s *DNSSniffer) GetTimeTilNextRefresh() time.Duration {
 	return time.Until(nextRefreshTime)
 }
 
+// packetIPs returns the source and destination IPs of an IPv4 or IPv6 packet.
+func packetIPs(packet gopacket.Packet) (srcIP net.IP, dstIP net.IP, ok bool) {
+	switch ip := packet.NetworkLayer().(type) {
+	case *layers.IPv4:
+		return ip.SrcIP, ip.DstIP, true
+	case *layers.IPv6:
+		return ip.SrcIP, ip.DstIP, true
+	default:
+		return nil, nil, false
+	}
+}
+
 func detectCaptureTime(packet gopacket.Packet) time.Time {
 	captureTime := packet.Metadata().CaptureInfo.Timestamp
 	if captureTime.IsZero() {
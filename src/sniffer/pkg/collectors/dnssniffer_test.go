@@ -1,10 +1,14 @@
 package collectors
 
 import (
+	"encoding/binary"
 	"encoding/hex"
 	"github.com/otterize/network-mapper/src/mapperclient"
 	"github.com/otterize/network-mapper/src/sniffer/pkg/ipresolver"
 	"github.com/otterize/nilable"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+	"net"
 	"testing"
 	"time"
 
@@ -78,6 +82,155 @@ func (s *SnifferTestSuite) TestHandlePacketWithCNAME() {
 	}, sniffer.CollectResults())
 }
 
+// serializePacket crafts a packet from the given layers, computing lengths and checksums.
+func serializePacket(t *testing.T, serializableLayers ...gopacket.SerializableLayer) []byte {
+	buf := gopacket.NewSerializeBuffer()
+	require.NoError(t, gopacket.SerializeLayers(buf, gopacket.SerializeOptions{FixLengths: true, ComputeChecksums: true}, serializableLayers...))
+	return buf.Bytes()
+}
+
+func (s *SnifferTestSuite) TestHandleIPv6Packet() {
+	sniffer := NewDNSSniffer(&ipresolver.MockIPResolver{}, false)
+
+	ip := &layers.IPv6{Version: 6, HopLimit: 64, NextHeader: layers.IPProtocolUDP, SrcIP: net.ParseIP("fd00:10:96::a"), DstIP: net.ParseIP("fd00:10:244::5")}
+	udp := &layers.UDP{SrcPort: 53, DstPort: 40000}
+	s.Require().NoError(udp.SetNetworkLayerForChecksum(ip))
+	rawDnsResponse := serializePacket(s.T(),
+		&layers.Ethernet{SrcMAC: net.HardwareAddr{0, 0, 0, 0, 0, 1}, DstMAC: net.HardwareAddr{0, 0, 0, 0, 0, 2}, EthernetType: layers.EthernetTypeIPv6},
+		ip,
+		udp,
+		&layers.DNS{
+			ID: 1, QR: true, OpCode: layers.DNSOpCodeQuery, ResponseCode: layers.DNSResponseCodeNoErr,
+			Questions: []layers.DNSQuestion{{Name: []byte("api.example.com"), Type: layers.DNSTypeAAAA, Class: layers.DNSClassIN}},
+			Answers: []layers.DNSResourceRecord{
+				{Name: []byte("api.example.com"), Type: layers.DNSTypeAAAA, Class: layers.DNSClassIN, TTL: 30, IP: net.ParseIP("2001:db8::1")},
+				{Name: []byte("api.example.com"), Type: layers.DNSTypeA, Class: layers.DNSClassIN, TTL: 30, IP: net.ParseIP("93.184.216.34")},
+			},
+		},
+	)
+	packet := gopacket.NewPacket(rawDnsResponse, layers.LayerTypeEthernet, gopacket.Default)
+	timestamp := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	packet.Metadata().CaptureInfo.Timestamp = timestamp
+	sniffer.HandlePacket(packet)
+	_ = sniffer.RefreshHostsMapping()
+
+	results := sniffer.CollectResults()
+	s.Require().Len(results, 1)
+	s.Require().Equal("fd00:10:244::5", results[0].SrcIp)
+	s.Require().ElementsMatch([]mapperclient.Destination{
+		{
+			Destination:   "api.example.com",
+			DestinationIP: nilable.From("2001:db8::1"),
+			LastSeen:      timestamp,
+			TTL:           nilable.From(30),
+			SrcPorts:      []int{},
+		},
+		{
+			Destination:   "api.example.com",
+			DestinationIP: nilable.From("93.184.216.34"),
+			LastSeen:      timestamp,
+			TTL:           nilable.From(30),
+			SrcPorts:      []int{},
+		},
+	}, results[0].Destinations)
+}
+
+// dnsOverTCPSegment crafts a TCP segment with the given payload, sent from port 53 when fromServer is set.
+func dnsOverTCPSegment(t *testing.T, fromServer bool, seq uint32, payload []byte) gopacket.Packet {
+	clientIP, serverIP := net.IP{10, 101, 81, 13}, net.IP{10, 96, 0, 10}
+	ip := &layers.IPv4{Version: 4, TTL: 64, Protocol: layers.IPProtocolTCP, SrcIP: clientIP, DstIP: serverIP}
+	tcp := &layers.TCP{SrcPort: 40000, DstPort: 53, Seq: seq, ACK: true, PSH: true, Window: 65535}
+	if fromServer {
+		ip.SrcIP, ip.DstIP = serverIP, clientIP
+		tcp.SrcPort, tcp.DstPort = 53, 40000
+	}
+	require.NoError(t, tcp.SetNetworkLayerForChecksum(ip))
+	raw := serializePacket(t,
+		&layers.Ethernet{SrcMAC: net.HardwareAddr{0, 0, 0, 0, 0, 1}, DstMAC: net.HardwareAddr{0, 0, 0, 0, 0, 2}, EthernetType: layers.EthernetTypeIPv4},
+		ip,
+		tcp,
+		gopacket.Payload(payload),
+	)
+	return gopacket.NewPacket(raw, layers.LayerTypeEthernet, gopacket.Default)
+}
+
+// dnsOverTCPMessage serializes the DNS message with its 2-byte length prefix.
+func dnsOverTCPMessage(t *testing.T, dns *layers.DNS) []byte {
+	message := serializePacket(t, dns)
+	return append(binary.BigEndian.AppendUint16(nil, uint16(len(message))), message...)
+}
+
+func (s *SnifferTestSuite) TestHandleTCPPacket() {
+	sniffer := NewDNSSniffer(&ipresolver.MockIPResolver{}, false)
+	question := layers.DNSQuestion{Name: []byte("api.example.com"), Type: layers.DNSTypeA, Class: layers.DNSClassIN}
+	query := dnsOverTCPMessage(s.T(), &layers.DNS{ID: 1, OpCode: layers.DNSOpCodeQuery, Questions: []layers.DNSQuestion{question}})
+	response := dnsOverTCPMessage(s.T(), &layers.DNS{
+		ID: 1, QR: true, OpCode: layers.DNSOpCodeQuery, ResponseCode: layers.DNSResponseCodeNoErr,
+		Questions: []layers.DNSQuestion{question},
+		Answers: []layers.DNSResourceRecord{
+			{Name: []byte("api.example.com"), Type: layers.DNSTypeA, Class: layers.DNSClassIN, TTL: 30, IP: net.ParseIP("93.184.216.34")},
+		},
+	})
+	timestamp := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	// The response is split across two segments, so neither decodes as DNS on its own
+	split := 10
+	packets := []gopacket.Packet{
+		dnsOverTCPSegment(s.T(), false, 1000, query),
+		dnsOverTCPSegment(s.T(), true, 5000, response[:split]),
+		dnsOverTCPSegment(s.T(), true, 5000+uint32(split), response[split:]),
+	}
+	for i, packet := range packets {
+		packet.Metadata().CaptureInfo.Timestamp = timestamp.Add(time.Duration(i) * time.Millisecond)
+		sniffer.HandlePacket(packet)
+	}
+	_ = sniffer.RefreshHostsMapping()
+
+	s.Require().Equal([]mapperclient.RecordedDestinationsForSrc{
+		{
+			SrcIp: "10.101.81.13",
+			Destinations: []mapperclient.Destination{
+				{
+					Destination:   "api.example.com",
+					DestinationIP: nilable.From("93.184.216.34"),
+					LastSeen:      timestamp.Add(2 * time.Millisecond),
+					TTL:           nilable.From(30),
+					SrcPorts:      []int{},
+				},
+			},
+		},
+	}, sniffer.CollectResults())
+}
+
+func (s *SnifferTestSuite) TestHandleTCPPacketMidConnection() {
+	sniffer := NewDNSSniffer(&ipresolver.MockIPResolver{}, false)
+	response := dnsOverTCPMessage(s.T(), &layers.DNS{
+		ID: 1, QR: true, OpCode: layers.DNSOpCodeQuery, ResponseCode: layers.DNSResponseCodeNoErr,
+		Answers: []layers.DNSResourceRecord{
+			{Name: []byte("a.example.com"), Type: layers.DNSTypeA, Class: layers.DNSClassIN, TTL: 30, IP: net.ParseIP("93.184.216.34")},
+		},
+	})
+	secondResponse := dnsOverTCPMessage(s.T(), &layers.DNS{
+		ID: 2, QR: true, OpCode: layers.DNSOpCodeQuery, ResponseCode: layers.DNSResponseCodeNoErr,
+		Answers: []layers.DNSResourceRecord{
+			{Name: []byte("b.example.com"), Type: layers.DNSTypeA, Class: layers.DNSClassIN, TTL: 30, IP: net.ParseIP("93.184.216.35")},
+		},
+	})
+
+	// The capture starts with a response, and a single segment holds two messages
+	packet := dnsOverTCPSegment(s.T(), true, 5000, append(response, secondResponse...))
+	packet.Metadata().CaptureInfo.Timestamp = time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	sniffer.HandlePacket(packet)
+	_ = sniffer.RefreshHostsMapping()
+
+	results := sniffer.CollectResults()
+	s.Require().Len(results, 1)
+	s.Require().Equal("10.101.81.13", results[0].SrcIp)
+	s.Require().ElementsMatch([]string{"a.example.com", "b.example.com"}, lo.Map(results[0].Destinations, func(d mapperclient.Destination, _ int) string {
+		return d.Destination
+	}))
+}
+
 func TestDNSSnifferSuite(t *testing.T) {
 	suite.Run(t, new(SnifferTestSuite))
 }
@@ -0,0 +1,94 @@
+package collectors
+
+import (
+	"encoding/binary"
+	"github.com/google/gopacket"
+	"github.com/google/gopacket/layers"
+	"github.com/google/gopacket/reassembly"
+	"github.com/sirupsen/logrus"
+	"time"
+)
+
+const dnsPort = 53
+
+type dnsResponseCallback func(clientIP string, dns *layers.DNS, captureTime time.Time)
+
+// dnsTCPAssemblerContext passes the packet's capture info to the streams, with the timestamp already resolved by
+// detectCaptureTime.
+type dnsTCPAssemblerContext gopacket.CaptureInfo
+
+func (c *dnsTCPAssemblerContext) GetCaptureInfo() gopacket.CaptureInfo {
+	return gopacket.CaptureInfo(*c)
+}
+
+// dnsTCPStreamFactory creates a dnsTCPStream for every TCP connection to or from port 53.
+type dnsTCPStreamFactory struct {
+	onResponse dnsResponseCallback
+}
+
+func (f *dnsTCPStreamFactory) New(netFlow, _ gopacket.Flow, tcp *layers.TCP, _ reassembly.AssemblerContext) reassembly.Stream {
+	stream := &dnsTCPStream{
+		onResponse:  f.onResponse,
+		clientIP:    netFlow.Src().String(),
+		responseDir: reassembly.TCPDirServerToClient,
+	}
+	if tcp.SrcPort == dnsPort {
+		// The first packet seen is a response, since the capture started mid-connection
+		stream.clientIP = netFlow.Dst().String()
+		stream.responseDir = reassembly.TCPDirClientToServer
+	}
+	return stream
+}
+
+// dnsTCPStream parses the DNS responses sent over a TCP connection. Each message is prefixed by its length as a
+// 2-byte integer (RFC 1035 section 4.2.2), so a message may span several segments, and a segment may hold several
+// messages.
+type dnsTCPStream struct {
+	onResponse  dnsResponseCallback
+	clientIP    string
+	responseDir reassembly.TCPFlowDirection
+}
+
+func (s *dnsTCPStream) Accept(_ *layers.TCP, _ gopacket.CaptureInfo, _ reassembly.TCPFlowDirection, _ reassembly.Sequence, start *bool, _ reassembly.AssemblerContext) bool {
+	// The capture may have started mid-connection, in which case there is no SYN to start reassembling from
+	*start = true
+	return true
+}
+
+func (s *dnsTCPStream) ReassembledSG(sg reassembly.ScatterGather, ac reassembly.AssemblerContext) {
+	direction, _, _, skip := sg.Info()
+	if direction != s.responseDir {
+		return
+	}
+	available, _ := sg.Lengths()
+	if skip != 0 {
+		// Part of the stream was not captured, so message boundaries are lost
+		logrus.Debugf("Missing %d bytes of DNS over TCP stream to %s, dropping buffered data", skip, s.clientIP)
+		sg.KeepFrom(available)
+		return
+	}
+
+	data := sg.Fetch(available)
+	offset := 0
+	for len(data)-offset >= 2 {
+		length := int(binary.BigEndian.Uint16(data[offset:]))
+		if len(data)-offset-2 < length {
+			// The rest of the message has not arrived yet
+			break
+		}
+		message := data[offset+2 : offset+2+length]
+		offset += 2 + length
+
+		dns := &layers.DNS{}
+		if err := dns.DecodeFromBytes(message, gopacket.NilDecodeFeedback); err != nil {
+			logrus.WithError(err).Debugf("Failed to decode DNS over TCP message to %s", s.clientIP)
+			continue
+		}
+		s.onResponse(s.clientIP, dns, ac.GetCaptureInfo().Timestamp)
+	}
+	sg.KeepFrom(offset)
+}
+
+func (s *dnsTCPStream) ReassemblyComplete(_ reassembly.AssemblerContext) bool {
+	return true
+}
@@ -0,0 +1,111 @@
+package collectors
+
+import (
+	"github.com/otterize/intents-operator/src/shared/errors"
+	"github.com/otterize/network-mapper/src/sniffer/pkg/ipresolver"
+	"github.com/otterize/nilable"
+	"github.com/sirupsen/logrus"
+	"net"
+	"time"
+)
+
+var ErrEBPFUnavailable = errors.NewSentinelError("eBPF is not available")
+
+type ConnectionDirection int
+
+const (
+	// ConnectionDirectionConnect is a connection a process on the node opened.
+	ConnectionDirectionConnect ConnectionDirection = iota
+	// ConnectionDirectionAccept is a connection a process on the node accepted.
+	ConnectionDirectionAccept
+)
+
+// ConnectionEvent is a TCP connection opened or accepted on the node.
+type ConnectionEvent struct {
+	Direction ConnectionDirection
+	// CgroupID is the cgroup of the process that opened the connection. It is 0 for accepted connections, which the
+	// kernel establishes outside the context of the accepting process.
+	CgroupID   uint64
+	LocalIP    net.IP
+	LocalPort  int
+	RemoteIP   net.IP
+	RemotePort int
+	Time       time.Time
+}
+
+// ConnectionEventSource delivers connection events until it is closed.
+type ConnectionEventSource interface {
+	Events() <-chan ConnectionEvent
+	Close() error
+}
+
+// EBPFCollector collects the TCP connections reported by eBPF as they are opened, so short-lived connections are seen
+// too. It replaces capturing TCP SYN packets and scanning sockets in procfs.
+type EBPFCollector struct {
+	NetworkCollector
+	source   ConnectionEventSource
+	resolver ipresolver.CgroupResolver
+	pending  []ConnectionEvent
+}
+
+func NewEBPFCollector(source ConnectionEventSource, resolver ipresolver.CgroupResolver) *EBPFCollector {
+	c := EBPFCollector{
+		NetworkCollector: NetworkCollector{},
+		source:           source,
+		resolver:         resolver,
+		pending:          make([]ConnectionEvent, 0),
+	}
+	c.resetData()
+	return &c
+}
+
+func (c *EBPFCollector) Events() <-chan ConnectionEvent {
+	return c.source.Events()
+}
+
+func (c *EBPFCollector) Close() error {
+	return errors.Wrap(c.source.Close())
+}
+
+func (c *EBPFCollector) HandleEvent(event ConnectionEvent) {
+	if event.LocalIP.IsLoopback() || event.RemoteIP.IsLoopback() {
+		// ignore localhost connections as they are irrelevant to the mapping
+		return
+	}
+
+	localIP := event.LocalIP.String()
+	remoteIP := event.RemoteIP.String()
+	if event.Direction == ConnectionDirectionAccept {
+		// The client may be outside the node, so it is reported by IP for the mapper to resolve, like captured SYNs
+		c.addCapturedRequest(remoteIP, "", localIP, localIP, event.Time, nilable.Nilable[int]{}, &event.LocalPort, &event.RemotePort)
+		return
+	}
+
+	hostname, ok := c.resolver.ResolveCgroup(event.CgroupID)
+	if !ok {
+		// The process may have started after the last refresh, so try again once the cgroups are refreshed
+		c.pending = append(c.pending, event)
+		return
+	}
+	c.addCapturedRequest(localIP, hostname, remoteIP, remoteIP, event.Time, nilable.Nilable[int]{}, &event.RemotePort, &event.LocalPort)
+}
+
+func (c *EBPFCollector) RefreshHostsMapping() error {
+	err := c.resolver.Refresh()
+	if err != nil {
+		return errors.Wrap(err)
+	}
+
+	for _, event := range c.pending {
+		localIP := event.LocalIP.String()
+		remoteIP := event.RemoteIP.String()
+		hostname, ok := c.resolver.ResolveCgroup(event.CgroupID)
+		if !ok {
+			// Still reported, the mapper resolves the client by its IP
+			logrus.Debugf("Could not resolve cgroup %d of connection from %s, reporting it by IP", event.CgroupID, localIP)
+		}
+		c.addCapturedRequest(localIP, hostname, remoteIP, remoteIP, event.Time, nilable.Nilable[int]{}, &event.RemotePort, &event.LocalPort)
+	}
+	c.pending = make([]ConnectionEvent, 0)
+	return nil
+}
@@ -0,0 +1,137 @@
+package collectors
+
+import (
+	"github.com/otterize/network-mapper/src/mapperclient"
+	"github.com/otterize/nilable"
+	"github.com/stretchr/testify/suite"
+	"net"
+	"testing"
+	"time"
+)
+
+type fakeConnectionEventSource struct {
+	events chan ConnectionEvent
+}
+
+func (s *fakeConnectionEventSource) Events() <-chan ConnectionEvent {
+	return s.events
+}
+
+func (s *fakeConnectionEventSource) Close() error {
+	close(s.events)
+	return nil
+}
+
+type fakeCgroupResolver struct {
+	hostnames map[uint64]string
+	refreshed map[uint64]string
+}
+
+func (r *fakeCgroupResolver) Refresh() error {
+	for cgroupID, hostname := range r.refreshed {
+		r.hostnames[cgroupID] = hostname
+	}
+	return nil
+}
+
+func (r *fakeCgroupResolver) ResolveCgroup(cgroupID uint64) (string, bool) {
+	hostname, ok := r.hostnames[cgroupID]
+	return hostname, ok
+}
+
+type EBPFCollectorTestSuite struct {
+	suite.Suite
+	source    *fakeConnectionEventSource
+	resolver  *fakeCgroupResolver
+	collector *EBPFCollector
+	timestamp time.Time
+}
+
+func (s *EBPFCollectorTestSuite) SetupTest() {
+	s.source = &fakeConnectionEventSource{events: make(chan ConnectionEvent, 10)}
+	s.resolver = &fakeCgroupResolver{hostnames: map[uint64]string{1: "client-pod"}, refreshed: map[uint64]string{}}
+	s.collector = NewEBPFCollector(s.source, s.resolver)
+	s.timestamp = time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+}
+
+// handleEvents sends the events through the fake source and handles them as the sniffer does.
+func (s *EBPFCollectorTestSuite) handleEvents(events ...ConnectionEvent) {
+	for _, event := range events {
+		s.source.events <- event
+	}
+	for range events {
+		s.collector.HandleEvent(<-s.collector.Events())
+	}
+}
+
+func (s *EBPFCollectorTestSuite) TestConnect() {
+	s.handleEvents(
+		ConnectionEvent{Direction: ConnectionDirectionConnect, CgroupID: 1, LocalIP: net.ParseIP("10.0.0.5"), LocalPort: 40000, RemoteIP: net.ParseIP("10.0.0.6"), RemotePort: 8080, Time: s.timestamp},
+		ConnectionEvent{Direction: ConnectionDirectionConnect, CgroupID: 1, LocalIP: net.ParseIP("10.0.0.5"), LocalPort: 40001, RemoteIP: net.ParseIP("10.0.0.6"), RemotePort: 8080, Time: s.timestamp},
+		// Loopback connections are ignored
+		ConnectionEvent{Direction: ConnectionDirectionConnect, CgroupID: 1, LocalIP: net.ParseIP("127.0.0.1"), LocalPort: 40002, RemoteIP: net.ParseIP("127.0.0.1"), RemotePort: 8080, Time: s.timestamp},
+	)
+
+	results := s.collector.CollectResults()
+	s.Require().Len(results, 1)
+	s.Require().Equal("10.0.0.5", results[0].SrcIp)
+	s.Require().Equal("client-pod", results[0].SrcHostname)
+	s.Require().Len(results[0].Destinations, 1)
+	s.Require().ElementsMatch([]int{40000, 40001}, results[0].Destinations[0].SrcPorts)
+	results[0].Destinations[0].SrcPorts = nil
+	s.Require().Equal(mapperclient.Destination{
+		Destination:     "10.0.0.6",
+		DestinationIP:   nilable.From("10.0.0.6"),
+		DestinationPort: nilable.From(8080),
+		LastSeen:        s.timestamp,
+	}, results[0].Destinations[0])
+}
+
+func (s *EBPFCollectorTestSuite) TestAccept() {
+	s.handleEvents(ConnectionEvent{Direction: ConnectionDirectionAccept, LocalIP: net.ParseIP("fd00::5"), LocalPort: 8080, RemoteIP: net.ParseIP("fd00::6"), RemotePort: 40000, Time: s.timestamp})
+
+	s.Require().Equal([]mapperclient.RecordedDestinationsForSrc{
+		{
+			SrcIp: "fd00::6",
+			Destinations: []mapperclient.Destination{
+				{
+					Destination:     "fd00::5",
+					DestinationIP:   nilable.From("fd00::5"),
+					DestinationPort: nilable.From(8080),
+					LastSeen:        s.timestamp,
+					SrcPorts:        []int{40000},
+				},
+			},
+		},
+	}, s.collector.CollectResults())
+}
+
+func (s *EBPFCollectorTestSuite) TestConnectFromUnknownCgroup() {
+	s.resolver.refreshed[2] = "new-pod"
+	s.handleEvents(
+		ConnectionEvent{Direction: ConnectionDirectionConnect, CgroupID: 2, LocalIP: net.ParseIP("10.0.0.7"), LocalPort: 40000, RemoteIP: net.ParseIP("10.0.0.6"), RemotePort: 8080, Time: s.timestamp},
+		ConnectionEvent{Direction: ConnectionDirectionConnect, CgroupID: 3, LocalIP: net.ParseIP("10.0.0.8"), LocalPort: 40000, RemoteIP: net.ParseIP("10.0.0.6"), RemotePort: 8080, Time: s.timestamp},
+	)
+	s.Require().Empty(s.collector.CollectResults())
+
+	// Once refreshed, the connection is reported with the pod's hostname, or by IP if the cgroup is still unknown
+	s.Require().NoError(s.collector.RefreshHostsMapping())
+	results := s.collector.CollectResults()
+	s.Require().Len(results, 2)
+	hostnames := map[string]string{results[0].SrcIp: results[0].SrcHostname, results[1].SrcIp: results[1].SrcHostname}
+	s.Require().Equal(map[string]string{"10.0.0.7": "new-pod", "10.0.0.8": ""}, hostnames)
+
+	// Pending connections are only reported once
+	s.Require().NoError(s.collector.RefreshHostsMapping())
+	s.Require().Empty(s.collector.CollectResults())
+}
+
+func (s *EBPFCollectorTestSuite) TestClose() {
+	s.Require().NoError(s.collector.Close())
+	_, open := <-s.collector.Events()
+	s.Require().False(open)
+}
+
+func TestEBPFCollectorTestSuite(t *testing.T) {
+	suite.Run(t, new(EBPFCollectorTestSuite))
+}
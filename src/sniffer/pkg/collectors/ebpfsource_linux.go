@@ -0,0 +1,329 @@
+//go:build linux
+
+package collectors
+
+import (
+	"encoding/binary"
+	"github.com/cilium/ebpf"
+	"github.com/cilium/ebpf/asm"
+	"github.com/cilium/ebpf/link"
+	"github.com/cilium/ebpf/perf"
+	"github.com/cilium/ebpf/rlimit"
+	"github.com/otterize/intents-operator/src/shared/errors"
+	"github.com/sirupsen/logrus"
+	"io"
+	"net"
+	"os"
+	"regexp"
+	"strconv"
+	"time"
+)
+
+const (
+	tracepointGroup = "sock"
+	tracepointName  = "inet_sock_set_state"
+
+	tcpEstablished = 1
+	tcpSynSent     = 2
+	tcpSynRecv     = 3
+
+	afInet     = 2
+	afInet6    = 10
+	ipProtoTCP = 6
+
+	// commonFieldsSize is the size of the fields every tracepoint record starts with, which programs can't read
+	commonFieldsSize = 8
+
+	// maxConnectingSockets bounds the sockets tracked between SYN_SENT and the next state, in case events are lost
+	maxConnectingSockets = 65536
+)
+
+var tracepointFormatPaths = []string{
+	"/sys/kernel/tracing/events/sock/inet_sock_set_state/format",
+	"/sys/kernel/debug/tracing/events/sock/inet_sock_set_state/format",
+}
+
+var tracepointFieldRegex = regexp.MustCompile(`field:[^;]*?\b(\w+)(?:\[\d+\])?;\s*offset:(\d+);\s*size:(\d+);`)
+
+type tracepointField struct {
+	offset int
+	size   int
+}
+
+// inetSockSetStateFormat holds the layout of the inet_sock_set_state tracepoint record, which differs between kernel
+// versions.
+type inetSockSetStateFormat struct {
+	fields     map[string]tracepointField
+	recordSize int
+}
+
+func readInetSockSetStateFormat() (inetSockSetStateFormat, error) {
+	for _, path := range tracepointFormatPaths {
+		content, err := os.ReadFile(path)
+		if os.IsNotExist(err) {
+			continue
+		}
+		if err != nil {
+			return inetSockSetStateFormat{}, errors.Wrap(err)
+		}
+		return parseInetSockSetStateFormat(string(content))
+	}
+	return inetSockSetStateFormat{}, errors.Errorf("tracepoint %s/%s not found: %w", tracepointGroup, tracepointName, ErrEBPFUnavailable)
+}
+
+func parseInetSockSetStateFormat(content string) (inetSockSetStateFormat, error) {
+	format := inetSockSetStateFormat{fields: make(map[string]tracepointField)}
+	for _, match := range tracepointFieldRegex.FindAllStringSubmatch(content, -1) {
+		offset, _ := strconv.Atoi(match[2])
+		size, _ := strconv.Atoi(match[3])
+		format.fields[match[1]] = tracepointField{offset: offset, size: size}
+		format.recordSize = max(format.recordSize, offset+size)
+	}
+	for _, name := range []string{"skaddr", "oldstate", "newstate", "sport", "dport", "family", "saddr", "daddr", "saddr_v6", "daddr_v6"} {
+		if _, ok := format.fields[name]; !ok {
+			return inetSockSetStateFormat{}, errors.Errorf("tracepoint %s/%s has no field '%s': %w", tracepointGroup, tracepointName, name, ErrEBPFUnavailable)
+		}
+	}
+	// The program copies the record in 8 byte words
+	format.recordSize = (format.recordSize + 7) / 8 * 8
+	return format, nil
+}
+
+// program emits the tracepoint record, with the common fields replaced by the cgroup ID of the current process, for
+// sockets moving to or from SYN_SENT, and from SYN_RECV to ESTABLISHED. Only moving to SYN_SENT happens in the
+// context of the connecting process - the others happen as packets are received.
+func (f inetSockSetStateFormat) program(events *ebpf.Map) asm.Instructions {
+	bufferOffset := -int16(f.recordSize)
+	instructions := asm.Instructions{
+		asm.Mov.Reg(asm.R6, asm.R1),
+		asm.LoadMem(asm.R7, asm.R6, int16(f.fields["newstate"].offset), asm.Word),
+		asm.LoadMem(asm.R8, asm.R6, int16(f.fields["oldstate"].offset), asm.Word),
+		asm.JEq.Imm(asm.R7, tcpSynSent, "emit"),
+		asm.JEq.Imm(asm.R8, tcpSynSent, "emit"),
+		asm.JNE.Imm(asm.R7, tcpEstablished, "exit"),
+		asm.JNE.Imm(asm.R8, tcpSynRecv, "exit"),
+		asm.FnGetCurrentCgroupId.Call().WithSymbol("emit"),
+		asm.StoreMem(asm.RFP, bufferOffset, asm.R0, asm.DWord),
+	}
+	for offset := commonFieldsSize; offset < f.recordSize; offset += 8 {
+		instructions = append(instructions,
+			asm.LoadMem(asm.R1, asm.R6, int16(offset), asm.DWord),
+			asm.StoreMem(asm.RFP, bufferOffset+int16(offset), asm.R1, asm.DWord),
+		)
+	}
+	return append(instructions,
+		asm.Mov.Reg(asm.R1, asm.R6),
+		asm.LoadMapPtr(asm.R2, events.FD()),
+		asm.LoadImm(asm.R3, 0xffffffff, asm.DWord), // BPF_F_CURRENT_CPU
+		asm.Mov.Reg(asm.R4, asm.RFP),
+		asm.Add.Imm(asm.R4, int32(bufferOffset)),
+		asm.Mov.Imm(asm.R5, int32(f.recordSize)),
+		asm.FnPerfEventOutput.Call(),
+		asm.Mov.Imm(asm.R0, 0).WithSymbol("exit"),
+		asm.Return(),
+	)
+}
+
+func (f inetSockSetStateFormat) uint16Field(record []byte, name string) int {
+	field := f.fields[name]
+	return int(binary.NativeEndian.Uint16(record[field.offset:]))
+}
+
+func (f inetSockSetStateFormat) bytesField(record []byte, name string) []byte {
+	field := f.fields[name]
+	return record[field.offset : field.offset+field.size]
+}
+
+func (f inetSockSetStateFormat) stateField(record []byte, name string) int {
+	field := f.fields[name]
+	return int(binary.NativeEndian.Uint32(record[field.offset:]))
+}
+
+// socketStateChange is a sample emitted by the program.
+type socketStateChange struct {
+	socket   uint64
+	cgroupID uint64
+	oldState int
+	newState int
+	// connection holds the addresses of the connection. The socket's source is always the local end, whether the
+	// connection was opened or accepted.
+	connection ConnectionEvent
+}
+
+// parseSample parses a sample emitted by the program. Samples for other protocols than TCP are skipped.
+func (f inetSockSetStateFormat) parseSample(sample []byte) (socketStateChange, bool) {
+	if len(sample) < f.recordSize {
+		return socketStateChange{}, false
+	}
+	if _, ok := f.fields["protocol"]; ok && f.uint16Field(sample, "protocol") != ipProtoTCP {
+		return socketStateChange{}, false
+	}
+
+	change := socketStateChange{
+		socket:   binary.NativeEndian.Uint64(sample[f.fields["skaddr"].offset:]),
+		cgroupID: binary.NativeEndian.Uint64(sample),
+		oldState: f.stateField(sample, "oldstate"),
+		newState: f.stateField(sample, "newstate"),
+		connection: ConnectionEvent{
+			LocalPort:  f.uint16Field(sample, "sport"),
+			RemotePort: f.uint16Field(sample, "dport"),
+			Time:       time.Now(),
+		},
+	}
+	switch f.uint16Field(sample, "family") {
+	case afInet:
+		change.connection.LocalIP = net.IP(append([]byte(nil), f.bytesField(sample, "saddr")...))
+		change.connection.RemoteIP = net.IP(append([]byte(nil), f.bytesField(sample, "daddr")...))
+	case afInet6:
+		change.connection.LocalIP = net.IP(append([]byte(nil), f.bytesField(sample, "saddr_v6")...))
+		change.connection.RemoteIP = net.IP(append([]byte(nil), f.bytesField(sample, "daddr_v6")...))
+	default:
+		return socketStateChange{}, false
+	}
+	return change, true
+}
+
+type ebpfConnectionEventSource struct {
+	format     inetSockSetStateFormat
+	events     *ebpf.Map
+	program    *ebpf.Program
+	tracepoint link.Link
+	reader     *perf.Reader
+	output     chan ConnectionEvent
+	// connecting maps the sockets in SYN_SENT to the cgroup of the process that opened them
+	connecting map[uint64]uint64
+}
+
+// NewEBPFConnectionEventSource attaches a program to the inet_sock_set_state tracepoint, reporting the TCP connections
+// opened and accepted on the node. Errors wrap ErrEBPFUnavailable when the kernel or the sniffer's privileges don't
+// allow it.
+func NewEBPFConnectionEventSource() (ConnectionEventSource, error) {
+	format, err := readInetSockSetStateFormat()
+	if err != nil {
+		return nil, errors.Wrap(err)
+	}
+	if err := rlimit.RemoveMemlock(); err != nil {
+		return nil, errors.Errorf("failed to remove memlock limit: %w: %w", err, ErrEBPFUnavailable)
+	}
+
+	s := newEBPFConnectionEventSource(format)
+	s.events, err = ebpf.NewMap(&ebpf.MapSpec{Type: ebpf.PerfEventArray})
+	if err != nil {
+		return nil, errors.Errorf("failed to create perf event array: %w: %w", err, ErrEBPFUnavailable)
+	}
+	s.program, err = ebpf.NewProgram(&ebpf.ProgramSpec{
+		Type:         ebpf.TracePoint,
+		License:      "Dual MIT/GPL",
+		Instructions: format.program(s.events),
+	})
+	if err != nil {
+		_ = s.close()
+		return nil, errors.Errorf("failed to load program: %w: %w", err, ErrEBPFUnavailable)
+	}
+	s.tracepoint, err = link.Tracepoint(tracepointGroup, tracepointName, s.program, nil)
+	if err != nil {
+		_ = s.close()
+		return nil, errors.Errorf("failed to attach to tracepoint: %w: %w", err, ErrEBPFUnavailable)
+	}
+	s.reader, err = perf.NewReader(s.events, 64*os.Getpagesize())
+	if err != nil {
+		_ = s.close()
+		return nil, errors.Errorf("failed to read perf events: %w: %w", err, ErrEBPFUnavailable)
+	}
+
+	go s.read()
+	return s, nil
+}
+
+func newEBPFConnectionEventSource(format inetSockSetStateFormat) *ebpfConnectionEventSource {
+	return &ebpfConnectionEventSource{
+		format:     format,
+		output:     make(chan ConnectionEvent),
+		connecting: make(map[uint64]uint64),
+	}
+}
+
+func (s *ebpfConnectionEventSource) read() {
+	defer close(s.output)
+	for {
+		record, err := s.reader.Read()
+		if errors.Is(err, perf.ErrClosed) {
+			return
+		}
+		if err != nil {
+			logrus.WithError(err).Error("Failed to read eBPF connection event")
+			continue
+		}
+		if record.LostSamples != 0 {
+			logrus.Warnf("Lost %d eBPF connection events, the perf buffer is full", record.LostSamples)
+			continue
+		}
+		if event, ok := s.handleSample(record.RawSample); ok {
+			s.output <- event
+		}
+	}
+}
+
+// handleSample returns the connection a sample completes, if any.
+func (s *ebpfConnectionEventSource) handleSample(sample []byte) (ConnectionEvent, bool) {
+	change, ok := s.format.parseSample(sample)
+	if !ok {
+		return ConnectionEvent{}, false
+	}
+
+	switch {
+	case change.newState == tcpSynSent:
+		// The source port is only assigned once the socket is in SYN_SENT, so the connection is reported when it
+		// leaves that state, with the cgroup of the connecting process remembered until then
+		if len(s.connecting) >= maxConnectingSockets {
+			logrus.Warnf("Tracking over %d connecting sockets, dropping them", maxConnectingSockets)
+			clear(s.connecting)
+		}
+		s.connecting[change.socket] = change.cgroupID
+		return ConnectionEvent{}, false
+	case change.oldState == tcpSynSent:
+		cgroupID, ok := s.connecting[change.socket]
+		delete(s.connecting, change.socket)
+		if !ok || change.newState != tcpEstablished {
+			return ConnectionEvent{}, false
+		}
+		change.connection.Direction = ConnectionDirectionConnect
+		change.connection.CgroupID = cgroupID
+		return change.connection, true
+	default:
+		change.connection.Direction = ConnectionDirectionAccept
+		return change.connection, true
+	}
+}
+
+func (s *ebpfConnectionEventSource) Events() <-chan ConnectionEvent {
+	return s.output
+}
+
+func (s *ebpfConnectionEventSource) Close() error {
+	return errors.Wrap(s.close())
+}
+
+func (s *ebpfConnectionEventSource) close() error {
+	closers := make([]io.Closer, 0)
+	if s.reader != nil {
+		closers = append(closers, s.reader)
+	}
+	if s.tracepoint != nil {
+		closers = append(closers, s.tracepoint)
+	}
+	if s.program != nil {
+		closers = append(closers, s.program)
+	}
+	if s.events != nil {
+		closers = append(closers, s.events)
+	}
+
+	var firstErr error
+	for _, closer := range closers {
+		if err := closer.Close(); err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+	return firstErr
+}
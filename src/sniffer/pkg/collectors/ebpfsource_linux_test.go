@@ -0,0 +1,111 @@
+//go:build linux
+
+package collectors
+
+import (
+	"encoding/binary"
+	"github.com/stretchr/testify/require"
+	"net"
+	"testing"
+)
+
+const inetSockSetStateFormatContent = `name: inet_sock_set_state
+ID: 1363
+format:
+	field:unsigned short common_type;	offset:0;	size:2;	signed:0;
+	field:unsigned char common_flags;	offset:2;	size:1;	signed:0;
+	field:unsigned char common_preempt_count;	offset:3;	size:1;	signed:0;
+	field:int common_pid;	offset:4;	size:4;	signed:1;
+
+	field:const void * skaddr;	offset:8;	size:8;	signed:0;
+	field:int oldstate;	offset:16;	size:4;	signed:1;
+	field:int newstate;	offset:20;	size:4;	signed:1;
+	field:__u16 sport;	offset:24;	size:2;	signed:0;
+	field:__u16 dport;	offset:26;	size:2;	signed:0;
+	field:__u16 family;	offset:28;	size:2;	signed:0;
+	field:__u16 protocol;	offset:30;	size:2;	signed:0;
+	field:__u8 saddr[4];	offset:32;	size:4;	signed:0;
+	field:__u8 daddr[4];	offset:36;	size:4;	signed:0;
+	field:__u8 saddr_v6[16];	offset:40;	size:16;	signed:0;
+	field:__u8 daddr_v6[16];	offset:56;	size:16;	signed:0;
+
+print fmt: "family=%s protocol=%s sport=%hu dport=%hu saddr=%pI4 daddr=%pI4 saddrv6=%pI6c daddrv6=%pI6c oldstate=%s newstate=%s"
+`
+
+type testSocketStateChange struct {
+	socket, cgroupID      uint64
+	oldState, newState    int
+	localIP, remoteIP     net.IP
+	localPort, remotePort int
+	protocol              int
+}
+
+func (c testSocketStateChange) sample(format inetSockSetStateFormat) []byte {
+	sample := make([]byte, format.recordSize)
+	binary.NativeEndian.PutUint64(sample, c.cgroupID)
+	binary.NativeEndian.PutUint64(sample[format.fields["skaddr"].offset:], c.socket)
+	binary.NativeEndian.PutUint32(sample[format.fields["oldstate"].offset:], uint32(c.oldState))
+	binary.NativeEndian.PutUint32(sample[format.fields["newstate"].offset:], uint32(c.newState))
+	binary.NativeEndian.PutUint16(sample[format.fields["sport"].offset:], uint16(c.localPort))
+	binary.NativeEndian.PutUint16(sample[format.fields["dport"].offset:], uint16(c.remotePort))
+	binary.NativeEndian.PutUint16(sample[format.fields["protocol"].offset:], uint16(c.protocol))
+	if ip := c.localIP.To4(); ip != nil {
+		binary.NativeEndian.PutUint16(sample[format.fields["family"].offset:], afInet)
+		copy(sample[format.fields["saddr"].offset:], ip)
+		copy(sample[format.fields["daddr"].offset:], c.remoteIP.To4())
+	} else {
+		binary.NativeEndian.PutUint16(sample[format.fields["family"].offset:], afInet6)
+		copy(sample[format.fields["saddr_v6"].offset:], c.localIP.To16())
+		copy(sample[format.fields["daddr_v6"].offset:], c.remoteIP.To16())
+	}
+	return sample
+}
+
+func TestParseInetSockSetStateFormat(t *testing.T) {
+	format, err := parseInetSockSetStateFormat(inetSockSetStateFormatContent)
+	require.NoError(t, err)
+	require.Equal(t, 72, format.recordSize)
+	require.Equal(t, tracepointField{offset: 40, size: 16}, format.fields["saddr_v6"])
+	require.Equal(t, tracepointField{offset: 20, size: 4}, format.fields["newstate"])
+
+	_, err = parseInetSockSetStateFormat("format:\n\tfield:int oldstate;\toffset:16;\tsize:4;\tsigned:1;\n")
+	require.ErrorIs(t, err, ErrEBPFUnavailable)
+}
+
+func TestEBPFConnectionEventSourceHandleSample(t *testing.T) {
+	format, err := parseInetSockSetStateFormat(inetSockSetStateFormatContent)
+	require.NoError(t, err)
+	source := newEBPFConnectionEventSource(format)
+
+	clientIP, serverIP := net.ParseIP("10.0.0.5"), net.ParseIP("10.0.0.6")
+	// The source port is not assigned yet when the socket moves to SYN_SENT, and the cgroup is only known then
+	_, ok := source.handleSample(testSocketStateChange{socket: 1, cgroupID: 42, oldState: 7, newState: tcpSynSent, localIP: clientIP, remoteIP: serverIP, remotePort: 8080, protocol: ipProtoTCP}.sample(format))
+	require.False(t, ok)
+	event, ok := source.handleSample(testSocketStateChange{socket: 1, cgroupID: 99, oldState: tcpSynSent, newState: tcpEstablished, localIP: clientIP, localPort: 40000, remoteIP: serverIP, remotePort: 8080, protocol: ipProtoTCP}.sample(format))
+	require.True(t, ok)
+	require.Equal(t, ConnectionDirectionConnect, event.Direction)
+	require.Equal(t, uint64(42), event.CgroupID)
+	require.Equal(t, "10.0.0.5", event.LocalIP.String())
+	require.Equal(t, 40000, event.LocalPort)
+	require.Equal(t, "10.0.0.6", event.RemoteIP.String())
+	require.Equal(t, 8080, event.RemotePort)
+	require.Empty(t, source.connecting)
+
+	// Connections that failed are not reported
+	_, ok = source.handleSample(testSocketStateChange{socket: 2, cgroupID: 42, oldState: 7, newState: tcpSynSent, localIP: clientIP, remoteIP: serverIP, protocol: ipProtoTCP}.sample(format))
+	require.False(t, ok)
+	_, ok = source.handleSample(testSocketStateChange{socket: 2, oldState: tcpSynSent, newState: 7, localIP: clientIP, remoteIP: serverIP, protocol: ipProtoTCP}.sample(format))
+	require.False(t, ok)
+	require.Empty(t, source.connecting)
+
+	event, ok = source.handleSample(testSocketStateChange{socket: 3, cgroupID: 99, oldState: tcpSynRecv, newState: tcpEstablished, localIP: net.ParseIP("fd00::6"), localPort: 8080, remoteIP: net.ParseIP("fd00::5"), remotePort: 40000, protocol: ipProtoTCP}.sample(format))
+	require.True(t, ok)
+	require.Equal(t, ConnectionDirectionAccept, event.Direction)
+	require.Zero(t, event.CgroupID)
+	require.Equal(t, "fd00::6", event.LocalIP.String())
+	require.Equal(t, "fd00::5", event.RemoteIP.String())
+
+	// Other protocols are skipped
+	_, ok = source.handleSample(testSocketStateChange{socket: 4, oldState: tcpSynRecv, newState: tcpEstablished, localIP: serverIP, remoteIP: clientIP, protocol: 132}.sample(format))
+	require.False(t, ok)
+}
@@ -0,0 +1,10 @@
+//go:build !linux
+
+package collectors
+
+import "github.com/otterize/intents-operator/src/shared/errors"
+
+// NewEBPFConnectionEventSource is only supported on Linux.
+func NewEBPFConnectionEventSource() (ConnectionEventSource, error) {
+	return nil, errors.Wrap(ErrEBPFUnavailable)
+}
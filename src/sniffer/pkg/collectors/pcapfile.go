@@ -0,0 +1,31 @@
+package collectors
+
+import (
+	"github.com/google/gopacket"
+	"github.com/google/gopacket/pcap"
+	"github.com/otterize/intents-operator/src/shared/errors"
+)
+
+// createPacketStreamFromFile reads the packets matching the BPF filter from a pcap or pcapng file. The channel is closed,
+// and the file with it, once all packets are read.
+func createPacketStreamFromFile(path string, filter string) (chan gopacket.Packet, error) {
+	handle, err := pcap.OpenOffline(path)
+	if err != nil {
+		return nil, errors.Wrap(err)
+	}
+	err = handle.SetBPFFilter(filter)
+	if err != nil {
+		handle.Close()
+		return nil, errors.Wrap(err)
+	}
+
+	packets := make(chan gopacket.Packet)
+	go func() {
+		defer handle.Close()
+		defer close(packets)
+		for packet := range gopacket.NewPacketSource(handle, handle.LinkType()).Packets() {
+			packets <- packet
+		}
+	}()
+	return packets, nil
+}
@@ -0,0 +1,57 @@
+package collectors
+
+import (
+	"github.com/otterize/network-mapper/src/mapperclient"
+	"github.com/samber/lo"
+)
+
+// ResultsSpool holds collected results that could not be reported to the mapper, so they are reported by a later
+// attempt instead of being lost. Results for the same UniqueRequest are merged, keeping the last time seen and all the
+// source ports, so the spool grows with the number of distinct requests rather than with the number of failed reports.
+type ResultsSpool struct {
+	NetworkCollector
+	maxEntries int
+}
+
+func NewResultsSpool(maxEntries int) *ResultsSpool {
+	s := ResultsSpool{
+		NetworkCollector: NetworkCollector{},
+		maxEntries:       maxEntries,
+	}
+	s.resetData()
+	return &s
+}
+
+// Add merges results into the spool. Once the spool holds maxEntries requests, results for new requests are dropped,
+// and their number is returned.
+func (s *ResultsSpool) Add(results []mapperclient.RecordedDestinationsForSrc) int {
+	dropped := 0
+	for _, result := range results {
+		for _, dest := range result.Destinations {
+			req := UniqueRequest{result.SrcIp, result.SrcHostname, dest.Destination, dest.DestinationIP.Item, dest.DestinationPort}
+			existingRequest, requestFound := s.capturedRequests[req]
+			if !requestFound {
+				if len(s.capturedRequests) >= s.maxEntries {
+					dropped++
+					continue
+				}
+				existingRequest = TimeAndTTL{dest.LastSeen, dest.TTL, lo.ToPtr(make(SourcePortsSet))}
+			}
+
+			for _, srcPort := range dest.SrcPorts {
+				(*existingRequest.srcPorts)[srcPort] = struct{}{}
+			}
+			if dest.LastSeen.After(existingRequest.lastSeen) {
+				existingRequest.lastSeen = dest.LastSeen
+				existingRequest.ttl = dest.TTL
+			}
+			s.capturedRequests[req] = existingRequest
+		}
+	}
+	return dropped
+}
+
+// Len returns the number of requests held in the spool.
+func (s *ResultsSpool) Len() int {
+	return len(s.capturedRequests)
+}
@@ -0,0 +1,70 @@
+package collectors
+
+import (
+	"github.com/otterize/network-mapper/src/mapperclient"
+	"github.com/otterize/nilable"
+	"github.com/stretchr/testify/suite"
+	"slices"
+	"testing"
+	"time"
+)
+
+type ResultsSpoolTestSuite struct {
+	suite.Suite
+	timestamp time.Time
+}
+
+func (s *ResultsSpoolTestSuite) SetupTest() {
+	s.timestamp = time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+}
+
+func (s *ResultsSpoolTestSuite) result(destIP string, lastSeen time.Time, ttl int, srcPort int) mapperclient.RecordedDestinationsForSrc {
+	return mapperclient.RecordedDestinationsForSrc{
+		SrcIp:       "10.0.0.5",
+		SrcHostname: "client",
+		Destinations: []mapperclient.Destination{
+			{
+				Destination:     destIP,
+				DestinationIP:   nilable.From(destIP),
+				DestinationPort: nilable.From(8080),
+				TTL:             nilable.From(ttl),
+				LastSeen:        lastSeen,
+				SrcPorts:        []int{srcPort},
+			},
+		},
+	}
+}
+
+func (s *ResultsSpoolTestSuite) TestResultsForSameRequestAreMerged() {
+	spool := NewResultsSpool(10)
+	s.Require().Zero(spool.Add([]mapperclient.RecordedDestinationsForSrc{s.result("10.0.0.6", s.timestamp.Add(time.Minute), 60, 40000)}))
+	// Results of an older report that failed are merged into newer ones
+	s.Require().Zero(spool.Add([]mapperclient.RecordedDestinationsForSrc{s.result("10.0.0.6", s.timestamp, 30, 40001)}))
+	s.Require().Equal(1, spool.Len())
+
+	results := spool.CollectResults()
+	s.Require().Len(results, 1)
+	s.Require().Len(results[0].Destinations, 1)
+	destination := results[0].Destinations[0]
+	s.Require().Equal(s.timestamp.Add(time.Minute), destination.LastSeen)
+	s.Require().Equal(nilable.From(60), destination.TTL)
+	slices.Sort(destination.SrcPorts)
+	s.Require().Equal([]int{40000, 40001}, destination.SrcPorts)
+	s.Require().Zero(spool.Len())
+}
+
+func (s *ResultsSpoolTestSuite) TestNewRequestsAreDroppedWhenFull() {
+	spool := NewResultsSpool(1)
+	s.Require().Zero(spool.Add([]mapperclient.RecordedDestinationsForSrc{s.result("10.0.0.6", s.timestamp, 60, 40000)}))
+	s.Require().Equal(1, spool.Add([]mapperclient.RecordedDestinationsForSrc{s.result("10.0.0.7", s.timestamp, 60, 40000)}))
+	// Requests already spooled are still merged
+	s.Require().Zero(spool.Add([]mapperclient.RecordedDestinationsForSrc{s.result("10.0.0.6", s.timestamp, 60, 40001)}))
+
+	results := spool.CollectResults()
+	s.Require().Len(results, 1)
+	s.Require().Equal("10.0.0.6", results[0].Destinations[0].Destination)
+}
+
+func TestResultsSpoolTestSuite(t *testing.T) {
+	suite.Run(t, new(ResultsSpoolTestSuite))
+}
@@ -11,18 +11,21 @@ import (
 	"github.com/otterize/nilable"
 	"github.com/sirupsen/logrus"
 	"github.com/spf13/viper"
+	"net"
 	"time"
 )
 
 type TCPSniffer struct {
 	NetworkCollector
 	resolver       ipresolver.IPResolver
-	pending        []pendingTCPCapture
+	pending        []pendingConnectionCapture
 	lastRefresh    time.Time
 	isRunningOnAWS bool
 }
 
-type pendingTCPCapture struct {
+// pendingConnectionCapture is a connection whose source was resolved to a hostname, to be reported once the resolving
+// is verified by the next refresh.
+type pendingConnectionCapture struct {
 	srcIp       string
 	srcHostname string
 	destIp      string
@@ -36,7 +39,7 @@ func NewTCPSniffer(resolver ipresolver.IPResolver, isRunningOnAWS bool) *TCPSnif
 	s := TCPSniffer{
 		NetworkCollector: NetworkCollector{},
 		resolver:         resolver,
-		pending:          make([]pendingTCPCapture, 0),
+		pending:          make([]pendingConnectionCapture, 0),
 		lastRefresh:      time.Now().Add(-viper.GetDuration(config.HostsMappingRefreshIntervalKey)), // Should refresh immediately
 		isRunningOnAWS:   isRunningOnAWS,
 	}
@@ -44,12 +47,16 @@ func NewTCPSniffer(resolver ipresolver.IPResolver, isRunningOnAWS bool) *TCPSnif
 	return &s
 }
 
+// tcpSYNFilter matches TCP SYN packets. tcp[tcpflags] only applies to IPv4, so IPv6 SYNs are matched by their flags
+// byte following the fixed IPv6 header.
+const tcpSYNFilter = "(tcp and tcp[tcpflags] == tcp-syn) or (ip6 and ip6[6] == 6 and ip6[53] == 0x02)"
+
 func setCaptureIncomingTCPSYN(handle *pcap.Handle) error {
 	err := handle.SetDirection(pcap.DirectionIn)
 	if err != nil {
 		return err
 	}
-	err = handle.SetBPFFilter("tcp and tcp[tcpflags] == tcp-syn")
+	err = handle.SetBPFFilter(tcpSYNFilter)
 	if err != nil {
 		return err
 	}
@@ -71,24 +78,24 @@ func (s *TCPSniffer) CreateTCPPacketStream() (chan gopacket.Packet, error) {
 	return packetSource.Packets(), nil
 }
 
+// CreateTCPPacketStreamFromFile reads the TCP SYN packets recorded in a pcap or pcapng file. The channel is closed
+// once all packets are read. Offline captures have no direction, so unlike live captures outgoing SYNs are included.
+func (s *TCPSniffer) CreateTCPPacketStreamFromFile(path string) (chan gopacket.Packet, error) {
+	return createPacketStreamFromFile(path, tcpSYNFilter)
+}
+
 func (s *TCPSniffer) HandlePacket(packet gopacket.Packet) {
 	if !viper.GetBool(sharedconfig.EnableTCPKey) {
 		return
 	}
 	captureTime := detectCaptureTime(packet)
 
-	ipLayer := packet.Layer(layers.LayerTypeIPv4)
-	if ipLayer == nil {
-		return
-	}
-
-	ip, ok := ipLayer.(*layers.IPv4)
-	if !ok {
-		logrus.Debugf("Failed to parse IP layer")
+	srcIPAddr, dstIPAddr, isIP := packetIPs(packet)
+	if !isIP {
 		return
 	}
 
-	srcPort, dstPort, portsFound, err := s.getSrcAndDestPort(packet, ip)
+	srcPort, dstPort, portsFound, err := s.getSrcAndDestPort(packet, dstIPAddr)
 	if err != nil {
 		logrus.Debugf("Failed to parse TCP/UDP port: %s", err)
 		return
@@ -98,9 +105,9 @@ func (s *TCPSniffer) HandlePacket(packet gopacket.Packet) {
 		return
 	}
 
-	logrus.Debugf("TCP SYN: %s to %s:%d", ip.SrcIP, ip.DstIP, dstPort)
-	srcIP := ip.SrcIP.String()
-	dstIP := ip.DstIP.String()
+	logrus.Debugf("TCP SYN: %s to %s:%d", srcIPAddr, dstIPAddr, dstPort)
+	srcIP := srcIPAddr.String()
+	dstIP := dstIPAddr.String()
 	if !s.isRunningOnAWS {
 		s.addCapturedRequest(srcIP, "", dstIP, dstIP, captureTime, nilable.FromPtr[int](nil), &dstPort, &srcPort)
 		return
@@ -121,7 +128,7 @@ func (s *TCPSniffer) HandlePacket(packet gopacket.Packet) {
 	logrus.Debugf("Captured TCP SYN from %s to %s", srcIP, dstIP)
 
 	// Resolver cache could be outdated, verify same resolving result after next poll
-	s.pending = append(s.pending, pendingTCPCapture{
+	s.pending = append(s.pending, pendingConnectionCapture{
 		srcIp:       srcIP,
 		srcHostname: localHostname,
 		destIp:      dstIP,
@@ -131,32 +138,16 @@ func (s *TCPSniffer) HandlePacket(packet gopacket.Packet) {
 	})
 }
 
-func (s *TCPSniffer) getSrcAndDestPort(packet gopacket.Packet, ip *layers.IPv4) (int, int, bool, error) {
-	layerType := ip.NextLayerType()
-	var portName string
-	var destPort int
-	var srcPort int
-	switch layerType {
-	case layers.LayerTypeTCP:
-		tcpLayer := packet.Layer(layers.LayerTypeTCP)
-		if tcpLayer == nil {
-			return 0, 0, false, errors.New("Failed to parse TCP layer")
-		}
-
-		tcp, ok := tcpLayer.(*layers.TCP)
-		if !ok {
-			return 0, 0, false, errors.New("Failed to parse TCP layer")
-		}
-
-		destPort = int(tcp.DstPort)
-		srcPort = int(tcp.SrcPort)
-		portName = tcp.DstPort.String()
-	default:
+func (s *TCPSniffer) getSrcAndDestPort(packet gopacket.Packet, dstIP net.IP) (int, int, bool, error) {
+	// The transport layer is looked up directly rather than by the IP layer's next header, since IPv6 packets may carry
+	// extension headers before it.
+	tcp, ok := packet.TransportLayer().(*layers.TCP)
+	if !ok {
 		return 0, 0, false, errors.New("Unknown transport layer")
 	}
 
-	logrus.Debugf("Detected dest ip and port %s: %s", ip.DstIP.String(), portName)
-	return srcPort, destPort, true, nil
+	logrus.Debugf("Detected dest ip and port %s: %s", dstIP.String(), tcp.DstPort.String())
+	return int(tcp.SrcPort), int(tcp.DstPort), true, nil
 }
 
 func (s *TCPSniffer) RefreshHostsMapping() error {
@@ -180,7 +171,7 @@ func (s *TCPSniffer) RefreshHostsMapping() error {
 		}
 		s.addCapturedRequest(p.srcIp, hostname, p.destIp, p.destIp, p.time, p.ttl, &p.destPort, &p.srcPort)
 	}
-	s.pending = make([]pendingTCPCapture, 0)
+	s.pending = make([]pendingConnectionCapture, 0)
 	return nil
 }
 
@@ -7,6 +7,7 @@ import (
 	"github.com/otterize/nilable"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
+	"net"
 	"testing"
 	"time"
 
@@ -81,3 +82,80 @@ func TestTCPSniffer_TestHandlePacketNonAWS(t *testing.T) {
 		},
 	}, sniffer.CollectResults())
 }
+
+func ipv6TCPSYN(t *testing.T, extensionHeader bool) []byte {
+	ip := &layers.IPv6{Version: 6, HopLimit: 64, NextHeader: layers.IPProtocolTCP, SrcIP: net.ParseIP("fd00:10:244::5"), DstIP: net.ParseIP("2001:db8::1")}
+	tcp := &layers.TCP{SrcPort: 55613, DstPort: 443, SYN: true, Window: 64800}
+	require.NoError(t, tcp.SetNetworkLayerForChecksum(ip))
+	if !extensionHeader {
+		return serializePacket(t, ip, tcp)
+	}
+
+	ip.NextHeader = layers.IPProtocolIPv6HopByHop
+	hopByHop := &layers.IPv6HopByHop{}
+	hopByHop.NextHeader = layers.IPProtocolTCP
+	hopByHop.Options = []*layers.IPv6HopByHopOption{{OptionType: 1, OptionLength: 4, OptionData: []byte{0, 0, 0, 0}}}
+	return serializePacket(t, ip, hopByHop, tcp)
+}
+
+func TestTCPSniffer_TestHandleIPv6Packet(t *testing.T) {
+	for _, extensionHeader := range []bool{false, true} {
+		controller := gomock.NewController(t)
+		mockResolver := ipresolver.NewMockIPResolver(controller)
+
+		sniffer := NewTCPSniffer(mockResolver, false)
+
+		packet := gopacket.NewPacket(ipv6TCPSYN(t, extensionHeader), layers.LayerTypeIPv6, gopacket.Default)
+		timestamp := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+		packet.Metadata().CaptureInfo.Timestamp = timestamp
+		sniffer.HandlePacket(packet)
+		require.NoError(t, sniffer.RefreshHostsMapping())
+
+		require.Equal(t, []mapperclient.RecordedDestinationsForSrc{
+			{
+				SrcIp:       "fd00:10:244::5",
+				SrcHostname: "",
+				Destinations: []mapperclient.Destination{
+					{
+						Destination:     "2001:db8::1",
+						DestinationIP:   nilable.From("2001:db8::1"),
+						DestinationPort: nilable.From(443),
+						SrcPorts:        []int{55613},
+						LastSeen:        timestamp,
+					},
+				},
+			},
+		}, sniffer.CollectResults())
+	}
+}
+
+func TestTCPSniffer_TestHandleIPv6PacketAWS(t *testing.T) {
+	controller := gomock.NewController(t)
+	mockResolver := ipresolver.NewMockIPResolver(controller)
+	mockResolver.EXPECT().ResolveIP("fd00:10:244::5").Return("client-1", true).Times(2) // once for the initial check, and then another for verification
+	mockResolver.EXPECT().Refresh().Return(nil).Times(1)
+
+	sniffer := NewTCPSniffer(mockResolver, true)
+
+	packet := gopacket.NewPacket(ipv6TCPSYN(t, false), layers.LayerTypeIPv6, gopacket.Default)
+	timestamp := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	packet.Metadata().CaptureInfo.Timestamp = timestamp
+	sniffer.HandlePacket(packet)
+	require.NoError(t, sniffer.RefreshHostsMapping())
+
+	require.Equal(t, []mapperclient.RecordedDestinationsForSrc{
+		{
+			SrcIp:       "fd00:10:244::5",
+			SrcHostname: "client-1",
+			Destinations: []mapperclient.Destination{
+				{
+					Destination:     "2001:db8::1",
+					DestinationIP:   nilable.From("2001:db8::1"),
+					DestinationPort: nilable.From(443),
+					SrcPorts:        []int{55613},
+					LastSeen:        timestamp,
+				},
+			},
+		},
+	}, sniffer.CollectResults())
+}
@@ -0,0 +1,277 @@
+package collectors
+
+import (
+	"fmt"
+	"github.com/google/gopacket"
+	"github.com/google/gopacket/layers"
+	"github.com/google/gopacket/pcap"
+	"github.com/otterize/go-procnet/procnet"
+	"github.com/otterize/intents-operator/src/shared/errors"
+	sharedconfig "github.com/otterize/network-mapper/src/shared/config"
+	"github.com/otterize/network-mapper/src/sniffer/pkg/config"
+	"github.com/otterize/network-mapper/src/sniffer/pkg/ipresolver"
+	"github.com/otterize/network-mapper/src/sniffer/pkg/utils"
+	"github.com/otterize/nilable"
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/viper"
+	"net"
+	"time"
+)
+
+// udpFilter matches UDP packets, except for DNS which is handled by the DNS sniffer.
+const udpFilter = "udp and not port 53"
+
+// udpSnapLen is enough to capture the headers up to the UDP header, the payload is not needed.
+const udpSnapLen = 128
+
+// maxUDPFlows bounds the number of tracked flows. Once reached, the first packets of new flows are still reported, but
+// their later packets may be reported again as new flows.
+const maxUDPFlows = 65536
+
+type udpFlow struct {
+	srcIP   string
+	dstIP   string
+	srcPort int
+	dstPort int
+}
+
+func (f udpFlow) reverse() udpFlow {
+	return udpFlow{srcIP: f.dstIP, dstIP: f.srcIP, srcPort: f.dstPort, dstPort: f.srcPort}
+}
+
+type udpEndpoint struct {
+	ip   string
+	port int
+}
+
+// UDPSniffer reports UDP flows by their first packet. UDP has no handshake, so the sender of the first packet seen for
+// a flow is taken to be the client, and the flow is tracked until it is idle for the configured timeout so the rest of
+// its packets - in either direction - are not reported as new flows.
+// Packets are captured before NAT, so a reply to a request sent to a service IP does not match the reverse of the
+// request. Such replies are recognized by being sent from a port a listener is bound to in the sender's network
+// namespace, as found by RefreshListeners.
+type UDPSniffer struct {
+	NetworkCollector
+	resolver        ipresolver.IPResolver
+	pending         []pendingConnectionCapture
+	lastRefresh     time.Time
+	isRunningOnAWS  bool
+	flows           map[udpFlow]time.Time
+	lastFlowsExpiry time.Time
+	listeners       map[udpEndpoint]bool
+}
+
+func NewUDPSniffer(resolver ipresolver.IPResolver, isRunningOnAWS bool) *UDPSniffer {
+	s := UDPSniffer{
+		NetworkCollector: NetworkCollector{},
+		resolver:         resolver,
+		pending:          make([]pendingConnectionCapture, 0),
+		lastRefresh:      time.Now().Add(-viper.GetDuration(config.HostsMappingRefreshIntervalKey)), // Should refresh immediately
+		isRunningOnAWS:   isRunningOnAWS,
+		flows:            make(map[udpFlow]time.Time),
+		listeners:        make(map[udpEndpoint]bool),
+	}
+	s.resetData()
+	return &s
+}
+
+func (s *UDPSniffer) CreateUDPPacketStream() (chan gopacket.Packet, error) {
+	handle, err := pcap.OpenLive("any", udpSnapLen, true, pcap.BlockForever)
+	if err != nil {
+		return nil, errors.Wrap(err)
+	}
+
+	err = handle.SetDirection(pcap.DirectionIn)
+	if err != nil {
+		return nil, errors.Wrap(err)
+	}
+	err = handle.SetBPFFilter(udpFilter)
+	if err != nil {
+		return nil, errors.Wrap(err)
+	}
+
+	packetSource := gopacket.NewPacketSource(handle, handle.LinkType())
+	return packetSource.Packets(), nil
+}
+
+// CreateUDPPacketStreamFromFile reads the UDP packets recorded in a pcap or pcapng file. The channel is closed once all
+// packets are read.
+func (s *UDPSniffer) CreateUDPPacketStreamFromFile(path string) (chan gopacket.Packet, error) {
+	return createPacketStreamFromFile(path, udpFilter)
+}
+
+func (s *UDPSniffer) HandlePacket(packet gopacket.Packet) {
+	if !viper.GetBool(sharedconfig.EnableUDPKey) {
+		return
+	}
+	captureTime := detectCaptureTime(packet)
+
+	srcIPAddr, dstIPAddr, isIP := packetIPs(packet)
+	if !isIP {
+		return
+	}
+	udp, ok := packet.TransportLayer().(*layers.UDP)
+	if !ok {
+		return
+	}
+	if udp.SrcPort == dnsPort || udp.DstPort == dnsPort {
+		return
+	}
+	if srcIPAddr.IsLoopback() || dstIPAddr.IsLoopback() || dstIPAddr.IsMulticast() || dstIPAddr.Equal(net.IPv4bcast) {
+		// Local, multicast and broadcast traffic has no single server to map
+		return
+	}
+
+	s.expireIdleFlows(captureTime)
+	flow := udpFlow{srcIP: srcIPAddr.String(), dstIP: dstIPAddr.String(), srcPort: int(udp.SrcPort), dstPort: int(udp.DstPort)}
+	if s.refreshFlow(flow, captureTime) || s.refreshFlow(flow.reverse(), captureTime) {
+		return
+	}
+	if s.isReply(flow) {
+		return
+	}
+	if len(s.flows) < maxUDPFlows {
+		s.flows[flow] = captureTime
+	}
+
+	logrus.Debugf("UDP flow: %s:%d to %s:%d", flow.srcIP, flow.srcPort, flow.dstIP, flow.dstPort)
+	s.addFlow(flow, captureTime)
+}
+
+// refreshFlow updates the last packet time of a tracked flow, and returns false if the flow is not tracked or was idle
+// for longer than the timeout.
+func (s *UDPSniffer) refreshFlow(flow udpFlow, captureTime time.Time) bool {
+	lastSeen, ok := s.flows[flow]
+	if !ok || captureTime.Sub(lastSeen) >= viper.GetDuration(config.UDPFlowIdleTimeoutKey) {
+		return false
+	}
+	s.flows[flow] = captureTime
+	return true
+}
+
+// isReply returns true if the flow is sent from a bound listener to a port no listener is bound to, which makes it a
+// server's reply. Clients that send from an unconnected socket look like listeners too, so when both ports are bound
+// the first packet seen decides the direction as usual.
+func (s *UDPSniffer) isReply(flow udpFlow) bool {
+	return s.listeners[udpEndpoint{ip: flow.srcIP, port: flow.srcPort}] && !s.listeners[udpEndpoint{ip: flow.dstIP, port: flow.dstPort}]
+}
+
+// RefreshListeners scans the UDP sockets of all network namespaces for bound listeners - unconnected sockets - the same
+// way the socket scanner scans TCP sockets. Listeners bound to a wildcard address are listening on all the IPs of their
+// namespace.
+func (s *UDPSniffer) RefreshListeners() error {
+	listeners := make(map[udpEndpoint]bool)
+	err := utils.ScanProcDirProcesses(func(_ int64, pDir string) {
+		ips, err := utils.ExtractProcessIPAddrs(pDir)
+		if err != nil {
+			return
+		}
+		scanUDPFile(listeners, ips, fmt.Sprintf("%s/net/udp", pDir))
+		scanUDPFile(listeners, ips, fmt.Sprintf("%s/net/udp6", pDir))
+	})
+	if err != nil {
+		return errors.Wrap(err)
+	}
+	s.listeners = listeners
+	return nil
+}
+
+func scanUDPFile(listeners map[udpEndpoint]bool, namespaceIPs []string, path string) {
+	socks, err := procnet.SocksFromPath(path)
+	if err != nil {
+		// it's likely that some files will be deleted during our iteration, so we ignore errors reading the file.
+		return
+	}
+	for _, sock := range socks {
+		if sock.State != procnet.Close || sock.RemoteAddr.Port != 0 {
+			// Connected sockets are clients
+			continue
+		}
+		if sock.LocalAddr.IP.IsLoopback() {
+			continue
+		}
+		port := int(sock.LocalAddr.Port)
+		if !sock.LocalAddr.IP.IsUnspecified() {
+			listeners[udpEndpoint{ip: sock.LocalAddr.IP.String(), port: port}] = true
+			continue
+		}
+		for _, ip := range namespaceIPs {
+			listeners[udpEndpoint{ip: ip, port: port}] = true
+		}
+	}
+}
+
+// expireIdleFlows stops tracking flows idle for longer than the timeout. Timeouts are measured in capture time, so they
+// work the same for live and offline captures.
+func (s *UDPSniffer) expireIdleFlows(captureTime time.Time) {
+	timeout := viper.GetDuration(config.UDPFlowIdleTimeoutKey)
+	if captureTime.Sub(s.lastFlowsExpiry) < timeout {
+		return
+	}
+	for flow, lastSeen := range s.flows {
+		if captureTime.Sub(lastSeen) >= timeout {
+			delete(s.flows, flow)
+		}
+	}
+	s.lastFlowsExpiry = captureTime
+}
+
+func (s *UDPSniffer) addFlow(flow udpFlow, captureTime time.Time) {
+	if !s.isRunningOnAWS {
+		s.addCapturedRequest(flow.srcIP, "", flow.dstIP, flow.dstIP, captureTime, nilable.FromPtr[int](nil), &flow.dstPort, &flow.srcPort)
+		return
+	}
+
+	localHostname, ok := s.resolver.ResolveIP(flow.srcIP)
+	if !ok {
+		// This is still reported because might be ingress traffic, mapper would drop non-ingress captures with no src hostname
+		destNameOrIP := flow.dstIP
+		destHostname, ok := s.resolver.ResolveIP(flow.dstIP)
+		if ok {
+			destNameOrIP = destHostname
+		}
+		s.addCapturedRequest(flow.srcIP, "", destNameOrIP, flow.dstIP, captureTime, nilable.FromPtr[int](nil), &flow.dstPort, &flow.srcPort)
+		return
+	}
+
+	// Resolver cache could be outdated, verify same resolving result after next poll
+	s.pending = append(s.pending, pendingConnectionCapture{
+		srcIp:       flow.srcIP,
+		srcHostname: localHostname,
+		destIp:      flow.dstIP,
+		destPort:    flow.dstPort,
+		time:        captureTime,
+		srcPort:     flow.srcPort,
+	})
+}
+
+func (s *UDPSniffer) RefreshHostsMapping() error {
+	if !s.isRunningOnAWS {
+		return nil
+	}
+	err := s.resolver.Refresh()
+	if err != nil {
+		return errors.Wrap(err)
+	}
+
+	for _, p := range s.pending {
+		hostname, ok := s.resolver.ResolveIP(p.srcIp)
+		if !ok {
+			logrus.Debugf("Could not to resolve %s, skipping UDP flow", p.srcIp)
+			continue
+		}
+		if p.srcHostname != hostname {
+			logrus.Debugf("IP %s was resolved to %s, but now resolves to %s. skipping UDP flow", p.srcIp, p.srcHostname, hostname)
+			continue
+		}
+		s.addCapturedRequest(p.srcIp, hostname, p.destIp, p.destIp, p.time, p.ttl, &p.destPort, &p.srcPort)
+	}
+	s.pending = make([]pendingConnectionCapture, 0)
+	return nil
+}
+
+func (s *UDPSniffer) GetTimeTilNextRefresh() time.Duration {
+	nextRefreshTime := s.lastRefresh.Add(viper.GetDuration(config.HostsMappingRefreshIntervalKey))
+	s.lastRefresh = time.Now()
+	return time.Until(nextRefreshTime)
+}
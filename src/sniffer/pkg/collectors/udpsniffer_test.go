@@ -0,0 +1,156 @@
+package collectors
+
+import (
+	"github.com/google/gopacket"
+	"github.com/google/gopacket/layers"
+	"github.com/otterize/network-mapper/src/mapperclient"
+	sharedconfig "github.com/otterize/network-mapper/src/shared/config"
+	"github.com/otterize/network-mapper/src/sniffer/pkg/config"
+	"github.com/otterize/network-mapper/src/sniffer/pkg/ipresolver"
+	"github.com/otterize/nilable"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+	"net"
+	"os"
+	"testing"
+	"time"
+)
+
+type UDPSnifferTestSuite struct {
+	suite.Suite
+	sniffer   *UDPSniffer
+	timestamp time.Time
+}
+
+func (s *UDPSnifferTestSuite) SetupTest() {
+	viper.Set(sharedconfig.EnableUDPKey, true)
+	s.sniffer = NewUDPSniffer(ipresolver.NewMockIPResolver(gomock.NewController(s.T())), false)
+	s.timestamp = time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+}
+
+func (s *UDPSnifferTestSuite) TearDownTest() {
+	viper.Set(sharedconfig.EnableUDPKey, sharedconfig.EnableUDPSnifferDefault)
+}
+
+func (s *UDPSnifferTestSuite) handleUDPPacket(srcIP string, srcPort int, dstIP string, dstPort int, captureTime time.Time) {
+	var ip gopacket.NetworkLayer
+	var ipLayer gopacket.SerializableLayer
+	var firstLayer gopacket.LayerType
+	if net.ParseIP(srcIP).To4() != nil {
+		ipv4 := &layers.IPv4{Version: 4, TTL: 64, Protocol: layers.IPProtocolUDP, SrcIP: net.ParseIP(srcIP), DstIP: net.ParseIP(dstIP)}
+		ip, ipLayer, firstLayer = ipv4, ipv4, layers.LayerTypeIPv4
+	} else {
+		ipv6 := &layers.IPv6{Version: 6, HopLimit: 64, NextHeader: layers.IPProtocolUDP, SrcIP: net.ParseIP(srcIP), DstIP: net.ParseIP(dstIP)}
+		ip, ipLayer, firstLayer = ipv6, ipv6, layers.LayerTypeIPv6
+	}
+	udp := &layers.UDP{SrcPort: layers.UDPPort(srcPort), DstPort: layers.UDPPort(dstPort)}
+	s.Require().NoError(udp.SetNetworkLayerForChecksum(ip))
+	buf := gopacket.NewSerializeBuffer()
+	s.Require().NoError(gopacket.SerializeLayers(buf, gopacket.SerializeOptions{FixLengths: true, ComputeChecksums: true}, ipLayer, udp, gopacket.Payload("metric:1|c")))
+
+	packet := gopacket.NewPacket(buf.Bytes(), firstLayer, gopacket.Default)
+	packet.Metadata().CaptureInfo.Timestamp = captureTime
+	s.sniffer.HandlePacket(packet)
+}
+
+func (s *UDPSnifferTestSuite) TestFirstPacketOfFlowIsReported() {
+	s.handleUDPPacket("10.0.0.5", 40000, "10.0.0.6", 8125, s.timestamp)
+	// Later packets of the flow, and replies, are part of the same flow
+	s.handleUDPPacket("10.0.0.5", 40000, "10.0.0.6", 8125, s.timestamp.Add(time.Second))
+	s.handleUDPPacket("10.0.0.6", 8125, "10.0.0.5", 40000, s.timestamp.Add(2*time.Second))
+
+	s.Require().Equal([]mapperclient.RecordedDestinationsForSrc{
+		{
+			SrcIp: "10.0.0.5",
+			Destinations: []mapperclient.Destination{
+				{
+					Destination:     "10.0.0.6",
+					DestinationIP:   nilable.From("10.0.0.6"),
+					DestinationPort: nilable.From(8125),
+					SrcPorts:        []int{40000},
+					LastSeen:        s.timestamp,
+				},
+			},
+		},
+	}, s.sniffer.CollectResults())
+}
+
+func (s *UDPSnifferTestSuite) TestFlowIsReportedAgainAfterIdleTimeout() {
+	s.handleUDPPacket("fd00::5", 40000, "fd00::6", 514, s.timestamp)
+	s.Require().Len(s.sniffer.CollectResults(), 1)
+
+	s.handleUDPPacket("fd00::5", 40000, "fd00::6", 514, s.timestamp.Add(time.Second))
+	s.Require().Empty(s.sniffer.CollectResults())
+
+	idleTime := s.timestamp.Add(time.Second).Add(viper.GetDuration(config.UDPFlowIdleTimeoutKey))
+	s.handleUDPPacket("fd00::5", 40000, "fd00::6", 514, idleTime)
+	results := s.sniffer.CollectResults()
+	s.Require().Len(results, 1)
+	s.Require().Equal(idleTime, results[0].Destinations[0].LastSeen)
+	s.Require().Len(s.sniffer.flows, 1)
+}
+
+func (s *UDPSnifferTestSuite) TestReplyToServiceIPIsNotReportedAsFlow() {
+	mockProcDir := s.T().TempDir()
+	// The server listens on 0.0.0.0:8125, the client sends from a socket connected to the service IP 10.96.0.20:8125
+	s.Require().NoError(os.MkdirAll(mockProcDir+"/100/net", 0o700))
+	s.Require().NoError(os.WriteFile(mockProcDir+"/100/net/fib_trie", []byte("  |-- 10.0.0.6\n     /32 host LOCAL\n"), 0o444))
+	s.Require().NoError(os.WriteFile(mockProcDir+"/100/net/udp", []byte(mockServerUDPFileContent), 0o444))
+	s.Require().NoError(os.MkdirAll(mockProcDir+"/200/net", 0o700))
+	s.Require().NoError(os.WriteFile(mockProcDir+"/200/net/fib_trie", []byte("  |-- 10.0.0.5\n     /32 host LOCAL\n"), 0o444))
+	s.Require().NoError(os.WriteFile(mockProcDir+"/200/net/udp", []byte(mockClientUDPFileContent), 0o444))
+	viper.Set(config.HostProcDirKey, mockProcDir)
+	defer viper.Set(config.HostProcDirKey, config.HostProcDirDefault)
+	s.Require().NoError(s.sniffer.RefreshListeners())
+
+	// The request is captured before DNAT and the reply before reverse NAT, so the reply is sent from the pod IP
+	s.handleUDPPacket("10.0.0.5", 40000, "10.96.0.20", 8125, s.timestamp)
+	s.handleUDPPacket("10.0.0.6", 8125, "10.0.0.5", 40000, s.timestamp.Add(time.Second))
+
+	s.Require().Equal([]mapperclient.RecordedDestinationsForSrc{
+		{
+			SrcIp: "10.0.0.5",
+			Destinations: []mapperclient.Destination{
+				{
+					Destination:     "10.96.0.20",
+					DestinationIP:   nilable.From("10.96.0.20"),
+					DestinationPort: nilable.From(8125),
+					SrcPorts:        []int{40000},
+					LastSeen:        s.timestamp,
+				},
+			},
+		},
+	}, s.sniffer.CollectResults())
+}
+
+func (s *UDPSnifferTestSuite) TestIgnoredPackets() {
+	// DNS is handled by the DNS sniffer
+	s.handleUDPPacket("10.0.0.5", 40000, "10.96.0.10", 53, s.timestamp)
+	s.handleUDPPacket("10.96.0.10", 53, "10.0.0.5", 40000, s.timestamp)
+	// Local, multicast and broadcast traffic
+	s.handleUDPPacket("127.0.0.1", 40000, "127.0.0.1", 8125, s.timestamp)
+	s.handleUDPPacket("10.0.0.5", 5353, "224.0.0.251", 5353, s.timestamp)
+	s.handleUDPPacket("0.0.0.0", 68, "255.255.255.255", 67, s.timestamp)
+
+	s.Require().Empty(s.sniffer.CollectResults())
+	s.Require().Empty(s.sniffer.flows)
+}
+
+func (s *UDPSnifferTestSuite) TestDisabled() {
+	viper.Set(sharedconfig.EnableUDPKey, false)
+	s.handleUDPPacket("10.0.0.5", 40000, "10.0.0.6", 8125, s.timestamp)
+	s.Require().Empty(s.sniffer.CollectResults())
+}
+
+func TestUDPSnifferTestSuite(t *testing.T) {
+	suite.Run(t, new(UDPSnifferTestSuite))
+}
+
+// 0.0.0.0:8125 (0x1FBD), unconnected
+const mockServerUDPFileContent = `   sl  local_address rem_address   st tx_queue rx_queue tr tm->when retrnsmt   uid  timeout inode ref pointer drops
+  100: 00000000:1FBD 00000000:0000 07 00000000:00000000 00:00000000 00000000     0        0 4144 2 0000000000000000 0`
+
+// 10.0.0.5:40000 (0x9C40) connected to 10.96.0.20:8125
+const mockClientUDPFileContent = `   sl  local_address rem_address   st tx_queue rx_queue tr tm->when retrnsmt   uid  timeout inode ref pointer drops
+  200: 0500000A:9C40 1400600A:1FBD 01 00000000:00000000 00:00000000 00000000     0        0 4145 2 0000000000000000 0`
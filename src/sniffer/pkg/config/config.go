@@ -20,6 +20,29 @@ const (
 	UseExtendedProcfsResolutionDefault = false
 	DomainDebugFilterKey               = "domain-debug-filter"
 	DomainDebugFilterDefault           = ""
+	DNSTCPStreamTimeoutKey             = "dns-tcp-stream-timeout"
+	DNSTCPStreamTimeoutDefault         = 30 * time.Second
+	PcapFileKey                        = "pcap-file"
+	PcapFileDefault                    = ""
+	PcapReplayOutputKey                = "pcap-replay-output"
+	PcapReplayOutputDefault            = PcapReplayOutputMapper
+	EnableEBPFKey                      = "enable-ebpf"
+	EnableEBPFDefault                  = false
+	HostCgroupDirKey                   = "host-cgroup-dir"
+	HostCgroupDirDefault               = "/sys/fs/cgroup"
+	UDPFlowIdleTimeoutKey              = "udp-flow-idle-timeout"
+	UDPFlowIdleTimeoutDefault          = 60 * time.Second
+	ReportSpoolMaxEntriesKey           = "report-spool-max-entries"
+	ReportSpoolMaxEntriesDefault       = 10000
+	ReportRetryInitialBackoffKey       = "report-retry-initial-backoff"
+	ReportRetryInitialBackoffDefault   = 1 * time.Second
+	ReportRetryMaxBackoffKey           = "report-retry-max-backoff"
+	ReportRetryMaxBackoffDefault       = 1 * time.Minute
+)
+
+const (
+	PcapReplayOutputMapper = "mapper"
+	PcapReplayOutputJSON   = "json"
 )
 
 func init() {
@@ -30,4 +53,13 @@ func init() {
 	viper.SetDefault(HostsMappingRefreshIntervalKey, HostsMappingRefreshIntervalDefault)
 	viper.SetDefault(UseExtendedProcfsResolutionKey, UseExtendedProcfsResolutionDefault)
 	viper.SetDefault(DomainDebugFilterKey, DomainDebugFilterDefault)
+	viper.SetDefault(DNSTCPStreamTimeoutKey, DNSTCPStreamTimeoutDefault)
+	viper.SetDefault(PcapFileKey, PcapFileDefault)
+	viper.SetDefault(PcapReplayOutputKey, PcapReplayOutputDefault)
+	viper.SetDefault(EnableEBPFKey, EnableEBPFDefault)
+	viper.SetDefault(HostCgroupDirKey, HostCgroupDirDefault)
+	viper.SetDefault(UDPFlowIdleTimeoutKey, UDPFlowIdleTimeoutDefault)
+	viper.SetDefault(ReportSpoolMaxEntriesKey, ReportSpoolMaxEntriesDefault)
+	viper.SetDefault(ReportRetryInitialBackoffKey, ReportRetryInitialBackoffDefault)
+	viper.SetDefault(ReportRetryMaxBackoffKey, ReportRetryMaxBackoffDefault)
 }
@@ -0,0 +1,94 @@
+package ipresolver
+
+import (
+	"github.com/otterize/intents-operator/src/shared/errors"
+	"github.com/otterize/network-mapper/src/sniffer/pkg/utils"
+	"github.com/sirupsen/logrus"
+)
+
+type CgroupResolver interface {
+	Refresh() error
+	ResolveCgroup(cgroupID uint64) (hostname string, ok bool)
+}
+
+type ProcFSCgroupResolverEntry struct {
+	CgroupID        uint64
+	Hostname        string
+	ProcessRefCount int
+}
+
+// ProcFSCgroupResolver maps cgroup IDs to the hostname of the pod the cgroup belongs to, by scanning the processes
+// in procfs.
+type ProcFSCgroupResolver struct {
+	byCgroup map[uint64]*ProcFSCgroupResolverEntry
+	byPid    map[int64]*ProcFSCgroupResolverEntry
+	monitor  *ProcessMonitor
+}
+
+func NewProcFSCgroupResolver() *ProcFSCgroupResolver {
+	r := ProcFSCgroupResolver{
+		byCgroup: make(map[uint64]*ProcFSCgroupResolverEntry),
+		byPid:    make(map[int64]*ProcFSCgroupResolverEntry),
+	}
+	r.monitor = NewProcessMonitor(r.onProcessNew, r.onProcessExit, utils.ScanProcDirProcesses)
+
+	return &r
+}
+
+func (r *ProcFSCgroupResolver) ResolveCgroup(cgroupID uint64) (hostname string, ok bool) {
+	if entry, ok := r.byCgroup[cgroupID]; ok {
+		return entry.Hostname, true
+	}
+	return "", false
+}
+
+func (r *ProcFSCgroupResolver) Refresh() error {
+	return r.monitor.Poll()
+}
+
+func (r *ProcFSCgroupResolver) onProcessNew(pid int64, pDir string) error {
+	hostname, err := utils.ExtractProcessHostname(pDir)
+	if err != nil {
+		logrus.Debugf("Failed to extract hostname for process %d: %v", pid, err)
+		return errors.Wrap(err)
+	}
+
+	cgroupID, err := utils.ExtractProcessCgroupID(pDir)
+	if err != nil {
+		logrus.Debugf("Failed to extract cgroup for process %d: %v", pid, err)
+		return errors.Wrap(err)
+	}
+
+	if entry, ok := r.byCgroup[cgroupID]; ok && entry.Hostname == hostname {
+		r.byPid[pid] = entry
+		entry.ProcessRefCount++
+		return nil
+	}
+
+	logrus.Debugf("Found new cgroup mapping %d:%s", cgroupID, hostname)
+	entry := &ProcFSCgroupResolverEntry{
+		CgroupID:        cgroupID,
+		Hostname:        hostname,
+		ProcessRefCount: 1,
+	}
+	r.byPid[pid] = entry
+	r.byCgroup[cgroupID] = entry
+	return nil
+}
+
+func (r *ProcFSCgroupResolver) onProcessExit(pid int64, _ string) error {
+	entry, ok := r.byPid[pid]
+	if !ok {
+		logrus.Debugf("Unknown process %d exited", pid)
+		return nil
+	}
+
+	entry.ProcessRefCount--
+	// Only remove the mapping if a newer one did not replace it
+	if entry.ProcessRefCount == 0 && r.byCgroup[entry.CgroupID] == entry {
+		logrus.Debugf("Removing cgroup mapping %d:%s", entry.CgroupID, entry.Hostname)
+		delete(r.byCgroup, entry.CgroupID)
+	}
+	delete(r.byPid, pid)
+	return nil
+}
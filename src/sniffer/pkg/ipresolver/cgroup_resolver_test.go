@@ -0,0 +1,97 @@
+package ipresolver
+
+import (
+	"fmt"
+	"github.com/otterize/network-mapper/src/sniffer/pkg/config"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/suite"
+	"os"
+	"path/filepath"
+	"syscall"
+	"testing"
+)
+
+type ProcFSCgroupResolverTestSuite struct {
+	suite.Suite
+	resolver          *ProcFSCgroupResolver
+	mockRootProcDir   string
+	mockRootCgroupDir string
+}
+
+func (s *ProcFSCgroupResolverTestSuite) SetupTest() {
+	s.mockRootProcDir = s.T().TempDir()
+	s.mockRootCgroupDir = s.T().TempDir()
+	viper.Set(config.HostProcDirKey, s.mockRootProcDir)
+	viper.Set(config.HostCgroupDirKey, s.mockRootCgroupDir)
+
+	s.resolver = NewProcFSCgroupResolver()
+}
+
+func (s *ProcFSCgroupResolverTestSuite) TearDownTest() {
+	viper.Set(config.HostProcDirKey, config.HostProcDirDefault)
+	viper.Set(config.HostCgroupDirKey, config.HostCgroupDirDefault)
+}
+
+// mockCreateCgroup creates a cgroup directory and returns its ID.
+func (s *ProcFSCgroupResolverTestSuite) mockCreateCgroup(cgroupPath string) uint64 {
+	dir := filepath.Join(s.mockRootCgroupDir, cgroupPath)
+	s.Require().NoError(os.MkdirAll(dir, 0o777))
+	info, err := os.Stat(dir)
+	s.Require().NoError(err)
+	return info.Sys().(*syscall.Stat_t).Ino
+}
+
+func (s *ProcFSCgroupResolverTestSuite) mockCreateProcess(pid int64, cgroupPath string, hostname string) {
+	mockProcDir := fmt.Sprintf("%s/%d", s.mockRootProcDir, pid)
+	s.Require().NoError(os.MkdirAll(mockProcDir, 0o777))
+	s.Require().NoError(os.WriteFile(mockProcDir+"/environ", []byte(fmt.Sprintf(mockEnvironFileContent, hostname)), 0o444))
+	s.Require().NoError(os.WriteFile(mockProcDir+"/cgroup", []byte(fmt.Sprintf("12:memory:/ignored\n0::%s\n", cgroupPath)), 0o444))
+}
+
+func (s *ProcFSCgroupResolverTestSuite) mockKillProcess(pid int64) {
+	s.Require().NoError(os.RemoveAll(fmt.Sprintf("%s/%d", s.mockRootProcDir, pid)))
+}
+
+func (s *ProcFSCgroupResolverTestSuite) TestResolverRefCount() {
+	cgroupID := s.mockCreateCgroup("/kubepods/pod-1/container-1")
+	s.mockCreateProcess(10, "/kubepods/pod-1/container-1", "service-1")
+	s.mockCreateProcess(11, "/kubepods/pod-1/container-1", "service-1")
+	s.Require().NoError(s.resolver.Refresh())
+
+	hostname, ok := s.resolver.ResolveCgroup(cgroupID)
+	s.Require().True(ok)
+	s.Require().Equal("service-1", hostname)
+
+	s.mockKillProcess(10)
+	s.Require().NoError(s.resolver.Refresh())
+	hostname, ok = s.resolver.ResolveCgroup(cgroupID)
+	s.Require().True(ok)
+	s.Require().Equal("service-1", hostname)
+
+	s.mockKillProcess(11)
+	s.Require().NoError(s.resolver.Refresh())
+	_, ok = s.resolver.ResolveCgroup(cgroupID)
+	s.Require().False(ok)
+}
+
+func (s *ProcFSCgroupResolverTestSuite) TestResolverMultipleCgroups() {
+	firstCgroupID := s.mockCreateCgroup("/kubepods/pod-1/container-1")
+	secondCgroupID := s.mockCreateCgroup("/kubepods/pod-2/container-1")
+	s.mockCreateProcess(20, "/kubepods/pod-1/container-1", "service-1")
+	s.mockCreateProcess(21, "/kubepods/pod-2/container-1", "service-2")
+	// A process whose cgroup is gone is skipped
+	s.mockCreateProcess(22, "/kubepods/pod-3/container-1", "service-3")
+	s.Require().NoError(s.resolver.Refresh())
+
+	hostname, ok := s.resolver.ResolveCgroup(firstCgroupID)
+	s.Require().True(ok)
+	s.Require().Equal("service-1", hostname)
+	hostname, ok = s.resolver.ResolveCgroup(secondCgroupID)
+	s.Require().True(ok)
+	s.Require().Equal("service-2", hostname)
+	s.Require().Len(s.resolver.byCgroup, 2)
+}
+
+func TestProcFSCgroupResolverTestSuite(t *testing.T) {
+	suite.Run(t, new(ProcFSCgroupResolverTestSuite))
+}
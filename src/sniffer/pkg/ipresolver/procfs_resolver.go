@@ -7,7 +7,8 @@ import (
 )
 
 type ProcFSIPResolverEntry struct {
-	IPAddr          string
+	// IPAddrs holds the pod's IPv4 address and, on dual-stack clusters, its IPv6 addresses.
+	IPAddrs         []string
 	Hostname        string
 	ProcessRefCount int
 }
@@ -41,19 +42,22 @@ func (r *ProcFSIPResolver) Refresh() error {
 }
 
 func (r *ProcFSIPResolver) onProcessNew(pid int64, pDir string) (err error) {
-	var hostname, ipaddr string
+	var hostname string
+	var ipaddrs []string
 	hostname, err = utils.ExtractProcessHostname(pDir)
 	if err != nil {
 		logrus.Debugf("Failed to extract hostname for process %d: %v", pid, err)
 		return errors.Wrap(err)
 	}
 
-	ipaddr, err = utils.ExtractProcessIPAddr(pDir)
+	ipaddrs, err = utils.ExtractProcessIPAddrs(pDir)
 	if err != nil {
 		logrus.Debugf("Failed to extract IP address for process %d: %v", pid, err)
 		return errors.Wrap(err)
 	}
 
+	// All the addresses belong to the same network namespace, so the first one identifies an existing mapping
+	ipaddr := ipaddrs[0]
 	if entry, ok := r.byAddr[ipaddr]; ok {
 		if entry.Hostname == hostname {
 			// Already mapped to this hostname, add another process reference
@@ -68,14 +72,16 @@ func (r *ProcFSIPResolver) onProcessNew(pid int64, pDir string) (err error) {
 		}
 	}
 
-	logrus.Debugf("Found new mapping %s:%s", ipaddr, hostname)
+	logrus.Debugf("Found new mapping %s:%s", ipaddrs, hostname)
 	newEntry := &ProcFSIPResolverEntry{
-		IPAddr:          ipaddr,
+		IPAddrs:         ipaddrs,
 		Hostname:        hostname,
 		ProcessRefCount: 1,
 	}
 	r.byPid[pid] = newEntry
-	r.byAddr[ipaddr] = newEntry
+	for _, addr := range ipaddrs {
+		r.byAddr[addr] = newEntry
+	}
 	return nil
 }
 
@@ -88,9 +94,11 @@ func (r *ProcFSIPResolver) onProcessExit(pid int64, _ string) error {
 		entry.ProcessRefCount--
 		if entry.ProcessRefCount == 0 {
 			// Should remove mapping, but validate this process actually holds the newest mapping
-			if r.byAddr[entry.IPAddr] == entry {
-				logrus.Debugf("Removing IP mapping %s:%s", entry.IPAddr, entry.Hostname)
-				delete(r.byAddr, entry.IPAddr)
+			for _, addr := range entry.IPAddrs {
+				if r.byAddr[addr] == entry {
+					logrus.Debugf("Removing IP mapping %s:%s", addr, entry.Hostname)
+					delete(r.byAddr, addr)
+				}
 			}
 		}
 
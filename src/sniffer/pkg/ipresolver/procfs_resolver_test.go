@@ -93,6 +93,18 @@ func (s *ProcFSIPResolverTestSuite) mockCreateProcess(pid int64, ipaddr, hostnam
 	s.Require().NoError(os.WriteFile(mockProcDir+"/net/fib_trie", []byte(mockFibTrieFile), 0o444))
 }
 
+// mockIfInet6FileContent lists the loopback, a global and a link-local address, in /proc/net/if_inet6 format.
+const mockIfInet6FileContent = `00000000000000000000000000000001 01 80 10 80       lo
+%s 02 40 00 80     eth0
+fe80000000000000a8c1abfffe000001 02 40 20 80     eth0
+`
+
+func (s *ProcFSIPResolverTestSuite) mockCreateDualStackProcess(pid int64, ipaddr, ipv6AddrHex, hostname string) {
+	s.mockCreateProcess(pid, ipaddr, hostname)
+	mockIfInet6File := fmt.Sprintf(mockIfInet6FileContent, ipv6AddrHex)
+	s.Require().NoError(os.WriteFile(s.getMockProcDir(pid)+"/net/if_inet6", []byte(mockIfInet6File), 0o444))
+}
+
 func (s *ProcFSIPResolverTestSuite) mockKillProcess(pid int64) {
 	s.Require().NoError(os.RemoveAll(s.getMockProcDir(pid)))
 }
@@ -176,6 +188,27 @@ func (s *ProcFSIPResolverTestSuite) TestResolverCollision() {
 	s.Require().Equal(hostname, "")
 }
 
+func (s *ProcFSIPResolverTestSuite) TestResolverDualStack() {
+	s.mockCreateDualStackProcess(40, "172.17.0.4", "fd000010024400000000000000000004", "service-4")
+	_ = s.resolver.Refresh()
+
+	for _, ip := range []string{"172.17.0.4", "fd00:10:244::4"} {
+		hostname, ok := s.resolver.ResolveIP(ip)
+		s.Require().True(ok)
+		s.Require().Equal("service-4", hostname)
+	}
+	_, ok := s.resolver.ResolveIP("fe80::a8c1:abff:fe00:1")
+	s.Require().False(ok)
+
+	s.mockKillProcess(40)
+	_ = s.resolver.Refresh()
+
+	for _, ip := range []string{"172.17.0.4", "fd00:10:244::4"} {
+		_, ok := s.resolver.ResolveIP(ip)
+		s.Require().False(ok)
+	}
+}
+
 func TestProcFSIPResolverTestSuite(t *testing.T) {
 	suite.Run(t, new(ProcFSIPResolverTestSuite))
 }
@@ -14,6 +14,14 @@ var (
 		Name: "dns_reported_connections",
 		Help: "The total number of DNS-based reported connections",
 	})
+	reportSpoolDepth = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "report_spool_depth",
+		Help: "The number of connections waiting to be reported to the mapper, by type of results",
+	}, []string{"results"})
+	reportSpoolDrops = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "report_spool_dropped_connections",
+		Help: "The total number of connections dropped because the report spool was full, by type of results",
+	}, []string{"results"})
 )
 
 func IncrementSocketScanReports(count int) {
@@ -23,3 +31,11 @@ func IncrementSocketScanReports(count int) {
 func IncrementDNSCaptureReports(count int) {
 	dnsCaptureReports.Add(float64(count))
 }
+
+func SetReportSpoolDepth(results string, depth int) {
+	reportSpoolDepth.WithLabelValues(results).Set(float64(depth))
+}
+
+func IncrementReportSpoolDrops(results string, count int) {
+	reportSpoolDrops.WithLabelValues(results).Add(float64(count))
+}
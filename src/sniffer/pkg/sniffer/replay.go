@@ -0,0 +1,142 @@
+package sniffer
+
+import (
+	"cmp"
+	"context"
+	"github.com/otterize/intents-operator/src/shared/errors"
+	"github.com/otterize/network-mapper/src/mapperclient"
+	"github.com/otterize/network-mapper/src/sniffer/pkg/collectors"
+	"github.com/sirupsen/logrus"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+)
+
+var pcapFileExtensions = []string{".pcap", ".pcapng", ".cap"}
+
+// ReplayResults are the captures found in recorded pcap files, ordered by source and destination so replaying the same
+// files always gives the same results.
+type ReplayResults struct {
+	DNSResults []mapperclient.RecordedDestinationsForSrc `json:"dnsResults"`
+	TCPResults []mapperclient.RecordedDestinationsForSrc `json:"tcpResults"`
+	UDPResults []mapperclient.RecordedDestinationsForSrc `json:"udpResults"`
+}
+
+// Replay feeds the packets recorded in a pcap or pcapng file, or in all such files in a directory, through the DNS, TCP
+// and UDP sniffers, as if they were captured live. Files in a directory are replayed in name order.
+func Replay(path string) (ReplayResults, error) {
+	files, err := listPcapFiles(path)
+	if err != nil {
+		return ReplayResults{}, errors.Wrap(err)
+	}
+
+	// Captures are usually replayed away from the node they were recorded on, so pod IPs can't be resolved through
+	// procfs. They are reported as-is for the mapper to resolve, as when not running on AWS.
+	dnsSniffer := collectors.NewDNSSniffer(nil, false)
+	tcpSniffer := collectors.NewTCPSniffer(nil, false)
+	udpSniffer := collectors.NewUDPSniffer(nil, false)
+	for _, file := range files {
+		logrus.WithField("file", file).Info("Replaying pcap file")
+		dnsPackets, err := dnsSniffer.CreateDNSPacketStreamFromFile(file)
+		if err != nil {
+			return ReplayResults{}, errors.Errorf("failed to read DNS packets from '%s': %w", file, err)
+		}
+		for packet := range dnsPackets {
+			dnsSniffer.HandlePacket(packet)
+		}
+		// Streams don't carry over between files, even if they were recorded one after the other
+		dnsSniffer.FlushTCPStreams()
+
+		tcpPackets, err := tcpSniffer.CreateTCPPacketStreamFromFile(file)
+		if err != nil {
+			return ReplayResults{}, errors.Errorf("failed to read TCP packets from '%s': %w", file, err)
+		}
+		for packet := range tcpPackets {
+			tcpSniffer.HandlePacket(packet)
+		}
+
+		udpPackets, err := udpSniffer.CreateUDPPacketStreamFromFile(file)
+		if err != nil {
+			return ReplayResults{}, errors.Errorf("failed to read UDP packets from '%s': %w", file, err)
+		}
+		for packet := range udpPackets {
+			udpSniffer.HandlePacket(packet)
+		}
+	}
+
+	return ReplayResults{
+		DNSResults: sortResults(dnsSniffer.CollectResults()),
+		TCPResults: sortResults(tcpSniffer.CollectResults()),
+		UDPResults: sortResults(udpSniffer.CollectResults()),
+	}, nil
+}
+
+// ReportReplayResults reports the results of a replay to the mapper, the same way live captures are reported.
+func ReportReplayResults(ctx context.Context, mapperClient *mapperclient.Client, results ReplayResults) error {
+	if len(results.DNSResults) != 0 {
+		err := mapperClient.ReportCaptureResults(ctx, mapperclient.CaptureResults{Results: results.DNSResults})
+		if err != nil {
+			return errors.Wrap(err)
+		}
+	}
+	if len(results.TCPResults) != 0 {
+		err := mapperClient.ReportTCPCaptureResults(ctx, mapperclient.CaptureTCPResults{Results: results.TCPResults})
+		if err != nil {
+			return errors.Wrap(err)
+		}
+	}
+	if len(results.UDPResults) != 0 {
+		err := mapperClient.ReportUDPCaptureResults(ctx, mapperclient.CaptureUDPResults{Results: results.UDPResults})
+		if err != nil {
+			return errors.Wrap(err)
+		}
+	}
+	logrus.Infof("Reported replayed captures of %d DNS clients, %d TCP clients and %d UDP clients to Mapper", len(results.DNSResults), len(results.TCPResults), len(results.UDPResults))
+	return nil
+}
+
+func listPcapFiles(path string) ([]string, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, errors.Wrap(err)
+	}
+	if !info.IsDir() {
+		return []string{path}, nil
+	}
+
+	entries, err := os.ReadDir(path)
+	if err != nil {
+		return nil, errors.Wrap(err)
+	}
+	files := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		if entry.IsDir() || !slices.Contains(pcapFileExtensions, strings.ToLower(filepath.Ext(entry.Name()))) {
+			continue
+		}
+		files = append(files, filepath.Join(path, entry.Name()))
+	}
+	if len(files) == 0 {
+		return nil, errors.Errorf("no pcap files found in directory '%s'", path)
+	}
+	return files, nil
+}
+
+func sortResults(results []mapperclient.RecordedDestinationsForSrc) []mapperclient.RecordedDestinationsForSrc {
+	for _, result := range results {
+		for _, destination := range result.Destinations {
+			slices.Sort(destination.SrcPorts)
+		}
+		slices.SortFunc(result.Destinations, func(a, b mapperclient.Destination) int {
+			return cmp.Or(
+				cmp.Compare(a.Destination, b.Destination),
+				cmp.Compare(a.DestinationIP.Item, b.DestinationIP.Item),
+				cmp.Compare(a.DestinationPort.Item, b.DestinationPort.Item),
+			)
+		})
+	}
+	slices.SortFunc(results, func(a, b mapperclient.RecordedDestinationsForSrc) int {
+		return cmp.Or(cmp.Compare(a.SrcIp, b.SrcIp), cmp.Compare(a.SrcHostname, b.SrcHostname))
+	})
+	return results
+}
@@ -0,0 +1,191 @@
+package sniffer
+
+import (
+	"github.com/google/gopacket"
+	"github.com/google/gopacket/layers"
+	"github.com/google/gopacket/pcapgo"
+	"github.com/otterize/network-mapper/src/mapperclient"
+	sharedconfig "github.com/otterize/network-mapper/src/shared/config"
+	"github.com/otterize/nilable"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/suite"
+	"net"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+	"time"
+)
+
+var (
+	ethernet = &layers.Ethernet{SrcMAC: net.HardwareAddr{0, 0, 0, 0, 0, 1}, DstMAC: net.HardwareAddr{0, 0, 0, 0, 0, 2}, EthernetType: layers.EthernetTypeIPv4}
+	podIP    = net.IP{10, 0, 0, 5}
+	remoteIP = net.IP{93, 184, 216, 34}
+)
+
+type ReplayTestSuite struct {
+	suite.Suite
+	dir          string
+	dnsTimestamp time.Time
+	tcpTimestamp time.Time
+	udpTimestamp time.Time
+}
+
+func (s *ReplayTestSuite) SetupTest() {
+	s.dir = s.T().TempDir()
+	s.dnsTimestamp = time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	s.tcpTimestamp = time.Date(2021, 1, 1, 0, 0, 1, 0, time.UTC)
+	s.udpTimestamp = time.Date(2021, 1, 1, 0, 0, 2, 0, time.UTC)
+}
+
+// replay replays the path, with timestamps in UTC - pcap readers differ in the time zone they read timestamps in.
+func (s *ReplayTestSuite) replay(path string) ReplayResults {
+	results, err := Replay(path)
+	s.Require().NoError(err)
+	for _, result := range slices.Concat(results.DNSResults, results.TCPResults, results.UDPResults) {
+		for i := range result.Destinations {
+			result.Destinations[i].LastSeen = result.Destinations[i].LastSeen.UTC()
+		}
+	}
+	return results
+}
+
+func (s *ReplayTestSuite) serialize(serializableLayers ...gopacket.SerializableLayer) []byte {
+	buf := gopacket.NewSerializeBuffer()
+	s.Require().NoError(gopacket.SerializeLayers(buf, gopacket.SerializeOptions{FixLengths: true, ComputeChecksums: true}, serializableLayers...))
+	return buf.Bytes()
+}
+
+func (s *ReplayTestSuite) dnsResponse() []byte {
+	ip := &layers.IPv4{Version: 4, TTL: 64, Protocol: layers.IPProtocolUDP, SrcIP: net.IP{10, 96, 0, 10}, DstIP: podIP}
+	udp := &layers.UDP{SrcPort: 53, DstPort: 40000}
+	s.Require().NoError(udp.SetNetworkLayerForChecksum(ip))
+	return s.serialize(ethernet, ip, udp, &layers.DNS{
+		ID: 1, QR: true, OpCode: layers.DNSOpCodeQuery, ResponseCode: layers.DNSResponseCodeNoErr,
+		Questions: []layers.DNSQuestion{{Name: []byte("api.example.com"), Type: layers.DNSTypeA, Class: layers.DNSClassIN}},
+		Answers: []layers.DNSResourceRecord{
+			{Name: []byte("api.example.com"), Type: layers.DNSTypeA, Class: layers.DNSClassIN, TTL: 30, IP: remoteIP},
+		},
+	})
+}
+
+func (s *ReplayTestSuite) tcpSYN() []byte {
+	ip := &layers.IPv4{Version: 4, TTL: 64, Protocol: layers.IPProtocolTCP, SrcIP: podIP, DstIP: remoteIP}
+	tcp := &layers.TCP{SrcPort: 40001, DstPort: 443, SYN: true, Window: 65535}
+	s.Require().NoError(tcp.SetNetworkLayerForChecksum(ip))
+	return s.serialize(ethernet, ip, tcp)
+}
+
+func (s *ReplayTestSuite) udpPacket() []byte {
+	ip := &layers.IPv4{Version: 4, TTL: 64, Protocol: layers.IPProtocolUDP, SrcIP: podIP, DstIP: remoteIP}
+	udp := &layers.UDP{SrcPort: 40002, DstPort: 443}
+	s.Require().NoError(udp.SetNetworkLayerForChecksum(ip))
+	return s.serialize(ethernet, ip, udp, gopacket.Payload("quic"))
+}
+
+func (s *ReplayTestSuite) writePcap(name string, timestamp time.Time, data []byte) string {
+	path := filepath.Join(s.dir, name)
+	file, err := os.Create(path)
+	s.Require().NoError(err)
+	defer file.Close()
+
+	writer := pcapgo.NewWriter(file)
+	s.Require().NoError(writer.WriteFileHeader(65535, layers.LinkTypeEthernet))
+	s.Require().NoError(writer.WritePacket(gopacket.CaptureInfo{Timestamp: timestamp, CaptureLength: len(data), Length: len(data)}, data))
+	return path
+}
+
+func (s *ReplayTestSuite) writePcapng(name string, timestamp time.Time, data []byte) string {
+	path := filepath.Join(s.dir, name)
+	file, err := os.Create(path)
+	s.Require().NoError(err)
+	defer file.Close()
+
+	writer, err := pcapgo.NewNgWriter(file, layers.LinkTypeEthernet)
+	s.Require().NoError(err)
+	s.Require().NoError(writer.WritePacket(gopacket.CaptureInfo{Timestamp: timestamp, CaptureLength: len(data), Length: len(data)}, data))
+	s.Require().NoError(writer.Flush())
+	return path
+}
+
+func (s *ReplayTestSuite) expectedDNSResults() []mapperclient.RecordedDestinationsForSrc {
+	return []mapperclient.RecordedDestinationsForSrc{
+		{
+			SrcIp: "10.0.0.5",
+			Destinations: []mapperclient.Destination{
+				{
+					Destination:   "api.example.com",
+					DestinationIP: nilable.From("93.184.216.34"),
+					LastSeen:      s.dnsTimestamp,
+					TTL:           nilable.From(30),
+					SrcPorts:      []int{},
+				},
+			},
+		},
+	}
+}
+
+func (s *ReplayTestSuite) TestReplayFile() {
+	path := s.writePcap("capture.pcap", s.dnsTimestamp, s.dnsResponse())
+
+	results := s.replay(path)
+	s.Require().Equal(s.expectedDNSResults(), results.DNSResults)
+	s.Require().Empty(results.TCPResults)
+}
+
+func (s *ReplayTestSuite) TestReplayDirectory() {
+	s.writePcap("a.pcap", s.dnsTimestamp, s.dnsResponse())
+	s.writePcapng("b.pcapng", s.tcpTimestamp, s.tcpSYN())
+	s.Require().NoError(os.WriteFile(filepath.Join(s.dir, "notes.txt"), []byte("not a capture"), 0600))
+
+	s.Require().Equal(ReplayResults{
+		DNSResults: s.expectedDNSResults(),
+		TCPResults: []mapperclient.RecordedDestinationsForSrc{
+			{
+				SrcIp: "10.0.0.5",
+				Destinations: []mapperclient.Destination{
+					{
+						Destination:     "93.184.216.34",
+						DestinationIP:   nilable.From("93.184.216.34"),
+						DestinationPort: nilable.From(443),
+						LastSeen:        s.tcpTimestamp,
+						SrcPorts:        []int{40001},
+					},
+				},
+			},
+		},
+		UDPResults: []mapperclient.RecordedDestinationsForSrc{},
+	}, s.replay(s.dir))
+}
+
+func (s *ReplayTestSuite) TestReplayUDP() {
+	viper.Set(sharedconfig.EnableUDPKey, true)
+	defer viper.Set(sharedconfig.EnableUDPKey, sharedconfig.EnableUDPSnifferDefault)
+	path := s.writePcap("capture.pcap", s.udpTimestamp, s.udpPacket())
+
+	s.Require().Equal([]mapperclient.RecordedDestinationsForSrc{
+		{
+			SrcIp: "10.0.0.5",
+			Destinations: []mapperclient.Destination{
+				{
+					Destination:     "93.184.216.34",
+					DestinationIP:   nilable.From("93.184.216.34"),
+					DestinationPort: nilable.From(443),
+					LastSeen:        s.udpTimestamp,
+					SrcPorts:        []int{40002},
+				},
+			},
+		},
+	}, s.replay(path).UDPResults)
+}
+
+func (s *ReplayTestSuite) TestReplayEmptyDirectory() {
+	s.Require().NoError(os.WriteFile(filepath.Join(s.dir, "notes.txt"), []byte("not a capture"), 0600))
+
+	_, err := Replay(s.dir)
+	s.Require().Error(err)
+}
+
+func TestReplayTestSuite(t *testing.T) {
+	suite.Run(t, new(ReplayTestSuite))
+}
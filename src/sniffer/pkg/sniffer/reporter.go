@@ -0,0 +1,100 @@
+package sniffer
+
+import (
+	"context"
+	"github.com/otterize/intents-operator/src/shared/errors"
+	"github.com/otterize/network-mapper/src/mapperclient"
+	"github.com/otterize/network-mapper/src/sniffer/pkg/collectors"
+	"github.com/otterize/network-mapper/src/sniffer/pkg/config"
+	"github.com/otterize/network-mapper/src/sniffer/pkg/prometheus"
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/viper"
+	"sync"
+	"time"
+)
+
+type reportFunc func(ctx context.Context, results []mapperclient.RecordedDestinationsForSrc) error
+
+// resultsReporter reports the results collected by one of the collectors to the mapper in the background. Collectors
+// reset their data once results are collected, so results that fail to be reported are spooled and retried with the
+// following reports, backing off while the mapper is unreachable, instead of being lost.
+type resultsReporter struct {
+	name        string
+	report      reportFunc
+	lock        sync.Mutex
+	spool       *collectors.ResultsSpool
+	inFlight    bool
+	failures    int
+	nextAttempt time.Time
+}
+
+func newResultsReporter(name string, report reportFunc) *resultsReporter {
+	return &resultsReporter{
+		name:   name,
+		report: report,
+		spool:  collectors.NewResultsSpool(viper.GetInt(config.ReportSpoolMaxEntriesKey)),
+	}
+}
+
+// Report adds results to the spool, and reports everything spooled unless a report is already in flight or the next
+// retry is not due yet. The actual report is async, so it won't block packet handling.
+func (r *resultsReporter) Report(ctx context.Context, results []mapperclient.RecordedDestinationsForSrc) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	r.addToSpool(results)
+	if r.inFlight || r.spool.Len() == 0 || time.Now().Before(r.nextAttempt) {
+		return
+	}
+
+	spooled := r.spool.CollectResults()
+	prometheus.SetReportSpoolDepth(r.name, r.spool.Len())
+	r.inFlight = true
+	go r.send(ctx, spooled)
+}
+
+func (r *resultsReporter) send(ctx context.Context, results []mapperclient.RecordedDestinationsForSrc) {
+	timeoutCtx, cancelFunc := context.WithTimeout(ctx, viper.GetDuration(config.CallsTimeoutKey))
+	defer cancelFunc()
+
+	logrus.Debugf("Reporting %s results of %d clients to Mapper", r.name, len(results))
+	err := r.report(timeoutCtx, results)
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.inFlight = false
+	if err != nil {
+		r.failures++
+		delay := retryDelay(r.failures)
+		var retryAfterErr *mapperclient.RetryAfterError
+		if errors.As(err, &retryAfterErr) {
+			// The mapper is up but can't keep up, so don't retry before it asked to
+			delay = max(delay, retryAfterErr.RetryAfter)
+		}
+		r.nextAttempt = time.Now().Add(delay)
+		r.addToSpool(results)
+		logrus.WithError(err).WithFields(logrus.Fields{"results": r.name, "spooled": r.spool.Len(), "retryIn": delay}).Error("Failed to report results, will retry")
+		return
+	}
+	r.failures = 0
+	r.nextAttempt = time.Time{}
+}
+
+func (r *resultsReporter) addToSpool(results []mapperclient.RecordedDestinationsForSrc) {
+	if dropped := r.spool.Add(results); dropped != 0 {
+		logrus.WithField("results", r.name).Warnf("Report spool is full, dropped %d connections", dropped)
+		prometheus.IncrementReportSpoolDrops(r.name, dropped)
+	}
+	prometheus.SetReportSpoolDepth(r.name, r.spool.Len())
+}
+
+// retryDelay returns the delay before retrying after the given number of consecutive failures, doubling from the
+// initial backoff up to the max backoff.
+func retryDelay(failures int) time.Duration {
+	delay := viper.GetDuration(config.ReportRetryInitialBackoffKey)
+	maxDelay := viper.GetDuration(config.ReportRetryMaxBackoffKey)
+	for i := 1; i < failures && delay < maxDelay; i++ {
+		delay *= 2
+	}
+	return min(delay, maxDelay)
+}
@@ -0,0 +1,114 @@
+package sniffer
+
+import (
+	"context"
+	"github.com/otterize/intents-operator/src/shared/errors"
+	"github.com/otterize/network-mapper/src/mapperclient"
+	"github.com/otterize/network-mapper/src/sniffer/pkg/config"
+	"github.com/otterize/nilable"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/suite"
+	"testing"
+	"time"
+)
+
+type reportAttempt struct {
+	results []mapperclient.RecordedDestinationsForSrc
+	errChan chan error
+}
+
+type ResultsReporterTestSuite struct {
+	suite.Suite
+	attempts chan reportAttempt
+	reporter *resultsReporter
+}
+
+func (s *ResultsReporterTestSuite) SetupTest() {
+	viper.Set(config.ReportRetryInitialBackoffKey, time.Duration(0))
+	s.attempts = make(chan reportAttempt)
+	s.reporter = newResultsReporter("test", func(ctx context.Context, results []mapperclient.RecordedDestinationsForSrc) error {
+		attempt := reportAttempt{results: results, errChan: make(chan error)}
+		s.attempts <- attempt
+		return <-attempt.errChan
+	})
+}
+
+func (s *ResultsReporterTestSuite) TearDownTest() {
+	viper.Set(config.ReportRetryInitialBackoffKey, config.ReportRetryInitialBackoffDefault)
+}
+
+func (s *ResultsReporterTestSuite) nextAttempt() reportAttempt {
+	select {
+	case attempt := <-s.attempts:
+		return attempt
+	case <-time.After(5 * time.Second):
+		s.FailNow("no report attempt")
+		return reportAttempt{}
+	}
+}
+
+func (s *ResultsReporterTestSuite) waitForSpoolLen(expected int) {
+	s.Require().Eventually(func() bool {
+		s.reporter.lock.Lock()
+		defer s.reporter.lock.Unlock()
+		return !s.reporter.inFlight && s.reporter.spool.Len() == expected
+	}, 5*time.Second, 10*time.Millisecond)
+}
+
+func resultsForDest(destIP string) []mapperclient.RecordedDestinationsForSrc {
+	return []mapperclient.RecordedDestinationsForSrc{
+		{
+			SrcIp: "10.0.0.5",
+			Destinations: []mapperclient.Destination{
+				{Destination: destIP, DestinationIP: nilable.From(destIP), LastSeen: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC), SrcPorts: []int{}},
+			},
+		},
+	}
+}
+
+func (s *ResultsReporterTestSuite) TestFailedResultsAreRetried() {
+	ctx := context.Background()
+	s.reporter.Report(ctx, resultsForDest("10.0.0.6"))
+	attempt := s.nextAttempt()
+	s.Require().Len(attempt.results, 1)
+	attempt.errChan <- errors.New("mapper is unreachable")
+	s.waitForSpoolLen(1)
+
+	// Spooled results are reported along with new ones
+	s.reporter.Report(ctx, resultsForDest("10.0.0.7"))
+	attempt = s.nextAttempt()
+	s.Require().Len(attempt.results, 1)
+	s.Require().ElementsMatch([]string{"10.0.0.6", "10.0.0.7"}, []string{attempt.results[0].Destinations[0].Destination, attempt.results[0].Destinations[1].Destination})
+	attempt.errChan <- nil
+	s.waitForSpoolLen(0)
+
+	// Nothing is left to retry
+	s.reporter.Report(ctx, nil)
+	s.Require().False(s.reporter.inFlight)
+}
+
+func (s *ResultsReporterTestSuite) TestResultsAreSpooledWhileReportIsInFlight() {
+	ctx := context.Background()
+	s.reporter.Report(ctx, resultsForDest("10.0.0.6"))
+	attempt := s.nextAttempt()
+	s.reporter.Report(ctx, resultsForDest("10.0.0.7"))
+	attempt.errChan <- nil
+	s.waitForSpoolLen(1)
+
+	s.reporter.Report(ctx, nil)
+	attempt = s.nextAttempt()
+	s.Require().Equal("10.0.0.7", attempt.results[0].Destinations[0].Destination)
+	attempt.errChan <- nil
+	s.waitForSpoolLen(0)
+}
+
+func (s *ResultsReporterTestSuite) TestRetryDelay() {
+	viper.Set(config.ReportRetryInitialBackoffKey, time.Second)
+	s.Require().Equal(time.Second, retryDelay(1))
+	s.Require().Equal(4*time.Second, retryDelay(3))
+	s.Require().Equal(config.ReportRetryMaxBackoffDefault, retryDelay(100))
+}
+
+func TestResultsReporterTestSuite(t *testing.T) {
+	suite.Run(t, new(ResultsReporterTestSuite))
+}
@@ -2,8 +2,10 @@ package sniffer
 
 import (
 	"context"
+	"github.com/google/gopacket"
 	"github.com/otterize/intents-operator/src/shared/errors"
 	"github.com/otterize/network-mapper/src/mapperclient"
+	sharedconfig "github.com/otterize/network-mapper/src/shared/config"
 	"github.com/otterize/network-mapper/src/shared/isrunningonaws"
 	"github.com/otterize/network-mapper/src/sniffer/pkg/collectors"
 	"github.com/otterize/network-mapper/src/sniffer/pkg/config"
@@ -19,29 +21,74 @@ type Sniffer struct {
 	dnsSniffer     *collectors.DNSSniffer
 	socketScanner  *collectors.SocketScanner
 	tcpSniffer     *collectors.TCPSniffer
+	udpSniffer     *collectors.UDPSniffer
 	lastReportTime time.Time
 	mapperClient   *mapperclient.Client
+	// ebpfCollector replaces tcpSniffer and socketScanner when eBPF is enabled and available, and is nil otherwise.
+	ebpfCollector      *collectors.EBPFCollector
+	dnsReporter        *resultsReporter
+	tcpReporter        *resultsReporter
+	udpReporter        *resultsReporter
+	ebpfReporter       *resultsReporter
+	socketScanReporter *resultsReporter
 }
 
 func NewSniffer(mapperClient *mapperclient.Client) *Sniffer {
 	procFSIPResolver := ipresolver.NewProcFSIPResolver()
 	isRunningOnAws := isrunningonaws.Check()
 
-	return &Sniffer{
+	s := &Sniffer{
 		dnsSniffer:    collectors.NewDNSSniffer(procFSIPResolver, isRunningOnAws),
 		tcpSniffer:    collectors.NewTCPSniffer(procFSIPResolver, isRunningOnAws),
+		udpSniffer:    collectors.NewUDPSniffer(procFSIPResolver, isRunningOnAws),
 		socketScanner: collectors.NewSocketScanner(),
 		mapperClient:  mapperClient,
+		dnsReporter: newResultsReporter("dns", func(ctx context.Context, results []mapperclient.RecordedDestinationsForSrc) error {
+			err := mapperClient.ReportCaptureResults(ctx, mapperclient.CaptureResults{Results: results})
+			if err != nil {
+				return errors.Wrap(err)
+			}
+			logrus.Debugf("Reported captured requests of %d clients to Mapper", len(results))
+			prometheus.IncrementDNSCaptureReports(len(results))
+			return nil
+		}),
+		tcpReporter: newResultsReporter("tcp", func(ctx context.Context, results []mapperclient.RecordedDestinationsForSrc) error {
+			return errors.Wrap(mapperClient.ReportTCPCaptureResults(ctx, mapperclient.CaptureTCPResults{Results: results}))
+		}),
+		udpReporter: newResultsReporter("udp", func(ctx context.Context, results []mapperclient.RecordedDestinationsForSrc) error {
+			return errors.Wrap(mapperClient.ReportUDPCaptureResults(ctx, mapperclient.CaptureUDPResults{Results: results}))
+		}),
+		// Connections are reported the same way captured TCP SYNs are
+		ebpfReporter: newResultsReporter("ebpf", func(ctx context.Context, results []mapperclient.RecordedDestinationsForSrc) error {
+			return errors.Wrap(mapperClient.ReportTCPCaptureResults(ctx, mapperclient.CaptureTCPResults{Results: results}))
+		}),
+		socketScanReporter: newResultsReporter("socketscan", func(ctx context.Context, results []mapperclient.RecordedDestinationsForSrc) error {
+			err := mapperClient.ReportSocketScanResults(ctx, mapperclient.SocketScanResults{Results: results})
+			if err != nil {
+				return errors.Wrap(err)
+			}
+			logrus.Debugf("Reported scanned requests of %d clients to Mapper", len(results))
+			prometheus.IncrementSocketScanReports(len(results))
+			return nil
+		}),
+	}
+	if viper.GetBool(config.EnableEBPFKey) {
+		source, err := collectors.NewEBPFConnectionEventSource()
+		if err != nil {
+			logrus.WithError(err).Warn("eBPF is not available, falling back to capturing TCP SYN packets and scanning sockets")
+		} else {
+			logrus.Info("Collecting TCP connections using eBPF")
+			s.ebpfCollector = collectors.NewEBPFCollector(source, ipresolver.NewProcFSCgroupResolver())
+		}
 	}
+	return s
 }
 
 func (s *Sniffer) reportCaptureResults(ctx context.Context) {
 	results := s.dnsSniffer.CollectResults()
 	if len(results) == 0 {
 		logrus.Debugf("No captured sniffed requests to report")
-		return
 	}
-	logrus.Debugf("Reporting captured requests of %d clients to Mapper", len(results))
 
 	// Check for domain debug filter and log matching entries at INFO level
 	domainDebugFilter := viper.GetString(config.DomainDebugFilterKey)
@@ -57,66 +104,49 @@ func (s *Sniffer) reportCaptureResults(ctx context.Context) {
 		}
 	}
 
-	go func() {
-		timeoutCtx, cancelFunc := context.WithTimeout(ctx, viper.GetDuration(config.CallsTimeoutKey))
-		defer cancelFunc()
-
-		err := s.mapperClient.ReportCaptureResults(timeoutCtx, mapperclient.CaptureResults{Results: results})
-		if err != nil {
-			logrus.WithError(err).Error("Failed to report capture results")
-			return
-		}
-		logrus.Debugf("Reported captured requests of %d clients to Mapper", len(results))
-		prometheus.IncrementDNSCaptureReports(len(results))
-	}()
+	s.dnsReporter.Report(ctx, results)
 }
 
 func (s *Sniffer) reportTCPCaptureResults(ctx context.Context) {
 	results := s.tcpSniffer.CollectResults()
 	if len(results) == 0 {
 		logrus.Debugf("No TCP captured sniffed requests to report")
-		return
 	}
-	logrus.Debugf("Reporting TCP captured requests of %d clients to Mapper", len(results))
+	s.tcpReporter.Report(ctx, results)
+}
 
-	go func() {
-		timeoutCtx, cancelFunc := context.WithTimeout(ctx, viper.GetDuration(config.CallsTimeoutKey))
-		defer cancelFunc()
+func (s *Sniffer) reportUDPCaptureResults(ctx context.Context) {
+	results := s.udpSniffer.CollectResults()
+	if len(results) == 0 {
+		logrus.Debugf("No UDP captured flows to report")
+	}
+	s.udpReporter.Report(ctx, results)
+}
 
-		logrus.Debugf("Reporting TCP captured requests of %d clients to Mapper", len(results))
-		err := s.mapperClient.ReportTCPCaptureResults(timeoutCtx, mapperclient.CaptureTCPResults{Results: results})
-		if err != nil {
-			logrus.WithError(err).Error("Failed to report capture results")
-		}
-	}()
+func (s *Sniffer) reportEBPFResults(ctx context.Context) {
+	results := s.ebpfCollector.CollectResults()
+	if len(results) == 0 {
+		logrus.Debugf("No eBPF collected connections to report")
+	}
+	s.ebpfReporter.Report(ctx, results)
 }
 
 func (s *Sniffer) reportSocketScanResults(ctx context.Context) {
 	results := s.socketScanner.CollectResults()
 	if len(results) == 0 {
 		logrus.Debugf("No socket scanned connections to report")
-		return
 	}
-	logrus.Debugf("Reporting scanned requests of %d clients to Mapper", len(results))
-
-	go func() {
-		timeoutCtx, cancelFunc := context.WithTimeout(ctx, viper.GetDuration(config.CallsTimeoutKey))
-		defer cancelFunc()
-
-		err := s.mapperClient.ReportSocketScanResults(timeoutCtx, mapperclient.SocketScanResults{Results: results})
-		if err != nil {
-			logrus.WithError(err).Error("Failed to report socket scan results")
-			return
-		}
-		logrus.Debugf("Reported scanned requests of %d clients to Mapper", len(results))
-		prometheus.IncrementSocketScanReports(len(results))
-	}()
+	s.socketScanReporter.Report(ctx, results)
 }
 
 func (s *Sniffer) report(ctx context.Context) {
 	s.reportSocketScanResults(ctx)
 	s.reportCaptureResults(ctx)
 	s.reportTCPCaptureResults(ctx)
+	s.reportUDPCaptureResults(ctx)
+	if s.ebpfCollector != nil {
+		s.reportEBPFResults(ctx)
+	}
 	s.lastReportTime = time.Now()
 }
 
@@ -131,9 +161,36 @@ func (s *Sniffer) RunForever(ctx context.Context) error {
 		return errors.Wrap(err)
 	}
 
-	tcpPacketsChan, err := s.tcpSniffer.CreateTCPPacketStream()
-	if err != nil {
-		return errors.Wrap(err)
+	var tcpPacketsChan chan gopacket.Packet
+	var ebpfEvents <-chan collectors.ConnectionEvent
+	if s.ebpfCollector != nil {
+		defer func() {
+			if err := s.ebpfCollector.Close(); err != nil {
+				logrus.WithError(err).Error("Failed to close eBPF collector")
+			}
+		}()
+		ebpfEvents = s.ebpfCollector.Events()
+		// eBPF only sees new connections, so the ones opened before the sniffer started are found by a single scan
+		if err := s.socketScanner.ScanProcDir(); err != nil {
+			logrus.WithError(err).Error("Failed to scan proc dir for sockets")
+		}
+	} else {
+		tcpPacketsChan, err = s.tcpSniffer.CreateTCPPacketStream()
+		if err != nil {
+			return errors.Wrap(err)
+		}
+	}
+
+	var udpPacketsChan chan gopacket.Packet
+	if viper.GetBool(sharedconfig.EnableUDPKey) {
+		// Unlike TCP, which only captures SYNs, all UDP packets are captured, so the capture is only opened when enabled
+		udpPacketsChan, err = s.udpSniffer.CreateUDPPacketStream()
+		if err != nil {
+			return errors.Wrap(err)
+		}
+		if err := s.udpSniffer.RefreshListeners(); err != nil {
+			logrus.WithError(err).Error("Failed to scan proc dir for UDP listeners")
+		}
 	}
 
 	for {
@@ -144,6 +201,13 @@ func (s *Sniffer) RunForever(ctx context.Context) error {
 			s.dnsSniffer.HandlePacket(packet)
 		case packet := <-tcpPacketsChan:
 			s.tcpSniffer.HandlePacket(packet)
+		case packet := <-udpPacketsChan:
+			s.udpSniffer.HandlePacket(packet)
+		case event, ok := <-ebpfEvents:
+			if !ok {
+				return errors.New("eBPF connection events stopped")
+			}
+			s.ebpfCollector.HandleEvent(event)
 		case <-time.After(s.dnsSniffer.GetTimeTilNextRefresh()):
 			if err := s.dnsSniffer.RefreshHostsMapping(); err != nil {
 				logrus.WithError(err).Error("Failed to refresh ip->host resolving map for DNS")
@@ -152,8 +216,21 @@ func (s *Sniffer) RunForever(ctx context.Context) error {
 			if err := s.tcpSniffer.RefreshHostsMapping(); err != nil {
 				logrus.WithError(err).Error("Failed to refresh ip->host resolving map for TCP")
 			}
+		case <-time.After(s.udpSniffer.GetTimeTilNextRefresh()):
+			if err := s.udpSniffer.RefreshHostsMapping(); err != nil {
+				logrus.WithError(err).Error("Failed to refresh ip->host resolving map for UDP")
+			}
+			if udpPacketsChan != nil {
+				if err := s.udpSniffer.RefreshListeners(); err != nil {
+					logrus.WithError(err).Error("Failed to scan proc dir for UDP listeners")
+				}
+			}
 		case <-time.After(s.getTimeTilNextReport()):
-			if err := s.socketScanner.ScanProcDir(); err != nil {
+			if s.ebpfCollector != nil {
+				if err := s.ebpfCollector.RefreshHostsMapping(); err != nil {
+					logrus.WithError(err).Error("Failed to refresh cgroup->host resolving map for eBPF")
+				}
+			} else if err := s.socketScanner.ScanProcDir(); err != nil {
 				logrus.WithError(err).Error("Failed to scan proc dir for sockets")
 			}
 			// Flush pending packets before reporting
@@ -163,7 +240,11 @@ func (s *Sniffer) RunForever(ctx context.Context) error {
 			if err := s.tcpSniffer.RefreshHostsMapping(); err != nil {
 				logrus.WithError(err).Error("Failed to refresh ip->host resolving map for TCP")
 			}
-			// Actual server request is async, won't block packet handling
+			if err := s.udpSniffer.RefreshHostsMapping(); err != nil {
+				logrus.WithError(err).Error("Failed to refresh ip->host resolving map for UDP")
+			}
+			// Actual server request is async, won't block packet handling. Results that fail to be reported are retried
+			// with the following reports.
 			s.report(ctx)
 		}
 	}
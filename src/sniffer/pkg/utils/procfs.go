@@ -1,14 +1,18 @@
 package utils
 
 import (
+	"encoding/hex"
 	"fmt"
 	"github.com/mpvl/unique"
 	"github.com/otterize/intents-operator/src/shared/errors"
 	"github.com/sirupsen/logrus"
+	"net"
 	"os"
+	"path/filepath"
 	"regexp"
 	"strconv"
 	"strings"
+	"syscall"
 
 	"github.com/otterize/network-mapper/src/sniffer/pkg/config"
 	"github.com/spf13/viper"
@@ -87,10 +91,29 @@ func extractProcessHostnameUsingEnviron(pDir string) (string, error) {
 	return "", errors.Errorf("couldn't find hostname in %s/environ", pDir)
 }
 
-func ExtractProcessIPAddr(pDir string) (string, error) {
+// ExtractProcessIPAddrs returns the IP addresses of the process's network namespace: its IPv4 address, and its global
+// IPv6 addresses on dual-stack clusters.
+func ExtractProcessIPAddrs(pDir string) ([]string, error) {
+	ipv4Addrs, err := extractProcessIPv4Addrs(pDir)
+	if err != nil {
+		return nil, errors.Wrap(err)
+	}
+	ipv6Addrs, err := extractProcessIPv6Addrs(pDir)
+	if err != nil {
+		return nil, errors.Wrap(err)
+	}
+
+	ips := append(ipv4Addrs, ipv6Addrs...)
+	if len(ips) == 0 {
+		return nil, errors.New("no IP addresses found")
+	}
+	return ips, nil
+}
+
+func extractProcessIPv4Addrs(pDir string) ([]string, error) {
 	contentBytes, err := os.ReadFile(fmt.Sprintf("%s/net/fib_trie", pDir))
 	if err != nil {
-		return "", errors.Wrap(err)
+		return nil, errors.Wrap(err)
 	}
 
 	content := string(contentBytes)
@@ -109,12 +132,67 @@ func ExtractProcessIPAddr(pDir string) (string, error) {
 	}
 	unique.Strings(&ips)
 
-	if len(ips) == 0 {
-		return "", errors.New("no IP addresses found")
-	}
 	if len(ips) > 1 {
 		logrus.Warnf("Found multiple IP addresses (%s) in %s", ips, pDir)
+		ips = ips[:1]
+	}
+
+	return ips, nil
+}
+
+// extractProcessIPv6Addrs reads the global scope addresses from net/if_inet6, which lists an address per line as
+// '<address in hex> <ifindex> <prefix length> <scope> <flags> <interface>'. Loopback and link-local addresses, which
+// have a different scope, are skipped. The file does not exist when IPv6 is disabled.
+func extractProcessIPv6Addrs(pDir string) ([]string, error) {
+	contentBytes, err := os.ReadFile(fmt.Sprintf("%s/net/if_inet6", pDir))
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, errors.Wrap(err)
 	}
 
-	return ips[0], nil
+	const globalScope = "00"
+	ips := make([]string, 0)
+	for _, line := range strings.Split(string(contentBytes), "\n") {
+		fields := strings.Fields(line)
+		if len(fields) < 4 || fields[3] != globalScope {
+			continue
+		}
+		ipBytes, err := hex.DecodeString(fields[0])
+		if err != nil || len(ipBytes) != net.IPv6len {
+			logrus.Debugf("Skipping malformed if_inet6 line '%s' in %s", line, pDir)
+			continue
+		}
+		ips = append(ips, net.IP(ipBytes).String())
+	}
+	unique.Strings(&ips)
+	return ips, nil
+}
+
+// ExtractProcessCgroupID returns the ID of the process's cgroup v2, which is the inode number of the cgroup's directory
+// under the host cgroup mount - the same ID the kernel reports through bpf_get_current_cgroup_id.
+func ExtractProcessCgroupID(pDir string) (uint64, error) {
+	contentBytes, err := os.ReadFile(fmt.Sprintf("%s/cgroup", pDir))
+	if err != nil {
+		return 0, errors.Wrap(err)
+	}
+
+	// The cgroup v2 hierarchy is listed as '0::<path>', next to any cgroup v1 hierarchies
+	for _, line := range strings.Split(string(contentBytes), "\n") {
+		cgroupPath, found := strings.CutPrefix(line, "0::")
+		if !found {
+			continue
+		}
+		info, err := os.Stat(filepath.Join(viper.GetString(config.HostCgroupDirKey), cgroupPath))
+		if err != nil {
+			return 0, errors.Wrap(err)
+		}
+		stat, ok := info.Sys().(*syscall.Stat_t)
+		if !ok {
+			return 0, errors.Errorf("unsupported file info for cgroup %s", cgroupPath)
+		}
+		return stat.Ino, nil
+	}
+	return 0, errors.Errorf("couldn't find cgroup v2 path in %s/cgroup", pDir)
 }